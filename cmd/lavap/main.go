@@ -53,6 +53,26 @@ func main() {
 	// Add Badge Generator Command
 	rootCmd.AddCommand(badgeServer)
 
+	// provider operator commands, querying a running rpcprovider
+	providerCmd := &cobra.Command{
+		Use:   "provider",
+		Short: "Commands for operating a running rpcprovider",
+	}
+	rootCmd.AddCommand(providerCmd)
+	providerCmd.AddCommand(rpcprovider.CreateProviderStatusCobraCommand())
+	providerCmd.AddCommand(rpcprovider.CreateProviderReloadCobraCommand())
+
+	// reward commands, working on the reward storage of a stopped rpcprovider
+	rewardsCmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Commands for listing, moving and claiming the unclaimed proofs of a provider",
+	}
+	rootCmd.AddCommand(rewardsCmd)
+	rewardsCmd.AddCommand(rpcprovider.CreateRewardsListCobraCommand())
+	rewardsCmd.AddCommand(rpcprovider.CreateRewardsExportCobraCommand())
+	rewardsCmd.AddCommand(rpcprovider.CreateRewardsImportCobraCommand())
+	rewardsCmd.AddCommand(rpcprovider.CreateRewardsClaimCobraCommand())
+
 	testCmd := &cobra.Command{
 		Use:   "test",
 		Short: "Test commands for protocol network",
@@ -0,0 +1,326 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FlagAdminAddress = "admin_address"
+	FlagAdminToken   = "admin_token"
+	AdminTokenEnv    = "LAVA_CACHE_ADMIN_TOKEN"
+
+	adminAuthorizationHeader = "authorization"
+)
+
+var InvalidateFilterError = errors.New("invalidate request must set at least one of chain id, request hash or block range")
+
+type RelayerCacheAdminServer struct {
+	pairingtypes.UnimplementedRelayerCacheAdminServer
+	CacheServer *CacheServer
+}
+
+// EnableEntriesIndex starts tracking the entries set in memory so they can be listed and invalidated
+func (cs *CacheServer) EnableEntriesIndex() {
+	cs.entries = newEntriesIndex()
+}
+
+// CheckAdminListenAddress refuses to expose the admin api beyond the local host without a token, as it can wipe or
+// overwrite the cached entries
+func CheckAdminListenAddress(listenAddr string, token string) error {
+	if token != "" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("cache admin api listening on a non loopback address requires a token (--%s or %s)", FlagAdminToken, AdminTokenEnv)
+}
+
+// adminAuthorized checks the bearer token of a request, every request is allowed when no token is set since the
+// listener is then bound to the local host
+func adminAuthorized(ctx context.Context, token string) error {
+	if token == "" {
+		return nil
+	}
+	resolvedToken, err := common.ResolveSecret(token)
+	if err != nil || resolvedToken == "" {
+		return status.Error(codes.Unauthenticated, "admin token unavailable")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(adminAuthorizationHeader) {
+		requestToken := strings.TrimPrefix(value, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(requestToken), []byte(resolvedToken)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+// NewCacheAdminGrpcServer returns a grpc server with the admin api, requests must carry the token (which may reference
+// a secret, resolved on every request so it can be rotated) as a bearer authorization header unless it is empty
+func NewCacheAdminGrpcServer(cs *CacheServer, token string) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := adminAuthorized(ctx, token); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := adminAuthorized(stream.Context(), token); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	)
+	pairingtypes.RegisterRelayerCacheAdminServer(s, &RelayerCacheAdminServer{CacheServer: cs})
+	return s
+}
+
+func (cs *CacheServer) ServeAdmin(ctx context.Context, listenAddr string, token string) {
+	err := CheckAdminListenAddress(listenAddr, token)
+	if err != nil {
+		utils.LavaFormatFatal("cache admin server refused to start", err, utils.Attribute{Key: "listenAddr", Value: listenAddr})
+	}
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		utils.LavaFormatFatal("cache admin server failure setting up listener", err, utils.Attribute{Key: "listenAddr", Value: listenAddr})
+	}
+	s := NewCacheAdminGrpcServer(cs, token)
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+	utils.LavaFormatInfo("Cache Admin Server listening", utils.Attribute{Key: "Address", Value: lis.Addr().String()})
+	if err := s.Serve(lis); err != nil {
+		utils.LavaFormatError("cache admin server failed to serve", err, utils.Attribute{Key: "Address", Value: lis.Addr().String()})
+	}
+}
+
+func (cs *CacheServer) valueForIndexedEntry(entry indexedEntryWithKey) (CacheValue, string, bool) {
+	cache, source := cs.tempCache, tempCacheSource
+	if entry.finalized {
+		cache, source = cs.finalizedCache, finalizedCacheSource
+	}
+	value, found := getNonExpiredFromCache(cache, entry.key)
+	if !found {
+		return CacheValue{}, "", false
+	}
+	cacheVal, ok := value.(CacheValue)
+	return cacheVal, source, ok
+}
+
+func indexedEntryMetadata(entry indexedEntryWithKey, source string) pairingtypes.CacheEntryMetadata {
+	return pairingtypes.CacheEntryMetadata{
+		ChainId:        entry.chainId,
+		Key:            entry.key,
+		RequestedBlock: entry.requestedBlock,
+		SeenBlock:      entry.seenBlock,
+		Source:         source,
+		Size_:          entry.size,
+		Ttl:            int64(time.Until(entry.expiresAt)),
+	}
+}
+
+func storedEntryMetadata(chainId string, cacheKey []byte, value CacheValue) pairingtypes.CacheEntryMetadata {
+	_, requestedBlock := requestedBlockFromCacheKey(cacheKey)
+	return pairingtypes.CacheEntryMetadata{
+		ChainId:        chainId,
+		Key:            cacheKey,
+		RequestedBlock: requestedBlock,
+		SeenBlock:      value.SeenBlock,
+		Source:         persistentCacheSource,
+		Size_:          value.Cost(),
+	}
+}
+
+// walkEntries calls the callback for every live entry in memory and then for persistent entries not in memory
+func (cs *CacheServer) walkEntries(chainId string, callback func(metadata pairingtypes.CacheEntryMetadata, value CacheValue) bool) error {
+	for _, entry := range cs.entries.entries(chainId) {
+		value, source, found := cs.valueForIndexedEntry(entry)
+		if !found {
+			cs.entries.remove(entry.chainId, entry.key) // evicted by ristretto
+			continue
+		}
+		if !callback(indexedEntryMetadata(entry, source), value) {
+			return nil
+		}
+	}
+	if cs.finalizedStore == nil {
+		return nil
+	}
+	return cs.finalizedStore.Iterate(func(storedChainId string, cacheKey []byte, value CacheValue) bool {
+		if chainId != "" && chainId != storedChainId {
+			return true
+		}
+		if _, inMemory := cs.entries.find(cacheKey); inMemory {
+			return true
+		}
+		return callback(storedEntryMetadata(storedChainId, cacheKey, value), value)
+	})
+}
+
+func (s *RelayerCacheAdminServer) ListEntries(ctx context.Context, req *pairingtypes.CacheListRequest) (*pairingtypes.CacheListResponse, error) {
+	response := &pairingtypes.CacheListResponse{}
+	err := s.CacheServer.walkEntries(req.ChainId, func(metadata pairingtypes.CacheEntryMetadata, _ CacheValue) bool {
+		response.Entries = append(response.Entries, metadata)
+		return req.Limit == 0 || uint64(len(response.Entries)) < req.Limit
+	})
+	return response, err
+}
+
+func (s *RelayerCacheAdminServer) GetEntry(ctx context.Context, req *pairingtypes.CacheEntryRequest) (*pairingtypes.CacheEntryMetadata, error) {
+	if entry, found := s.CacheServer.entries.find(req.Key); found {
+		if _, source, found := s.CacheServer.valueForIndexedEntry(entry); found {
+			metadata := indexedEntryMetadata(entry, source)
+			return &metadata, nil
+		}
+	}
+	if s.CacheServer.finalizedStore != nil {
+		if chainId, value, found := s.CacheServer.finalizedStore.Get(req.Key); found {
+			metadata := storedEntryMetadata(chainId, req.Key, value)
+			return &metadata, nil
+		}
+	}
+	return nil, NotFoundError
+}
+
+func invalidateMatches(req *pairingtypes.CacheInvalidateRequest, chainId string, cacheKey []byte) bool {
+	requestHash, requestedBlock := requestedBlockFromCacheKey(cacheKey)
+	if req.ChainId != "" && req.ChainId != chainId {
+		return false
+	}
+	if len(req.RequestHash) > 0 && !bytes.Equal(req.RequestHash, requestHash) {
+		return false
+	}
+	if req.FromBlock > 0 && requestedBlock < req.FromBlock {
+		return false
+	}
+	if req.ToBlock > 0 && requestedBlock > req.ToBlock {
+		return false
+	}
+	return true
+}
+
+func (s *RelayerCacheAdminServer) Invalidate(ctx context.Context, req *pairingtypes.CacheInvalidateRequest) (*pairingtypes.CacheInvalidateResponse, error) {
+	if req.ChainId == "" && len(req.RequestHash) == 0 && req.FromBlock <= 0 && req.ToBlock <= 0 {
+		return nil, InvalidateFilterError
+	}
+	cs := s.CacheServer
+	invalidatedKeys := map[string]struct{}{}
+	for _, entry := range cs.entries.entries(req.ChainId) {
+		if !invalidateMatches(req, entry.chainId, entry.key) {
+			continue
+		}
+		cs.tempCache.Del(entry.key)
+		cs.finalizedCache.Del(entry.key)
+		cs.blockHashes.untrack(entry.chainId, entry.requestedBlock, entry.key)
+		cs.entries.remove(entry.chainId, entry.key)
+		invalidatedKeys[string(entry.key)] = struct{}{}
+	}
+	if cs.finalizedStore != nil {
+		storedKeys := [][]byte{}
+		err := cs.finalizedStore.Iterate(func(chainId string, cacheKey []byte, _ CacheValue) bool {
+			if invalidateMatches(req, chainId, cacheKey) {
+				storedKeys = append(storedKeys, cacheKey)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, cacheKey := range storedKeys {
+			cs.finalizedCache.Del(cacheKey) // might have been warm loaded or promoted from the store without being indexed
+			err = cs.finalizedStore.Delete(cacheKey)
+			if err != nil {
+				return nil, err
+			}
+			invalidatedKeys[string(cacheKey)] = struct{}{}
+		}
+	}
+	invalidated := uint64(len(invalidatedKeys))
+	utils.LavaFormatInfo("invalidated cache entries",
+		utils.Attribute{Key: "chainId", Value: req.ChainId},
+		utils.Attribute{Key: "fromBlock", Value: req.FromBlock},
+		utils.Attribute{Key: "toBlock", Value: req.ToBlock},
+		utils.Attribute{Key: "invalidated", Value: invalidated},
+	)
+	return &pairingtypes.CacheInvalidateResponse{Invalidated: invalidated}, nil
+}
+
+func (s *RelayerCacheAdminServer) Export(req *pairingtypes.CacheExportRequest, stream pairingtypes.RelayerCacheAdmin_ExportServer) error {
+	var sendErr error
+	err := s.CacheServer.walkEntries(req.ChainId, func(metadata pairingtypes.CacheEntryMetadata, value CacheValue) bool {
+		sendErr = stream.Send(&pairingtypes.CacheSnapshotEntry{
+			Metadata:  metadata,
+			Value:     *value.ToCacheReply(),
+			BlockHash: value.Hash,
+		})
+		return sendErr == nil
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	return err
+}
+
+func (s *RelayerCacheAdminServer) Import(stream pairingtypes.RelayerCacheAdmin_ImportServer) error {
+	cs := s.CacheServer
+	imported := uint64(0)
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			utils.LavaFormatInfo("imported cache entries", utils.Attribute{Key: "imported", Value: imported})
+			return stream.SendAndClose(&pairingtypes.CacheImportResponse{Imported: imported})
+		}
+		if err != nil {
+			return err
+		}
+		value := CacheValue{
+			Hash:             entry.BlockHash,
+			OptionalMetadata: entry.Value.OptionalMetadata,
+			SeenBlock:        entry.Value.SeenBlock,
+		}
+		if entry.Value.Reply != nil {
+			value.Response = *entry.Value.Reply
+		}
+		metadata := entry.Metadata
+		if metadata.Source == tempCacheSource {
+			ttl := time.Duration(metadata.Ttl)
+			if ttl <= 0 {
+				continue // non finalized entries without a ttl are already stale
+			}
+			cs.tempCache.SetWithTTL(metadata.Key, value, value.Cost(), ttl)
+			cs.blockHashes.track(metadata.ChainId, metadata.RequestedBlock, metadata.Key, value.Hash, ttl)
+			cs.entries.add(metadata.ChainId, metadata.Key, value, false, ttl)
+		} else {
+			cs.finalizedCache.SetWithTTL(metadata.Key, value, value.Cost(), cs.ExpirationFinalized)
+			cs.persistFinalized(metadata.ChainId, metadata.Key, value)
+			cs.entries.add(metadata.ChainId, metadata.Key, value, true, cs.ExpirationFinalized)
+		}
+		imported++
+	}
+}
@@ -0,0 +1,329 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	FlagAdminChainId     = "chain-id"
+	FlagAdminLimit       = "limit"
+	FlagAdminRequestHash = "request-hash"
+	FlagAdminFromBlock   = "from-block"
+	FlagAdminToBlock     = "to-block"
+
+	adminConnectTimeout = 5 * time.Second
+	// snapshot entries can hold large responses, they are bounded by the grpc message limit
+	maxSnapshotEntrySize = 1 << 30
+)
+
+func CreateCacheAdminCobraCommand() *cobra.Command {
+	adminCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "inspect and manage the entries of a running cache server through its admin address (--admin_address)",
+	}
+	adminCmd.AddCommand(
+		createCacheAdminListCommand(),
+		createCacheAdminEntryCommand(),
+		createCacheAdminInvalidateCommand(),
+		createCacheAdminExportCommand(),
+		createCacheAdminImportCommand(),
+	)
+	adminCmd.PersistentFlags().String(FlagAdminToken, "", "the admin api token (also read from "+AdminTokenEnv+"), can reference a secret such as env://NAME or file:///path")
+	return adminCmd
+}
+
+// connectCacheAdmin dials the admin address and attaches the admin token to every request
+func connectCacheAdmin(cmd *cobra.Command, address string) (pairingtypes.RelayerCacheAdminClient, *grpc.ClientConn, error) {
+	token, err := cmd.Flags().GetString(FlagAdminToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if token == "" {
+		token = os.Getenv(AdminTokenEnv)
+	}
+	token, err = common.ResolveSecret(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	withToken := func(ctx context.Context) context.Context {
+		if token == "" {
+			return ctx
+		}
+		return metadata.AppendToOutgoingContext(ctx, adminAuthorizationHeader, "Bearer "+token)
+	}
+	connectCtx, cancel := context.WithTimeout(cmd.Context(), adminConnectTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(connectCtx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(withToken(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withToken(ctx), desc, cc, method, opts...)
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pairingtypes.NewRelayerCacheAdminClient(conn), conn, nil
+}
+
+func printCacheEntryMetadata(cmd *cobra.Command, metadata pairingtypes.CacheEntryMetadata) {
+	cmd.Printf("chain: %s key: %s requested block: %d seen block: %d source: %s size: %d ttl: %s\n",
+		metadata.ChainId, hex.EncodeToString(metadata.Key), metadata.RequestedBlock, metadata.SeenBlock, metadata.Source, metadata.Size_, time.Duration(metadata.Ttl))
+}
+
+func createCacheAdminListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [admin-address<HOST:PORT>]",
+		Short:   "list the cached entries and their metadata",
+		Example: `cache admin list 127.0.0.1:7778 --chain-id ETH1 --limit 100`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainId, err := cmd.Flags().GetString(FlagAdminChainId)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(FlagAdminLimit)
+			if err != nil {
+				return err
+			}
+			client, conn, err := connectCacheAdmin(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			response, err := client.ListEntries(cmd.Context(), &pairingtypes.CacheListRequest{ChainId: chainId, Limit: limit})
+			if err != nil {
+				return err
+			}
+			for _, metadata := range response.Entries {
+				printCacheEntryMetadata(cmd, metadata)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagAdminChainId, "", "only list the entries of this chain")
+	cmd.Flags().Uint64(FlagAdminLimit, 1000, "the maximal amount of entries to list (0 for all)")
+	return cmd
+}
+
+func createCacheAdminEntryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "entry [admin-address<HOST:PORT>] [key<HEX>]",
+		Short:   "show the metadata of a cached entry by its key, as printed by list",
+		Example: `cache admin entry 127.0.0.1:7778 5f3a...`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid key %s: %w", args[1], err)
+			}
+			client, conn, err := connectCacheAdmin(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			metadata, err := client.GetEntry(cmd.Context(), &pairingtypes.CacheEntryRequest{Key: key})
+			if err != nil {
+				return err
+			}
+			printCacheEntryMetadata(cmd, *metadata)
+			return nil
+		},
+	}
+}
+
+func createCacheAdminInvalidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invalidate [admin-address<HOST:PORT>]",
+		Short: "remove the entries matching all the given filters from memory and from the persistent store",
+		Example: `cache admin invalidate 127.0.0.1:7778 --chain-id ETH1 --from-block 100 --to-block 200
+cache admin invalidate 127.0.0.1:7778 --request-hash 5f3a...`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainId, err := cmd.Flags().GetString(FlagAdminChainId)
+			if err != nil {
+				return err
+			}
+			requestHashHex, err := cmd.Flags().GetString(FlagAdminRequestHash)
+			if err != nil {
+				return err
+			}
+			requestHash, err := hex.DecodeString(requestHashHex)
+			if err != nil {
+				return fmt.Errorf("invalid request hash %s: %w", requestHashHex, err)
+			}
+			fromBlock, err := cmd.Flags().GetInt64(FlagAdminFromBlock)
+			if err != nil {
+				return err
+			}
+			toBlock, err := cmd.Flags().GetInt64(FlagAdminToBlock)
+			if err != nil {
+				return err
+			}
+			client, conn, err := connectCacheAdmin(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			response, err := client.Invalidate(cmd.Context(), &pairingtypes.CacheInvalidateRequest{
+				ChainId:     chainId,
+				RequestHash: requestHash,
+				FromBlock:   fromBlock,
+				ToBlock:     toBlock,
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("invalidated %d entries\n", response.Invalidated)
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagAdminChainId, "", "only invalidate the entries of this chain")
+	cmd.Flags().String(FlagAdminRequestHash, "", "only invalidate the entries of this request hash (hex, the key without the requested block)")
+	cmd.Flags().Int64(FlagAdminFromBlock, 0, "only invalidate the entries requested at this block or later")
+	cmd.Flags().Int64(FlagAdminToBlock, 0, "only invalidate the entries requested at this block or earlier")
+	return cmd
+}
+
+// WriteSnapshotEntry appends an entry to a snapshot file, entries are stored length delimited
+func WriteSnapshotEntry(writer io.Writer, entry *pairingtypes.CacheSnapshotEntry) error {
+	data, err := entry.Marshal()
+	if err != nil {
+		return err
+	}
+	lengthPrefix := binary.AppendUvarint(nil, uint64(len(data)))
+	if _, err = writer.Write(lengthPrefix); err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
+
+// ReadSnapshotEntry reads the next entry of a snapshot file, returning io.EOF when there are no more entries
+func ReadSnapshotEntry(reader *bufio.Reader) (*pairingtypes.CacheSnapshotEntry, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxSnapshotEntrySize {
+		return nil, fmt.Errorf("snapshot entry size %d exceeds the limit %d", length, maxSnapshotEntrySize)
+	}
+	data := make([]byte, length)
+	if _, err = io.ReadFull(reader, data); err != nil {
+		return nil, err
+	}
+	entry := &pairingtypes.CacheSnapshotEntry{}
+	err = entry.Unmarshal(data)
+	return entry, err
+}
+
+func createCacheAdminExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export [admin-address<HOST:PORT>] [file]",
+		Short:   "write a snapshot of the cached entries to a file",
+		Example: `cache admin export 127.0.0.1:7778 cache.snapshot --chain-id ETH1`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainId, err := cmd.Flags().GetString(FlagAdminChainId)
+			if err != nil {
+				return err
+			}
+			client, conn, err := connectCacheAdmin(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			stream, err := client.Export(cmd.Context(), &pairingtypes.CacheExportRequest{ChainId: chainId})
+			if err != nil {
+				return err
+			}
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			writer := bufio.NewWriter(file)
+			exported := 0
+			for {
+				entry, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				if err = WriteSnapshotEntry(writer, entry); err != nil {
+					return err
+				}
+				exported++
+			}
+			if err = writer.Flush(); err != nil {
+				return err
+			}
+			cmd.Printf("exported %d entries to %s\n", exported, args[1])
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagAdminChainId, "", "only export the entries of this chain")
+	return cmd
+}
+
+func createCacheAdminImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "import [admin-address<HOST:PORT>] [file]",
+		Short:   "load a snapshot written by export into the cache, non finalized entries that expired since are skipped",
+		Example: `cache admin import 127.0.0.1:7778 cache.snapshot`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			client, conn, err := connectCacheAdmin(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			stream, err := client.Import(cmd.Context())
+			if err != nil {
+				return err
+			}
+			reader := bufio.NewReader(file)
+			for {
+				entry, err := ReadSnapshotEntry(reader)
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				if err = stream.Send(entry); err != nil {
+					return err
+				}
+			}
+			response, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+			cmd.Printf("imported %d entries from %s\n", response.Imported, args[1])
+			return nil
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package cache_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/ecosystem/cache"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func initAdminTest(t *testing.T) (context.Context, *cache.RelayerCacheServer, pairingtypes.RelayerCacheAdminClient) {
+	ctx, cacheServer := initTest()
+	cacheServer.CacheServer.EnableEntriesIndex()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := cache.NewCacheAdminGrpcServer(cacheServer.CacheServer, "")
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return ctx, cacheServer, pairingtypes.NewRelayerCacheAdminClient(conn)
+}
+
+func setAdminTestEntries(t *testing.T, ctx context.Context, cacheServer *cache.RelayerCacheServer, blocks []int64, finalized bool) [][]byte {
+	requestHashes := [][]byte{}
+	for _, block := range blocks {
+		request := getRequest(block, []byte(StubSig), StubApiInterface)
+		requestHash := HashRequest(t, request, StubChainID)
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    requestHash,
+			BlockHash:      []byte{1, 2, 3},
+			ChainId:        StubChainID,
+			Response:       &pairingtypes.RelayReply{Data: []byte(StubData)},
+			Finalized:      finalized,
+			RequestedBlock: block,
+			SeenBlock:      block,
+		})
+		require.NoError(t, err)
+		requestHashes = append(requestHashes, requestHash)
+	}
+	time.Sleep(3 * time.Millisecond)
+	return requestHashes
+}
+
+func TestCacheAdminListAndInvalidate(t *testing.T) {
+	ctx, cacheServer, client := initAdminTest(t)
+	setAdminTestEntries(t, ctx, cacheServer, []int64{100, 200, 300}, true)
+	setAdminTestEntries(t, ctx, cacheServer, []int64{400}, false)
+
+	list, err := client.ListEntries(ctx, &pairingtypes.CacheListRequest{ChainId: StubChainID})
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 4)
+	for _, metadata := range list.Entries {
+		require.Equal(t, StubChainID, metadata.ChainId)
+		require.Equal(t, metadata.RequestedBlock, metadata.SeenBlock)
+		require.Positive(t, metadata.Size_)
+		require.Positive(t, metadata.Ttl)
+		if metadata.RequestedBlock == 400 {
+			require.Equal(t, "temp_cache", metadata.Source)
+		} else {
+			require.Equal(t, "finalized_cache", metadata.Source)
+		}
+		entry, err := client.GetEntry(ctx, &pairingtypes.CacheEntryRequest{Key: metadata.Key})
+		require.NoError(t, err)
+		require.Equal(t, metadata.RequestedBlock, entry.RequestedBlock)
+	}
+
+	list, err = client.ListEntries(ctx, &pairingtypes.CacheListRequest{ChainId: StubChainID, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 2)
+	list, err = client.ListEntries(ctx, &pairingtypes.CacheListRequest{ChainId: "other-chain"})
+	require.NoError(t, err)
+	require.Empty(t, list.Entries)
+
+	_, err = client.Invalidate(ctx, &pairingtypes.CacheInvalidateRequest{})
+	require.Error(t, err)
+
+	invalidated, err := client.Invalidate(ctx, &pairingtypes.CacheInvalidateRequest{ChainId: StubChainID, FromBlock: 150, ToBlock: 400})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), invalidated.Invalidated)
+
+	list, err = client.ListEntries(ctx, &pairingtypes.CacheListRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 1)
+	require.Equal(t, int64(100), list.Entries[0].RequestedBlock)
+
+	// invalidated entries are a cache miss
+	request := getRequest(200, []byte(StubSig), StubApiInterface)
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+		RequestHash:    HashRequest(t, request, StubChainID),
+		ChainId:        StubChainID,
+		Finalized:      true,
+		RequestedBlock: request.RequestBlock,
+	})
+	require.Error(t, err)
+}
+
+func TestCacheAdminExportImport(t *testing.T) {
+	ctx, cacheServer, client := initAdminTest(t)
+	blocks := []int64{100, 200}
+	requestHashes := setAdminTestEntries(t, ctx, cacheServer, blocks, true)
+
+	stream, err := client.Export(ctx, &pairingtypes.CacheExportRequest{ChainId: StubChainID})
+	require.NoError(t, err)
+	snapshot := &bytes.Buffer{}
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, cache.WriteSnapshotEntry(snapshot, entry))
+	}
+
+	// import the snapshot into a fresh cache server
+	ctx, importedCacheServer, importClient := initAdminTest(t)
+	importStream, err := importClient.Import(ctx)
+	require.NoError(t, err)
+	reader := bufio.NewReader(snapshot)
+	for {
+		entry, err := cache.ReadSnapshotEntry(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importStream.Send(entry))
+	}
+	response, err := importStream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(blocks)), response.Imported)
+	time.Sleep(3 * time.Millisecond)
+
+	for idx, block := range blocks {
+		reply, err := importedCacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+			RequestHash:    requestHashes[idx],
+			ChainId:        StubChainID,
+			Finalized:      true,
+			RequestedBlock: block,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte(StubData), reply.Reply.Data)
+		require.Equal(t, block, reply.SeenBlock)
+	}
+}
+
+func TestCacheAdminToken(t *testing.T) {
+	ctx, cacheServer := initTest()
+	cacheServer.CacheServer.EnableEntriesIndex()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := cache.NewCacheAdminGrpcServer(cacheServer.CacheServer, "secret")
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	client := pairingtypes.NewRelayerCacheAdminClient(conn)
+
+	// requests without the token or with a wrong one are refused, including streams
+	_, err = client.Invalidate(ctx, &pairingtypes.CacheInvalidateRequest{ChainId: StubChainID})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	wrongCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer wrong")
+	_, err = client.ListEntries(wrongCtx, &pairingtypes.CacheListRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	stream, err := client.Export(ctx, &pairingtypes.CacheExportRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authorizedCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer secret")
+	_, err = client.ListEntries(authorizedCtx, &pairingtypes.CacheListRequest{})
+	require.NoError(t, err)
+}
+
+func TestCacheAdminListenAddress(t *testing.T) {
+	require.NoError(t, cache.CheckAdminListenAddress("127.0.0.1:7778", ""))
+	require.NoError(t, cache.CheckAdminListenAddress("localhost:7778", ""))
+	require.NoError(t, cache.CheckAdminListenAddress("[::1]:7778", ""))
+	require.Error(t, cache.CheckAdminListenAddress("0.0.0.0:7778", ""))
+	require.Error(t, cache.CheckAdminListenAddress(":7778", ""))
+	require.NoError(t, cache.CheckAdminListenAddress("0.0.0.0:7778", "secret"))
+}
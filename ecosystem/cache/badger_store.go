@@ -0,0 +1,556 @@
+package cache
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	badgerValuePrefix         = "v/"
+	badgerIndexPrefix         = "i/"
+	badgerIndexChainSeparator = 0
+	BadgerStoreSweepInterval  = time.Minute
+	// when the size limit is exceeded we evict down to this ratio of the max size so we don't evict on every write
+	badgerStoreEvictionTarget = 0.9
+	// entries that expire by ttl are accounted in buckets of this many seconds, and leave the accounting once their
+	// bucket has passed
+	badgerStoreExpiryBucketSeconds = 60
+)
+
+// BadgerStore is a disk backed CacheStore, entries are kept with an index ordered by chain and seen block
+// so when the store grows beyond its size limit the entries furthest behind their chain's latest block are evicted first
+type BadgerStore struct {
+	db      *badger.DB
+	maxSize int64
+	ttl     time.Duration
+	// lock guards the size accounting and the latest blocks, and is held for writing across every update to the
+	// values and their index so the size accounting always matches what is stored
+	lock         sync.RWMutex
+	totalSize    int64
+	entries      int64
+	expiring     map[int64]expiryBucket // expiry bucket end (unix seconds) -> accounting of the entries expiring in it
+	needsRecount bool                   // set when an update failed, so the accounting may not match what is stored
+	latestBlocks map[string]int64       // chainId -> latest seen block stored
+	sweepTrigger chan struct{}
+	closed       chan struct{}
+	closeOnce    sync.Once
+}
+
+var _ CacheStore = (*BadgerStore)(nil)
+
+type expiryBucket struct {
+	size    int64
+	entries int64
+}
+
+func expiryBucketOf(expiresAt uint64) int64 {
+	return int64((expiresAt + badgerStoreExpiryBucketSeconds - 1) / badgerStoreExpiryBucketSeconds * badgerStoreExpiryBucketSeconds)
+}
+
+// NewBadgerStore opens (or creates) a persistent store at path, maxSize is the total size in bytes of the stored values
+// (0 means unlimited) and ttl is the expiration of each entry (0 means entries are only removed by eviction)
+func NewBadgerStore(path string, maxSize int64, ttl time.Duration) (*BadgerStore, error) {
+	options := badger.DefaultOptions(path)
+	options.Logger = nil
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, err
+	}
+	store := &BadgerStore{
+		db:           db,
+		maxSize:      maxSize,
+		ttl:          ttl,
+		expiring:     map[int64]expiryBucket{},
+		latestBlocks: map[string]int64{},
+		sweepTrigger: make(chan struct{}, 1),
+		closed:       make(chan struct{}),
+	}
+	store.lock.Lock()
+	err = store.recountIndex()
+	store.lock.Unlock()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	go store.sweepRoutine()
+	return store, nil
+}
+
+func valueKey(key []byte) []byte {
+	return append([]byte(badgerValuePrefix), key...)
+}
+
+func chainIndexPrefix(chainId string) []byte {
+	prefix := append([]byte(badgerIndexPrefix), chainId...)
+	return append(prefix, badgerIndexChainSeparator)
+}
+
+func indexKey(chainId string, seenBlock int64, key []byte) []byte {
+	index := chainIndexPrefix(chainId)
+	index = binary.BigEndian.AppendUint64(index, uint64(seenBlock))
+	return append(index, key...)
+}
+
+func parseIndexKey(index []byte) (chainId string, seenBlock int64, key []byte, err error) {
+	index = index[len(badgerIndexPrefix):]
+	for idx, char := range index {
+		if char != badgerIndexChainSeparator {
+			continue
+		}
+		rest := index[idx+1:]
+		if len(rest) < 8 {
+			break
+		}
+		return string(index[:idx]), int64(binary.BigEndian.Uint64(rest[:8])), rest[8:], nil
+	}
+	return "", 0, nil, fmt.Errorf("invalid index key %x", index)
+}
+
+// a stored record carries the chain id so the index entry can be found when a key is overwritten or deleted
+func encodeRecord(chainId string, value CacheValue) ([]byte, error) {
+	encodedValue, err := encodeCacheValue(value)
+	if err != nil {
+		return nil, err
+	}
+	record := make([]byte, 0, binary.MaxVarintLen64+len(chainId)+len(encodedValue))
+	record = binary.AppendUvarint(record, uint64(len(chainId)))
+	record = append(record, chainId...)
+	return append(record, encodedValue...), nil
+}
+
+func decodeRecord(record []byte) (chainId string, value CacheValue, err error) {
+	chainLen, read := binary.Uvarint(record)
+	if read <= 0 || uint64(len(record)-read) < chainLen {
+		return "", CacheValue{}, fmt.Errorf("invalid cache record encoding, length %d", len(record))
+	}
+	record = record[read:]
+	value, err = decodeCacheValue(record[chainLen:])
+	return string(record[:chainLen]), value, err
+}
+
+// expiresAt returns the expiry of an entry written now, in unix seconds (0 when entries don't expire)
+func (bs *BadgerStore) expiresAt() uint64 {
+	if bs.ttl <= 0 {
+		return 0
+	}
+	return uint64(time.Now().Add(bs.ttl).Unix())
+}
+
+func newEntry(key []byte, value []byte, expiresAt uint64) *badger.Entry {
+	entry := badger.NewEntry(key, value)
+	entry.ExpiresAt = expiresAt
+	return entry
+}
+
+// account adds a stored entry to the size accounting, the caller must hold the lock for writing
+func (bs *BadgerStore) account(size int64, expiresAt uint64) {
+	bs.totalSize += size
+	bs.entries++
+	if expiresAt == 0 {
+		return
+	}
+	bucketKey := expiryBucketOf(expiresAt)
+	bucket := bs.expiring[bucketKey]
+	bucket.size += size
+	bucket.entries++
+	bs.expiring[bucketKey] = bucket
+}
+
+// unaccount removes a deleted or evicted entry from the size accounting, the caller must hold the lock for writing
+func (bs *BadgerStore) unaccount(size int64, expiresAt uint64) {
+	if expiresAt != 0 {
+		bucketKey := expiryBucketOf(expiresAt)
+		bucket, found := bs.expiring[bucketKey]
+		if !found {
+			return // already left the accounting when its bucket expired
+		}
+		bucket.size -= size
+		bucket.entries--
+		if bucket.entries <= 0 {
+			delete(bs.expiring, bucketKey)
+		} else {
+			bs.expiring[bucketKey] = bucket
+		}
+	}
+	bs.totalSize -= size
+	bs.entries--
+}
+
+// dropExpired removes the entries that expired by ttl from the size accounting, since they are removed by badger
+// without going through Delete. the caller must hold the lock for writing
+func (bs *BadgerStore) dropExpired(now time.Time) {
+	for bucketKey, bucket := range bs.expiring {
+		if bucketKey <= now.Unix() {
+			bs.totalSize -= bucket.size
+			bs.entries -= bucket.entries
+			delete(bs.expiring, bucketKey)
+		}
+	}
+}
+
+func (bs *BadgerStore) Get(key []byte) (chainId string, value CacheValue, found bool) {
+	var record []byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(valueKey(key))
+		if err != nil {
+			return err
+		}
+		record, err = item.ValueCopy(nil)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, badger.ErrKeyNotFound) {
+			utils.LavaFormatWarning("failed reading cache entry from persistent store", err)
+		}
+		return "", CacheValue{}, false
+	}
+	chainId, value, err = decodeRecord(record)
+	if err != nil {
+		utils.LavaFormatWarning("failed decoding cache entry from persistent store", err)
+		return "", CacheValue{}, false
+	}
+	return chainId, value, true
+}
+
+// deletedEntry is the accounting of an entry removed in a transaction
+type deletedEntry struct {
+	found     bool
+	size      int64
+	expiresAt uint64
+}
+
+// deleteInTxn removes the value and its index entry, returning the accounting of the removed entry
+func deleteInTxn(txn *badger.Txn, key []byte) (deletedEntry, error) {
+	item, err := txn.Get(valueKey(key))
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return deletedEntry{}, nil
+		}
+		return deletedEntry{}, err
+	}
+	record, err := item.ValueCopy(nil)
+	if err != nil {
+		return deletedEntry{}, err
+	}
+	chainId, value, err := decodeRecord(record)
+	if err != nil {
+		return deletedEntry{}, err
+	}
+	err = txn.Delete(indexKey(chainId, value.SeenBlock, key))
+	if err != nil {
+		return deletedEntry{}, err
+	}
+	deleted := deletedEntry{found: true, size: int64(len(record)), expiresAt: item.ExpiresAt()}
+	return deleted, txn.Delete(valueKey(key))
+}
+
+func (bs *BadgerStore) Set(chainId string, key []byte, value CacheValue) error {
+	record, err := encodeRecord(chainId, value)
+	if err != nil {
+		return err
+	}
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+	// the value and its index entry expire together
+	expiresAt := bs.expiresAt()
+	var deleted deletedEntry
+	err = bs.db.Update(func(txn *badger.Txn) error {
+		deleted, err = deleteInTxn(txn, key)
+		if err != nil {
+			return err
+		}
+		sizeBytes := binary.BigEndian.AppendUint64(nil, uint64(len(record)))
+		err = txn.SetEntry(newEntry(indexKey(chainId, value.SeenBlock, key), sizeBytes, expiresAt))
+		if err != nil {
+			return err
+		}
+		return txn.SetEntry(newEntry(valueKey(key), record, expiresAt))
+	})
+	if err != nil {
+		bs.needsRecount = true
+		return err
+	}
+	if bs.latestBlocks[chainId] < value.SeenBlock {
+		bs.latestBlocks[chainId] = value.SeenBlock
+	}
+	if deleted.found {
+		bs.unaccount(deleted.size, deleted.expiresAt)
+	}
+	bs.account(int64(len(record)), expiresAt)
+	if bs.maxSize > 0 && bs.totalSize > bs.maxSize {
+		select {
+		case bs.sweepTrigger <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (bs *BadgerStore) Delete(key []byte) error {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+	var deleted deletedEntry
+	err := bs.db.Update(func(txn *badger.Txn) (err error) {
+		deleted, err = deleteInTxn(txn, key)
+		return err
+	})
+	if err != nil {
+		bs.needsRecount = true
+		return err
+	}
+	if deleted.found {
+		bs.unaccount(deleted.size, deleted.expiresAt)
+	}
+	return nil
+}
+
+func (bs *BadgerStore) chains() []string {
+	bs.lock.RLock()
+	defer bs.lock.RUnlock()
+	chains := make([]string, 0, len(bs.latestBlocks))
+	for chainId := range bs.latestBlocks {
+		chains = append(chains, chainId)
+	}
+	return chains
+}
+
+func (bs *BadgerStore) Iterate(callback func(chainId string, key []byte, value CacheValue) bool) error {
+	return bs.db.View(func(txn *badger.Txn) error {
+		for _, chainId := range bs.chains() {
+			prefix := chainIndexPrefix(chainId)
+			options := badger.DefaultIteratorOptions
+			options.PrefetchValues = false
+			options.Reverse = true
+			options.Prefix = prefix
+			it := txn.NewIterator(options)
+			for it.Seek(append(append([]byte{}, prefix...), 0xff)); it.ValidForPrefix(prefix); it.Next() {
+				_, _, key, err := parseIndexKey(it.Item().Key())
+				if err != nil {
+					continue
+				}
+				item, err := txn.Get(valueKey(key))
+				if err != nil {
+					continue // expired between the index and the value read
+				}
+				record, err := item.ValueCopy(nil)
+				if err != nil {
+					it.Close()
+					return err
+				}
+				_, value, err := decodeRecord(record)
+				if err != nil {
+					continue
+				}
+				if !callback(chainId, key, value) {
+					it.Close()
+					return nil
+				}
+			}
+			it.Close()
+		}
+		return nil
+	})
+}
+
+// recountIndex replaces the size accounting with a fresh scan of the index, it runs when the store is opened and
+// after a failed update. the caller must hold the lock for writing, so no update can land between the scan and the
+// replacement
+func (bs *BadgerStore) recountIndex() error {
+	bs.totalSize = 0
+	bs.entries = 0
+	bs.expiring = map[int64]expiryBucket{}
+	err := bs.db.View(func(txn *badger.Txn) error {
+		options := badger.DefaultIteratorOptions
+		options.Prefix = []byte(badgerIndexPrefix)
+		it := txn.NewIterator(options)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			chainId, seenBlock, _, err := parseIndexKey(item.Key())
+			if err != nil {
+				return err
+			}
+			sizeBytes, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if len(sizeBytes) == 8 {
+				bs.account(int64(binary.BigEndian.Uint64(sizeBytes)), item.ExpiresAt())
+			}
+			if bs.latestBlocks[chainId] < seenBlock {
+				bs.latestBlocks[chainId] = seenBlock
+			}
+		}
+		return nil
+	})
+	bs.needsRecount = err != nil
+	return err
+}
+
+func (bs *BadgerStore) Size() int64 {
+	bs.lock.RLock()
+	defer bs.lock.RUnlock()
+	return bs.totalSize
+}
+
+// Entries returns the number of stored entries
+func (bs *BadgerStore) Entries() int64 {
+	bs.lock.RLock()
+	defer bs.lock.RUnlock()
+	return bs.entries
+}
+
+func (bs *BadgerStore) sweepRoutine() {
+	ticker := time.NewTicker(BadgerStoreSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bs.closed:
+			return
+		case <-ticker.C:
+		case <-bs.sweepTrigger:
+		}
+		err := bs.EnforceSizeLimit()
+		if err != nil {
+			utils.LavaFormatWarning("failed enforcing persistent cache size limit", err)
+		}
+	}
+}
+
+type indexCursor struct {
+	it      *badger.Iterator
+	prefix  []byte
+	chainId string
+	latest  int64
+	age     int64
+}
+
+// cursors are ordered so the entry that is furthest behind its chain's latest block is popped first
+type indexCursorHeap []*indexCursor
+
+func (h indexCursorHeap) Len() int           { return len(h) }
+func (h indexCursorHeap) Less(i, j int) bool { return h[i].age > h[j].age }
+func (h indexCursorHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *indexCursorHeap) Push(x any)        { *h = append(*h, x.(*indexCursor)) }
+func (h *indexCursorHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// EnforceSizeLimit drops the entries that expired by ttl from the size accounting (recounting the index only after a
+// failed update) and evicts the oldest entries by block age until the store is back under its limit. writes wait
+// while it runs so they can't race the eviction's size accounting
+func (bs *BadgerStore) EnforceSizeLimit() error {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+	select {
+	case <-bs.closed:
+		return nil // a triggered sweep can get here after the store was closed
+	default:
+	}
+	if bs.needsRecount {
+		err := bs.recountIndex()
+		if err != nil {
+			return err
+		}
+	}
+	bs.dropExpired(time.Now())
+	totalSize := bs.totalSize
+	if bs.maxSize <= 0 || totalSize <= bs.maxSize {
+		return nil
+	}
+	toFree := totalSize - int64(float64(bs.maxSize)*badgerStoreEvictionTarget)
+	victims := [][]byte{}
+	evicted := []deletedEntry{}
+	err := bs.db.View(func(txn *badger.Txn) error {
+		cursors := indexCursorHeap{}
+		for chainId, latest := range bs.latestBlocks {
+			prefix := chainIndexPrefix(chainId)
+			options := badger.DefaultIteratorOptions
+			options.Prefix = prefix
+			it := txn.NewIterator(options)
+			defer it.Close()
+			it.Seek(prefix)
+			if !it.ValidForPrefix(prefix) {
+				continue
+			}
+			cursor := &indexCursor{it: it, prefix: prefix, chainId: chainId, latest: latest}
+			_, seenBlock, _, err := parseIndexKey(it.Item().Key())
+			if err != nil {
+				continue
+			}
+			cursor.age = latest - seenBlock
+			cursors = append(cursors, cursor)
+		}
+		heap.Init(&cursors)
+		var freed int64
+		for freed < toFree && cursors.Len() > 0 {
+			cursor := cursors[0]
+			item := cursor.it.Item()
+			victims = append(victims, item.KeyCopy(nil))
+			_, _, key, err := parseIndexKey(item.Key())
+			if err == nil {
+				victims = append(victims, valueKey(key))
+			}
+			sizeBytes, err := item.ValueCopy(nil)
+			if err == nil && len(sizeBytes) == 8 {
+				size := int64(binary.BigEndian.Uint64(sizeBytes))
+				evicted = append(evicted, deletedEntry{found: true, size: size, expiresAt: item.ExpiresAt()})
+				freed += size
+			}
+			cursor.it.Next()
+			if !cursor.it.ValidForPrefix(cursor.prefix) {
+				heap.Pop(&cursors)
+				continue
+			}
+			_, seenBlock, _, err := parseIndexKey(cursor.it.Item().Key())
+			if err != nil {
+				heap.Pop(&cursors)
+				continue
+			}
+			cursor.age = cursor.latest - seenBlock
+			heap.Fix(&cursors, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	writeBatch := bs.db.NewWriteBatch()
+	defer writeBatch.Cancel()
+	for _, victim := range victims {
+		err = writeBatch.Delete(victim)
+		if err != nil {
+			bs.needsRecount = true
+			return err
+		}
+	}
+	err = writeBatch.Flush()
+	if err != nil {
+		bs.needsRecount = true
+		return err
+	}
+	for _, entry := range evicted {
+		bs.unaccount(entry.size, entry.expiresAt)
+	}
+	utils.LavaFormatDebug("evicted entries from persistent cache", utils.LogAttr("evicted", len(evicted)), utils.LogAttr("size", totalSize), utils.LogAttr("max_size", bs.maxSize))
+	return nil
+}
+
+func (bs *BadgerStore) Close() error {
+	bs.closeOnce.Do(func() { close(bs.closed) })
+	// wait for a running sweep or write before closing the db under it
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+	return bs.db.Close()
+}
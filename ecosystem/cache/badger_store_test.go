@@ -0,0 +1,199 @@
+package cache_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/ecosystem/cache"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func initPersistentTest(t *testing.T, path string, warmLoadItems int) (context.Context, *cache.RelayerCacheServer) {
+	ctx, cacheServer := initTest()
+	store, err := cache.NewBadgerStore(path, 0, time.Hour)
+	require.NoError(t, err)
+	cacheServer.CacheServer.InitFinalizedStore(store, warmLoadItems)
+	return ctx, cacheServer
+}
+
+func TestPersistentStoreSurvivesRestart(t *testing.T) {
+	for _, warmLoadItems := range []int{0, 10} {
+		t.Run(fmt.Sprintf("warm load %d", warmLoadItems), func(t *testing.T) {
+			path := t.TempDir()
+			ctx, cacheServer := initPersistentTest(t, path, warmLoadItems)
+			request := getRequest(1230, []byte(StubSig), StubApiInterface)
+			response := &pairingtypes.RelayReply{Data: []byte(StubData)}
+			_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+				RequestHash:    HashRequest(t, request, StubChainID),
+				ChainId:        StubChainID,
+				Response:       response,
+				Finalized:      true,
+				RequestedBlock: request.RequestBlock,
+				SeenBlock:      1300,
+			})
+			require.NoError(t, err)
+			cacheServer.CacheServer.Close()
+
+			// a new server on the same path must reply from the persisted entry
+			ctx, cacheServer = initPersistentTest(t, path, warmLoadItems)
+			defer cacheServer.CacheServer.Close()
+			reply, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+				RequestHash:    HashRequest(t, request, StubChainID),
+				ChainId:        StubChainID,
+				Finalized:      true,
+				RequestedBlock: request.RequestBlock,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []byte(StubData), reply.Reply.Data)
+			require.Equal(t, int64(1300), reply.SeenBlock)
+		})
+	}
+}
+
+func TestPersistentStoreEvictsByBlockAge(t *testing.T) {
+	path := t.TempDir()
+	store, err := cache.NewBadgerStore(path, 0, 0)
+	require.NoError(t, err)
+
+	set := func(chainId string, seenBlock int64) []byte {
+		key := []byte(fmt.Sprintf("%s-%d", chainId, seenBlock))
+		err := store.Set(chainId, key, cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}, SeenBlock: seenBlock})
+		require.NoError(t, err)
+		return key
+	}
+	// chains with very different heights, eviction must be relative to each chain's latest block
+	lowChainOld := set("low", 10)
+	lowChainNew := set("low", 100)
+	highChainOld := set("high", 1_000_000)
+	highChainNew := set("high", 1_000_050)
+	totalSize := store.Size()
+	entrySize := totalSize / 4
+	require.NoError(t, store.Close())
+
+	// reopen with room for two entries, the entries furthest behind their chain's latest block go first
+	maxSize := entrySize*2 + entrySize/2
+	store, err = cache.NewBadgerStore(path, maxSize, 0)
+	require.NoError(t, err)
+	defer store.Close()
+	require.Equal(t, totalSize, store.Size())
+	require.NoError(t, store.EnforceSizeLimit())
+	_, _, found := store.Get(lowChainOld)
+	require.False(t, found)
+	_, _, found = store.Get(highChainOld)
+	require.False(t, found)
+	_, _, found = store.Get(lowChainNew)
+	require.True(t, found)
+	_, _, found = store.Get(highChainNew)
+	require.True(t, found)
+	require.LessOrEqual(t, store.Size(), maxSize)
+}
+
+func TestPersistentStoreLoadIndexSize(t *testing.T) {
+	path := t.TempDir()
+	store, err := cache.NewBadgerStore(path, 0, 0)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		err := store.Set("chain", []byte(fmt.Sprintf("key-%d", i)), cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}, SeenBlock: int64(100 + i)})
+		require.NoError(t, err)
+	}
+	entrySize := store.Size() / 10
+	// overwriting an entry replaces its size and deleting an entry frees it
+	require.NoError(t, store.Set("chain", []byte("key-0"), cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}, SeenBlock: 110}))
+	require.NoError(t, store.Delete([]byte("key-1")))
+	totalSize := store.Size()
+	require.Equal(t, entrySize*9, totalSize)
+	require.NoError(t, store.Close())
+
+	// the size is restored from the index when the store is opened again
+	store, err = cache.NewBadgerStore(path, 0, 0)
+	require.NoError(t, err)
+	defer store.Close()
+	require.Equal(t, totalSize, store.Size())
+	require.NoError(t, store.EnforceSizeLimit())
+	require.Equal(t, totalSize, store.Size())
+}
+
+func TestPersistentStoreConcurrentEviction(t *testing.T) {
+	path := t.TempDir()
+	store, err := cache.NewBadgerStore(path, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, store.Set("chain", []byte("probe"), cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}}))
+	entrySize := store.Size()
+	require.NoError(t, store.Close())
+
+	store, err = cache.NewBadgerStore(path, entrySize*20, 0)
+	require.NoError(t, err)
+	wg := sync.WaitGroup{}
+	for writer := 0; writer < 4; writer++ {
+		wg.Add(1)
+		go func(writer int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", writer, i%30))
+				err := store.Set("chain", key, cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}, SeenBlock: int64(100 + i)})
+				require.NoError(t, err)
+			}
+		}(writer)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			require.NoError(t, store.EnforceSizeLimit())
+		}
+	}()
+	wg.Wait()
+	totalSize := store.Size()
+	entries := store.Entries()
+	require.NoError(t, store.Close())
+
+	// the running totals must match a fresh scan of what was actually stored
+	store, err = cache.NewBadgerStore(path, 0, 0)
+	require.NoError(t, err)
+	defer store.Close()
+	require.Equal(t, store.Size(), totalSize)
+	require.Equal(t, store.Entries(), entries)
+}
+
+func TestPersistentStoreEvictionAccounting(t *testing.T) {
+	path := t.TempDir()
+	store, err := cache.NewBadgerStore(path, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, store.Set("chain", []byte("key-pp"), cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}, SeenBlock: 100}))
+	entrySize := store.Size()
+	require.NoError(t, store.Close())
+
+	// with ttl the index and the values expire together, and the accounting follows every update without a rescan
+	store, err = cache.NewBadgerStore(path, entrySize*10, time.Hour)
+	require.NoError(t, err)
+	defer store.Close()
+	require.Equal(t, int64(1), store.Entries())
+	for i := 0; i < 20; i++ {
+		err := store.Set("chain", []byte(fmt.Sprintf("key-%02d", i)), cache.CacheValue{Response: pairingtypes.RelayReply{Data: make([]byte, 100)}, SeenBlock: int64(100 + i)})
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(21), store.Entries())
+	require.Equal(t, entrySize*21, store.Size())
+	require.NoError(t, store.Delete([]byte("key-19")))
+	require.NoError(t, store.Delete([]byte("missing")))
+	require.Equal(t, int64(20), store.Entries())
+
+	// eviction goes down to 90% of the max size and subtracts exactly what it removed
+	require.NoError(t, store.EnforceSizeLimit())
+	require.Equal(t, int64(9), store.Entries())
+	require.Equal(t, entrySize*9, store.Size())
+	found := 0
+	for i := 0; i < 20; i++ {
+		if _, _, ok := store.Get([]byte(fmt.Sprintf("key-%02d", i))); ok {
+			found++
+		}
+	}
+	if _, _, ok := store.Get([]byte("key-pp")); ok {
+		found++
+	}
+	require.Equal(t, 9, found)
+}
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// amount of blocks behind the latest tracked block we keep indexing non finalized entries for
+const MaxTrackedBlocksPerChain = 1000
+
+type trackedBlock struct {
+	entries   map[string][]byte // cache key -> stored block hash
+	expiresAt time.Time         // the latest expiry of the block's entries, the block is dropped once they all expired
+}
+
+type chainBlockIndex struct {
+	blocks map[int64]*trackedBlock
+	latest int64
+}
+
+// prune drops the blocks too far behind the latest tracked block and the blocks whose entries all expired, so blocks
+// that are never covered by a notification don't stay tracked
+func (cbi *chainBlockIndex) prune(now time.Time) {
+	for block, tracked := range cbi.blocks {
+		if block < cbi.latest-MaxTrackedBlocksPerChain || !tracked.expiresAt.After(now) {
+			delete(cbi.blocks, block)
+		}
+	}
+}
+
+// blockHashIndex keeps track of the non finalized entries stored with a block hash, so they can be purged
+// when their block is orphaned by a reorg, or promoted to the finalized cache once their block is finalized
+type blockHashIndex struct {
+	lock   sync.Mutex
+	chains map[string]*chainBlockIndex
+}
+
+func newBlockHashIndex() *blockHashIndex {
+	return &blockHashIndex{chains: map[string]*chainBlockIndex{}}
+}
+
+// track indexes a non finalized entry stored with a block hash, ttl is the expiration of the entry in the cache
+func (bhi *blockHashIndex) track(chainId string, block int64, cacheKey []byte, hash []byte, ttl time.Duration) {
+	if len(hash) == 0 {
+		return
+	}
+	bhi.lock.Lock()
+	defer bhi.lock.Unlock()
+	chain, ok := bhi.chains[chainId]
+	if !ok {
+		chain = &chainBlockIndex{blocks: map[int64]*trackedBlock{}}
+		bhi.chains[chainId] = chain
+	}
+	if block < chain.latest-MaxTrackedBlocksPerChain {
+		// the entries of this block will expire before anyone notifies about it
+		return
+	}
+	if block > chain.latest {
+		chain.latest = block
+	}
+	now := time.Now()
+	tracked, ok := chain.blocks[block]
+	if !ok {
+		// a new block is tracked about once per chain block, so pruning here keeps the index bounded without a sweep
+		chain.prune(now)
+		tracked = &trackedBlock{entries: map[string][]byte{}}
+		chain.blocks[block] = tracked
+	}
+	tracked.entries[string(cacheKey)] = hash
+	if expiresAt := now.Add(ttl); expiresAt.After(tracked.expiresAt) {
+		tracked.expiresAt = expiresAt
+	}
+}
+
+func (bhi *blockHashIndex) untrack(chainId string, block int64, cacheKey []byte) {
+	bhi.lock.Lock()
+	defer bhi.lock.Unlock()
+	chain, ok := bhi.chains[chainId]
+	if !ok {
+		return
+	}
+	tracked, ok := chain.blocks[block]
+	if !ok {
+		return
+	}
+	delete(tracked.entries, string(cacheKey))
+	if len(tracked.entries) == 0 {
+		delete(chain.blocks, block)
+	}
+}
+
+// reconcile compares the tracked entries to the canonical hashes, returning the keys stored with a hash that is no longer
+// canonical and the keys whose block is now finalized with a matching hash. all finalized blocks stop being tracked
+func (bhi *blockHashIndex) reconcile(chainId string, canonical map[int64][]byte, finalizedBlock int64) (orphaned [][]byte, finalized [][]byte) {
+	bhi.lock.Lock()
+	defer bhi.lock.Unlock()
+	chain, ok := bhi.chains[chainId]
+	if !ok {
+		return nil, nil
+	}
+	for block, tracked := range chain.blocks {
+		canonicalHash, known := canonical[block]
+		for cacheKey, hash := range tracked.entries {
+			if known && !bytes.Equal(hash, canonicalHash) {
+				orphaned = append(orphaned, []byte(cacheKey))
+				delete(tracked.entries, cacheKey)
+				continue
+			}
+			if block <= finalizedBlock && known {
+				finalized = append(finalized, []byte(cacheKey))
+			}
+		}
+		if block <= finalizedBlock || len(tracked.entries) == 0 {
+			delete(chain.blocks, block)
+		}
+	}
+	return orphaned, finalized
+}
+
+func (bhi *blockHashIndex) trackedBlocks(chainId string) int {
+	bhi.lock.Lock()
+	defer bhi.lock.Unlock()
+	chain, ok := bhi.chains[chainId]
+	if !ok {
+		return 0
+	}
+	return len(chain.blocks)
+}
+
+// TrackedBlocks returns the amount of blocks of a chain with non finalized entries waiting for a block hashes notification
+func (cs *CacheServer) TrackedBlocks(chainId string) int {
+	return cs.blockHashes.trackedBlocks(chainId)
+}
@@ -278,8 +278,9 @@ func TestCacheSetGetLatest(t *testing.T) {
 		{name: "new latest with hash, Non Finalized Hash update latest", valid: true, delay: time.Millisecond, finalized: false, hash: []byte{1, 2, 3}, latestBlockForSetRelay: 1420, latestIsCorrect: true},
 		{name: "new latest with hash, Finalized With Hash, with existing latest entry", valid: true, delay: time.Millisecond, finalized: true, hash: []byte{1, 2, 3}, latestBlockForSetRelay: 1270, latestIsCorrect: false},
 		{name: "new latest with hash, NonFinalized With Hash, with existing latest entry", valid: true, delay: time.Millisecond, finalized: false, hash: []byte{1, 2, 3}, latestBlockForSetRelay: 1290, latestIsCorrect: false},
-		{name: "new latest with hash, Finalized With Hash, with existing latest entry", valid: false, delay: time.Millisecond, finalized: true, hash: nil, latestBlockForSetRelay: 1270, latestIsCorrect: false},
-		{name: "new latest with hash, NonFinalized With Hash, with existing latest entry", valid: false, delay: time.Millisecond, finalized: false, hash: nil, latestBlockForSetRelay: 1290, latestIsCorrect: false},
+		// the finalized get with a matching hash promoted the latest entry to the finalized cache, so it no longer requires a hash
+		{name: "new latest with hash, Finalized With Hash, with existing latest entry", valid: true, delay: time.Millisecond, finalized: true, hash: nil, latestBlockForSetRelay: 1270, latestIsCorrect: false},
+		{name: "new latest with hash, NonFinalized With Hash, with existing latest entry", valid: true, delay: time.Millisecond, finalized: false, hash: nil, latestBlockForSetRelay: 1290, latestIsCorrect: false},
 	}
 
 	var latestBlockForRelay int64 = 0
@@ -516,3 +517,126 @@ func TestCacheSetGetJsonRPCWithID(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheBlockHashesUpdate(t *testing.T) {
+	t.Parallel()
+	storedHash := []byte{1, 2, 3}
+	tests := []struct {
+		name           string
+		canonicalHash  []byte
+		finalizedBlock int64
+		validStored    bool // a get with the stored hash still hits
+		validFinalized bool // a finalized get without a hash hits, meaning the entry was promoted
+	}{
+		{name: "Same Hash Not Finalized", canonicalHash: storedHash, finalizedBlock: 1200, validStored: true, validFinalized: false},
+		{name: "Same Hash Finalized", canonicalHash: storedHash, finalizedBlock: 1230, validStored: true, validFinalized: true},
+		{name: "Reorg Not Finalized", canonicalHash: []byte{4, 5, 6}, finalizedBlock: 1200, validStored: false, validFinalized: false},
+		{name: "Reorg Finalized", canonicalHash: []byte{4, 5, 6}, finalizedBlock: 1230, validStored: false, validFinalized: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cacheServer := initTest()
+			request := getRequest(1230, []byte(StubSig), StubApiInterface)
+			_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+				RequestHash:    HashRequest(t, request, StubChainID),
+				BlockHash:      storedHash,
+				ChainId:        StubChainID,
+				Response:       &pairingtypes.RelayReply{},
+				Finalized:      false,
+				RequestedBlock: request.RequestBlock,
+			})
+			require.NoError(t, err)
+			time.Sleep(3 * time.Millisecond)
+
+			_, err = cacheServer.NotifyBlockHashes(ctx, &pairingtypes.BlockHashesUpdate{
+				ChainId:        StubChainID,
+				Hashes:         []pairingtypes.BlockHashEntry{{Block: 1229, Hash: []byte{7}}, {Block: request.RequestBlock, Hash: tt.canonicalHash}},
+				FinalizedBlock: tt.finalizedBlock,
+			})
+			require.NoError(t, err)
+			time.Sleep(3 * time.Millisecond)
+
+			_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+				RequestHash:    HashRequest(t, request, StubChainID),
+				BlockHash:      storedHash,
+				ChainId:        StubChainID,
+				RequestedBlock: request.RequestBlock,
+			})
+			if tt.validStored {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+				RequestHash:    HashRequest(t, request, StubChainID),
+				ChainId:        StubChainID,
+				Finalized:      true,
+				RequestedBlock: request.RequestBlock,
+			})
+			if tt.validFinalized {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCacheBlockHashesBounded(t *testing.T) {
+	t.Parallel()
+	setWithHash := func(ctx context.Context, cacheServer *cache.RelayerCacheServer, block int64) {
+		request := getRequest(block, []byte(StubSig), StubApiInterface)
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    HashRequest(t, request, StubChainID),
+			BlockHash:      []byte{1, 2, 3},
+			ChainId:        StubChainID,
+			Response:       &pairingtypes.RelayReply{},
+			Finalized:      false,
+			RequestedBlock: request.RequestBlock,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("height", func(t *testing.T) {
+		// no notification ever arrives, only the blocks close to the latest one stay tracked
+		ctx, cacheServer := initTest()
+		for block := int64(1); block <= 3*cache.MaxTrackedBlocksPerChain; block++ {
+			setWithHash(ctx, cacheServer, block)
+		}
+		require.LessOrEqual(t, cacheServer.CacheServer.TrackedBlocks(StubChainID), cache.MaxTrackedBlocksPerChain+1)
+		// a block already too far behind isn't tracked at all
+		tracked := cacheServer.CacheServer.TrackedBlocks(StubChainID)
+		setWithHash(ctx, cacheServer, 1)
+		require.Equal(t, tracked, cacheServer.CacheServer.TrackedBlocks(StubChainID))
+	})
+
+	t.Run("expiration", func(t *testing.T) {
+		// entries stored with a hash expire like finalized entries, their blocks are dropped once they all expired
+		ctx := context.Background()
+		cs := cache.CacheServer{CacheMaxCost: 2 * 1024 * 1024 * 1024}
+		cs.InitCache(ctx, 10*time.Millisecond, cache.DefaultExpirationForNonFinalized, cache.DisabledFlagOption)
+		cacheServer := &cache.RelayerCacheServer{CacheServer: &cs}
+		setWithHash(ctx, cacheServer, 100)
+		setWithHash(ctx, cacheServer, 101)
+		require.Equal(t, 2, cs.TrackedBlocks(StubChainID))
+		time.Sleep(20 * time.Millisecond)
+		setWithHash(ctx, cacheServer, 102)
+		require.Equal(t, 1, cs.TrackedBlocks(StubChainID))
+	})
+}
+
+func TestCacheHashRestQueryOrder(t *testing.T) {
+	t.Parallel()
+	request := getRequest(1230, nil, spectypes.APIInterfaceRest)
+	request.ApiUrl = "/cosmos/tx/v1beta1/txs?events=a&pagination.limit=10"
+	reorderedRequest := shallowCopy(request)
+	reorderedRequest.ApiUrl = "/cosmos//tx/v1beta1/txs?pagination.limit=10&events=a"
+	require.Equal(t, HashRequest(t, request, StubChainID), HashRequest(t, reorderedRequest, StubChainID))
+	// hashing doesn't modify the request
+	require.Equal(t, "/cosmos//tx/v1beta1/txs?pagination.limit=10&events=a", reorderedRequest.ApiUrl)
+	// a trailing slash may be answered differently by the node, so it is kept apart
+	trailingSlashRequest := shallowCopy(request)
+	trailingSlashRequest.ApiUrl = "/cosmos/tx/v1beta1/txs/?events=a&pagination.limit=10"
+	require.NotEqual(t, HashRequest(t, request, StubChainID), HashRequest(t, trailingSlashRequest, StubChainID))
+}
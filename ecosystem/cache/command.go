@@ -44,5 +44,12 @@ longer DefaultExpirationForNonFinalized will reduce sync QoS for "latest" reques
 	cacheCmd.Flags().Duration(ExpirationNonFinalizedFlagName, DefaultExpirationForNonFinalized, "how long does a cache entry lasts in the cache for a non finalized entry")
 	cacheCmd.Flags().String(FlagMetricsAddress, DisabledFlagOption, "address to listen to prometheus metrics 127.0.0.1:5555, later you can curl http://127.0.0.1:5555/metrics")
 	cacheCmd.Flags().Int64(FlagCacheSizeName, 2*1024*1024*1024, "the maximal amount of entries to save")
+	cacheCmd.Flags().String(FlagPersistentPathName, "", "directory of a disk backed store for finalized entries, kept across restarts (empty disables it)")
+	cacheCmd.Flags().Int64(FlagPersistentMaxSizeName, 20*1024*1024*1024, "the maximal size in bytes of the persistent store, oldest entries by block age are evicted first (0 for unlimited)")
+	cacheCmd.Flags().Duration(FlagPersistentExpirationName, DefaultPersistentExpiration, "how long does a finalized entry lasts in the persistent store (0 for no expiration)")
+	cacheCmd.Flags().Int(FlagPersistentWarmLoadName, DefaultPersistentWarmLoadItems, "amount of entries loaded from the persistent store to memory on startup")
+	cacheCmd.Flags().String(FlagAdminAddress, DisabledFlagOption, "address to listen to admin grpc requests 127.0.0.1:7778, used by the cache admin subcommand to list, invalidate, export and import entries. without a token it must be a loopback address")
+	cacheCmd.Flags().String(FlagAdminToken, "", "token required from admin requests (also read from "+AdminTokenEnv+"), can reference a secret such as env://NAME or file:///path")
+	cacheCmd.AddCommand(CreateCacheAdminCobraCommand())
 	return cacheCmd
 }
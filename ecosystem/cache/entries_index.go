@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+)
+
+type indexedEntry struct {
+	requestedBlock int64
+	seenBlock      int64
+	finalized      bool
+	size           int64
+	expiresAt      time.Time
+}
+
+type indexedEntryWithKey struct {
+	indexedEntry
+	chainId string
+	key     []byte
+}
+
+// entriesIndex keeps the metadata of the entries set in the in memory caches, as ristretto can't be iterated.
+// it is only maintained when the admin api is enabled, entries evicted by ristretto are dropped when they are listed
+type entriesIndex struct {
+	lock   sync.RWMutex
+	chains map[string]map[string]indexedEntry // chainId -> cache key -> entry
+}
+
+func newEntriesIndex() *entriesIndex {
+	return &entriesIndex{chains: map[string]map[string]indexedEntry{}}
+}
+
+// requestedBlockFromCacheKey reverses formatHashKey
+func requestedBlockFromCacheKey(cacheKey []byte) (requestHash []byte, requestedBlock int64) {
+	if len(cacheKey) < 8 {
+		return cacheKey, 0
+	}
+	split := len(cacheKey) - 8
+	return cacheKey[:split], int64(binary.LittleEndian.Uint64(cacheKey[split:]))
+}
+
+func (ei *entriesIndex) add(chainId string, cacheKey []byte, value CacheValue, finalized bool, ttl time.Duration) {
+	if ei == nil {
+		return
+	}
+	_, requestedBlock := requestedBlockFromCacheKey(cacheKey)
+	ei.lock.Lock()
+	defer ei.lock.Unlock()
+	entries, ok := ei.chains[chainId]
+	if !ok {
+		entries = map[string]indexedEntry{}
+		ei.chains[chainId] = entries
+	}
+	entries[string(cacheKey)] = indexedEntry{
+		requestedBlock: requestedBlock,
+		seenBlock:      value.SeenBlock,
+		finalized:      finalized,
+		size:           value.Cost(),
+		expiresAt:      time.Now().Add(ttl),
+	}
+}
+
+func (ei *entriesIndex) remove(chainId string, cacheKey []byte) {
+	if ei == nil {
+		return
+	}
+	ei.lock.Lock()
+	defer ei.lock.Unlock()
+	delete(ei.chains[chainId], string(cacheKey))
+}
+
+func (ei *entriesIndex) find(cacheKey []byte) (indexedEntryWithKey, bool) {
+	if ei == nil {
+		return indexedEntryWithKey{}, false
+	}
+	ei.lock.RLock()
+	defer ei.lock.RUnlock()
+	for chainId, entries := range ei.chains {
+		if entry, ok := entries[string(cacheKey)]; ok && entry.expiresAt.After(time.Now()) {
+			return indexedEntryWithKey{indexedEntry: entry, chainId: chainId, key: cacheKey}, true
+		}
+	}
+	return indexedEntryWithKey{}, false
+}
+
+// entries returns the non expired entries of a chain (or all chains for an empty chainId) and prunes the expired ones
+func (ei *entriesIndex) entries(chainId string) []indexedEntryWithKey {
+	if ei == nil {
+		return nil
+	}
+	ei.lock.Lock()
+	defer ei.lock.Unlock()
+	now := time.Now()
+	result := []indexedEntryWithKey{}
+	for indexedChainId, entries := range ei.chains {
+		if chainId != "" && chainId != indexedChainId {
+			continue
+		}
+		for cacheKey, entry := range entries {
+			if !entry.expiresAt.After(now) {
+				delete(entries, cacheKey)
+				continue
+			}
+			result = append(result, indexedEntryWithKey{indexedEntry: entry, chainId: indexedChainId, key: []byte(cacheKey)})
+		}
+	}
+	return result
+}
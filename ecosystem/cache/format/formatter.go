@@ -4,19 +4,35 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
-// input formatter works on the input data
-func FormatterForRelayRequestAndResponse(apiInterface string) (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
+// input formatter works on the input data, apiUrl is the path for rest and the method for grpc
+func FormatterForRelayRequestAndResponse(apiInterface string, apiUrl string) (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
 	switch apiInterface {
 	case spectypes.APIInterfaceJsonRPC:
 		return FormatterForRelayRequestAndResponseJsonRPC()
 	case spectypes.APIInterfaceTendermintRPC:
 		// tendermint has json rpc input as well
 		return FormatterForRelayRequestAndResponseJsonRPC()
+	case spectypes.APIInterfaceRest:
+		return FormatterForRelayRequestAndResponseRest()
+	case spectypes.APIInterfaceGrpc:
+		return FormatterForRelayRequestAndResponseGrpc(apiUrl)
 	default:
 		return IdentityFormatter()
 	}
 }
 
+// api url formatter works on the url of the request
+func FormatterForApiUrl(apiInterface string) func(string) string {
+	switch apiInterface {
+	case spectypes.APIInterfaceRest:
+		return FormatterForApiUrlRest
+	default:
+		return func(apiUrl string) string {
+			return apiUrl
+		}
+	}
+}
+
 func IdentityFormatter() (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
 	inputFormatter = func(inpData []byte) []byte {
 		return inpData
@@ -3,15 +3,98 @@ package format
 import (
 	"testing"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func TestFormatter(t *testing.T) {
-	inputFormatter, outputFormatter := FormatterForRelayRequestAndResponse(spectypes.APIInterfaceJsonRPC)
+	inputFormatter, outputFormatter := FormatterForRelayRequestAndResponse(spectypes.APIInterfaceJsonRPC, "")
 	data := `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}]`
 	inputData := inputFormatter([]byte(data))
 	require.NotEqual(t, string(inputData), data)
 	outData := outputFormatter(inputData)
 	require.Equal(t, string(outData), data)
 }
+
+func TestFormatterApiUrlRest(t *testing.T) {
+	urlFormatter := FormatterForApiUrl(spectypes.APIInterfaceRest)
+	tests := []struct {
+		name     string
+		urls     []string
+		expected string
+	}{
+		{name: "query order", urls: []string{"/cosmos/tx/v1beta1/txs?events=a&pagination.limit=10", "/cosmos/tx/v1beta1/txs?pagination.limit=10&events=a"}, expected: "/cosmos/tx/v1beta1/txs?events=a&pagination.limit=10"},
+		{name: "path formatting", urls: []string{"/cosmos/bank/v1beta1/balances/lava@123", "/cosmos//bank/v1beta1/balances/lava@123", "/cosmos/bank/v1beta1/./balances/lava@123"}, expected: "/cosmos/bank/v1beta1/balances/lava@123"},
+		{name: "trailing slash", urls: []string{"/cosmos//bank/v1beta1/balances/lava@123/", "/cosmos/bank/v1beta1/./balances/lava@123//"}, expected: "/cosmos/bank/v1beta1/balances/lava@123/"},
+		{name: "root", urls: []string{"/", "/./"}, expected: "/"},
+		{name: "escaping", urls: []string{"/blocks/latest?q=a+b", "/blocks/latest?q=a%20b"}, expected: "/blocks/latest?q=a+b"},
+		{name: "empty query", urls: []string{"/blocks/latest?", "/blocks/latest"}, expected: "/blocks/latest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, apiUrl := range tt.urls {
+				require.Equal(t, tt.expected, urlFormatter(apiUrl))
+			}
+		})
+	}
+	// a trailing slash is part of the key
+	require.NotEqual(t, urlFormatter("/foo/"), urlFormatter("/foo"))
+	// encoded separators and dots belong to their segment
+	require.Equal(t, "/ibc/apps/transfer/v1/denom_traces/ibc%2FABC", urlFormatter("/ibc/apps//transfer/v1/denom_traces/ibc%2FABC"))
+	require.NotEqual(t, urlFormatter("/ibc/apps/transfer/v1/denom_traces/ibc%2FABC"), urlFormatter("/ibc/apps/transfer/v1/denom_traces/ibc/ABC"))
+	require.Equal(t, "/a/%2e%2e/b", urlFormatter("/a/%2e%2e/b"))
+	require.NotEqual(t, urlFormatter("/a/%2e%2e/b"), urlFormatter("/a/../b"))
+	require.Equal(t, "/b", urlFormatter("/a/../b"))
+	// repeated values keep their order
+	require.NotEqual(t, urlFormatter("/txs?events=a&events=b"), urlFormatter("/txs?events=b&events=a"))
+	// other interfaces are untouched
+	require.Equal(t, "/a//b?z=1&a=2", FormatterForApiUrl(spectypes.APIInterfaceJsonRPC)("/a//b?z=1&a=2"))
+}
+
+func TestFormatterRestBody(t *testing.T) {
+	inputFormatter, outputFormatter := FormatterForRelayRequestAndResponse(spectypes.APIInterfaceRest, "/cosmos/tx/v1beta1/simulate")
+	first := inputFormatter([]byte(`{"tx_bytes":"abc", "amount": 12345678901234567890}`))
+	second := inputFormatter([]byte(`{"amount":12345678901234567890,"tx_bytes":"abc"}`))
+	require.Equal(t, string(first), string(second))
+	require.Equal(t, `{"amount":12345678901234567890,"tx_bytes":"abc"}`, string(first))
+	require.Equal(t, "not json", string(inputFormatter([]byte("not json"))))
+	require.Equal(t, "reply", string(outputFormatter([]byte("reply"))))
+}
+
+func TestFormatterGrpc(t *testing.T) {
+	// a registered method is re-marshaled with its descriptor, field order and explicit defaults don't matter
+	method := "cosmos.bank.v1beta1.Query/Balance"
+	inputFormatter, outputFormatter := FormatterForRelayRequestAndResponse(spectypes.APIInterfaceGrpc, method)
+	canonical, err := (&banktypes.QueryBalanceRequest{Address: "lava@123", Denom: "ulava"}).Marshal()
+	require.NoError(t, err)
+	reordered := protowire.AppendTag(nil, 2, protowire.BytesType)
+	reordered = protowire.AppendString(reordered, "ulava")
+	reordered = protowire.AppendTag(reordered, 1, protowire.BytesType)
+	reordered = protowire.AppendString(reordered, "lava@123")
+	require.NotEqual(t, canonical, reordered)
+	require.Equal(t, inputFormatter(canonical), inputFormatter(reordered))
+	require.Equal(t, canonical, outputFormatter(canonical))
+	// an explicitly encoded empty address is only dropped by the descriptor based re-marshal
+	withDefault, err := (&banktypes.QueryBalanceRequest{Denom: "ulava"}).Marshal()
+	require.NoError(t, err)
+	withDefault = append(protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), ""), withDefault...)
+	withoutDefault, err := (&banktypes.QueryBalanceRequest{Denom: "ulava"}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, inputFormatter(withoutDefault), inputFormatter(withDefault))
+
+	// an unknown method only gets its top level fields sorted
+	inputFormatter, _ = FormatterForRelayRequestAndResponse(spectypes.APIInterfaceGrpc, "unknown.Service/Method")
+	require.Equal(t, inputFormatter(canonical), inputFormatter(reordered))
+	repeated := protowire.AppendTag(nil, 3, protowire.VarintType)
+	repeated = protowire.AppendVarint(repeated, 1)
+	repeated = protowire.AppendTag(repeated, 3, protowire.VarintType)
+	repeated = protowire.AppendVarint(repeated, 2)
+	reversed := protowire.AppendTag(nil, 3, protowire.VarintType)
+	reversed = protowire.AppendVarint(reversed, 2)
+	reversed = protowire.AppendTag(reversed, 3, protowire.VarintType)
+	reversed = protowire.AppendVarint(reversed, 1)
+	require.NotEqual(t, inputFormatter(repeated), inputFormatter(reversed))
+	require.Equal(t, []byte("\xff"), inputFormatter([]byte("\xff")))
+}
@@ -0,0 +1,87 @@
+package format
+
+import (
+	"sort"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// re-marshaling the request deterministically so equivalent protobuf bodies serialized differently share cache entries.
+// when the method is registered we re-marshal with its input descriptor, otherwise we only reorder the top level fields
+func FormatterForRelayRequestAndResponseGrpc(method string) (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
+	inputFormatter = func(inpData []byte) []byte {
+		if len(inpData) == 0 {
+			return inpData
+		}
+		if inputDescriptor := grpcMethodInputDescriptor(method); inputDescriptor != nil {
+			msg := dynamicpb.NewMessage(inputDescriptor)
+			if err := proto.Unmarshal(inpData, msg); err == nil {
+				modifiedInp, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+				if err == nil {
+					return modifiedInp
+				}
+			}
+		}
+		return sortTopLevelProtoFields(inpData)
+	}
+	outputFormatter = func(inpData []byte) []byte {
+		return inpData
+	}
+	return inputFormatter, outputFormatter
+}
+
+func grpcMethodInputDescriptor(method string) protoreflect.MessageDescriptor {
+	// method is formatted as package.Service/Method
+	method = strings.TrimPrefix(method, "/")
+	serviceName, methodName, found := strings.Cut(method, "/")
+	if !found {
+		return nil
+	}
+	descriptor, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(methodName))
+	if methodDescriptor == nil {
+		return nil
+	}
+	return methodDescriptor.Input()
+}
+
+// without a descriptor nested fields can't be told apart from bytes, so only the top level order is normalized,
+// the sort is stable so repeated fields and last-one-wins scalars keep their meaning
+func sortTopLevelProtoFields(inpData []byte) []byte {
+	type rawField struct {
+		number protowire.Number
+		raw    []byte
+	}
+	fields := []rawField{}
+	data := inpData
+	for len(data) > 0 {
+		number, wireType, tagLen := protowire.ConsumeTag(data)
+		if tagLen < 0 {
+			return inpData
+		}
+		valueLen := protowire.ConsumeFieldValue(number, wireType, data[tagLen:])
+		if valueLen < 0 {
+			return inpData
+		}
+		fields = append(fields, rawField{number: number, raw: data[:tagLen+valueLen]})
+		data = data[tagLen+valueLen:]
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].number < fields[j].number })
+	modifiedInp := make([]byte, 0, len(inpData))
+	for _, field := range fields {
+		modifiedInp = append(modifiedInp, field.raw...)
+	}
+	return modifiedInp
+}
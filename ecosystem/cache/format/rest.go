@@ -0,0 +1,91 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+)
+
+// normalizing the url so requests that differ only in query param order or path formatting share cache entries
+func FormatterForApiUrlRest(apiUrl string) string {
+	urlObj, err := url.Parse(apiUrl)
+	if err != nil {
+		return apiUrl
+	}
+	if urlObj.Path != "" {
+		// cleaning the escaped form keeps encoded separators and dots inside their segment, "a%2Fb" and "a/b" are
+		// different endpoints for the node
+		cleanPath := cleanEscapedPath(urlObj.EscapedPath())
+		unescapedPath, err := url.PathUnescape(cleanPath)
+		if err != nil {
+			return apiUrl
+		}
+		urlObj.Path = unescapedPath
+		urlObj.RawPath = cleanPath
+	}
+	// Encode sorts by key and keeps the order of repeated values, as it may be meaningful
+	urlObj.RawQuery = urlObj.Query().Encode()
+	urlObj.ForceQuery = false
+	urlObj.Fragment = ""
+	urlObj.RawFragment = ""
+	return urlObj.String()
+}
+
+// cleanEscapedPath resolves empty, "." and ".." segments like path.Clean, only literal dots count so encoded ones
+// are kept as they are
+func cleanEscapedPath(escapedPath string) string {
+	rooted := strings.HasPrefix(escapedPath, "/")
+	segments := []string{}
+	for _, segment := range strings.Split(escapedPath, "/") {
+		switch segment {
+		case "", ".":
+		case "..":
+			if len(segments) > 0 && segments[len(segments)-1] != ".." {
+				segments = segments[:len(segments)-1]
+			} else if !rooted {
+				segments = append(segments, segment)
+			}
+		default:
+			segments = append(segments, segment)
+		}
+	}
+	cleanPath := strings.Join(segments, "/")
+	if rooted {
+		cleanPath = "/" + cleanPath
+	}
+	// nodes may answer "/foo/" differently from "/foo"
+	if strings.HasSuffix(escapedPath, "/") && cleanPath != "" && !strings.HasSuffix(cleanPath, "/") {
+		cleanPath += "/"
+	}
+	return cleanPath
+}
+
+// json bodies (POST) are compacted with sorted keys, the response is returned as is
+func FormatterForRelayRequestAndResponseRest() (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
+	inputFormatter = func(inpData []byte) []byte {
+		trimmed := bytes.TrimSpace(inpData)
+		if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+			return inpData
+		}
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.UseNumber() // avoid float conversions changing numbers
+		var body interface{}
+		err := decoder.Decode(&body)
+		if err != nil || decoder.More() {
+			return inpData
+		}
+		modifiedInp, err := json.Marshal(body) // maps are marshaled with sorted keys
+		if err != nil {
+			utils.LavaFormatWarning("failed to marshal rest body in cache formatter", err)
+			return inpData
+		}
+		return modifiedInp
+	}
+	outputFormatter = func(inpData []byte) []byte {
+		return inpData
+	}
+	return inputFormatter, outputFormatter
+}
@@ -31,6 +31,9 @@ var (
 const (
 	DbValueConfirmationAttempts = 5
 	SEP                         = ";"
+	tempCacheSource             = "temp_cache"
+	finalizedCacheSource        = "finalized_cache"
+	persistentCacheSource       = "persistent_cache"
 )
 
 type RelayerCacheServer struct {
@@ -179,7 +182,6 @@ func (s *RelayerCacheServer) getRelayInner(relayCacheGet *pairingtypes.RelayCach
 	// 3. seen block to distinguish between seen entries and unseen entries.
 	cacheKey := s.formatHashKey(relayCacheGet.RequestHash, relayCacheGet.RequestedBlock)
 	cacheVal, cache_source, found := s.findInAllCaches(relayCacheGet.Finalized, cacheKey)
-	// TODO: use the information when a new block is finalized
 	if !found {
 		return nil, NotFoundError
 	}
@@ -193,13 +195,24 @@ func (s *RelayerCacheServer) getRelayInner(relayCacheGet *pairingtypes.RelayCach
 	}
 	// entry found, now we check the hash requested and hash stored
 	if bytes.Equal(cacheVal.Hash, relayCacheGet.BlockHash) {
+		if relayCacheGet.Finalized && cache_source == tempCacheSource {
+			// the block became finalized with the hash we stored, so the entry no longer needs to expire quickly
+			s.promoteToFinalized(relayCacheGet.ChainId, cacheKey, cacheVal)
+			s.CacheServer.blockHashes.untrack(relayCacheGet.ChainId, relayCacheGet.RequestedBlock, cacheKey)
+		}
 		utils.LavaFormatDebug("returning response", utils.Attribute{Key: "cache_source", Value: cache_source},
 			utils.Attribute{Key: "hash", Value: "match"},
 			utils.Attribute{Key: "response_data", Value: parser.CapStringLen(string(cacheVal.Response.Data))},
 		)
 		return cacheVal.ToCacheReply(), nil
 	}
-	// TODO: handle case where we have hash stored and it became finalized
+	if relayCacheGet.Finalized && relayCacheGet.BlockHash != nil && cache_source == tempCacheSource {
+		// the requested block is finalized with a different hash, the stored entry belongs to an orphaned block
+		s.CacheServer.tempCache.Del(cacheKey)
+		s.CacheServer.blockHashes.untrack(relayCacheGet.ChainId, relayCacheGet.RequestedBlock, cacheKey)
+		s.CacheServer.entries.remove(relayCacheGet.ChainId, cacheKey)
+		utils.LavaFormatDebug("purged orphaned cache entry", utils.Attribute{Key: "chainId", Value: relayCacheGet.ChainId}, utils.Attribute{Key: "requested_block", Value: relayCacheGet.RequestedBlock})
+	}
 	return nil, HashMismatchError
 }
 
@@ -267,9 +280,14 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 	if relayCacheSet.Finalized {
 		cache := s.CacheServer.finalizedCache
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.CacheServer.ExpirationFinalized)
+		s.CacheServer.persistFinalized(relayCacheSet.ChainId, cacheKey, cacheValue)
+		s.CacheServer.entries.add(relayCacheSet.ChainId, cacheKey, cacheValue, true, s.CacheServer.ExpirationFinalized)
 	} else {
 		cache := s.CacheServer.tempCache
-		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.getExpirationForChain(time.Duration(relayCacheSet.AverageBlockTime), relayCacheSet.BlockHash))
+		expiration := s.getExpirationForChain(time.Duration(relayCacheSet.AverageBlockTime), relayCacheSet.BlockHash)
+		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), expiration)
+		s.CacheServer.blockHashes.track(relayCacheSet.ChainId, relayCacheSet.RequestedBlock, cacheKey, relayCacheSet.BlockHash, expiration)
+		s.CacheServer.entries.add(relayCacheSet.ChainId, cacheKey, cacheValue, false, expiration)
 	}
 	// Setting the seen block for shared state.
 	s.setSeenBlockOnSharedStateMode(relayCacheSet.ChainId, relayCacheSet.SharedStateId, latestKnownBlock)
@@ -277,6 +295,47 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 	return &emptypb.Empty{}, nil
 }
 
+// NotifyBlockHashes receives the canonical hashes of a chain, non finalized entries stored with a different hash for the same block
+// were served from an orphaned fork and are purged, entries of finalized blocks with a matching hash are promoted to the finalized cache
+func (s *RelayerCacheServer) NotifyBlockHashes(ctx context.Context, update *pairingtypes.BlockHashesUpdate) (*emptypb.Empty, error) {
+	canonical := make(map[int64][]byte, len(update.Hashes))
+	for _, entry := range update.Hashes {
+		canonical[entry.Block] = entry.Hash
+	}
+	orphaned, finalized := s.CacheServer.blockHashes.reconcile(update.ChainId, canonical, update.FinalizedBlock)
+	for _, cacheKey := range orphaned {
+		s.CacheServer.tempCache.Del(cacheKey)
+		s.CacheServer.entries.remove(update.ChainId, cacheKey)
+	}
+	for _, cacheKey := range finalized {
+		value, found := getNonExpiredFromCache(s.CacheServer.tempCache, cacheKey)
+		if !found {
+			continue
+		}
+		if cacheVal, ok := value.(CacheValue); ok {
+			s.promoteToFinalized(update.ChainId, cacheKey, cacheVal)
+		}
+	}
+	if len(orphaned) > 0 || len(finalized) > 0 {
+		utils.LavaFormatDebug("applied block hashes update",
+			utils.Attribute{Key: "chainId", Value: update.ChainId},
+			utils.Attribute{Key: "finalized_block", Value: update.FinalizedBlock},
+			utils.Attribute{Key: "purged", Value: len(orphaned)},
+			utils.Attribute{Key: "promoted", Value: len(finalized)},
+		)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// promoteToFinalized moves a non finalized entry whose block hash was confirmed by finalization to the finalized cache
+func (s *RelayerCacheServer) promoteToFinalized(chainId string, cacheKey []byte, cacheVal CacheValue) {
+	cacheVal.Hash = nil // no need to store the hash value for finalized entries
+	s.CacheServer.finalizedCache.SetWithTTL(cacheKey, cacheVal, cacheVal.Cost(), s.CacheServer.ExpirationFinalized)
+	s.CacheServer.persistFinalized(chainId, cacheKey, cacheVal)
+	s.CacheServer.tempCache.Del(cacheKey)
+	s.CacheServer.entries.add(chainId, cacheKey, cacheVal, true, s.CacheServer.ExpirationFinalized)
+}
+
 func (s *RelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*pairingtypes.CacheUsage, error) {
 	cacheHits := atomic.LoadUint64(&s.cacheHits)
 	cacheMisses := atomic.LoadUint64(&s.cacheMisses)
@@ -361,25 +420,33 @@ func (s *RelayerCacheServer) findInAllCaches(finalized bool, cacheKey []byte) (r
 			cache := s.CacheServer.finalizedCache
 			value, found := getNonExpiredFromCache(cache, cacheKey)
 			if found {
-				return value, "finalized_cache", true
+				return value, finalizedCacheSource, true
 			}
 			// if a key is finalized still doesn't mean it wasn't set when unfinalized
 			cache = s.CacheServer.tempCache
 			value, found = getNonExpiredFromCache(cache, cacheKey)
 			if found {
-				return value, "temp_cache", true
+				return value, tempCacheSource, true
+			}
+			value, found = s.CacheServer.getFromFinalizedStore(cacheKey)
+			if found {
+				return value, persistentCacheSource, true
 			}
 		} else {
 			// if something isn't finalized now it was never finalized, but sometimes when we don't have information we try to get a non finalized entry when in fact its finalized
 			cache := s.CacheServer.tempCache
 			value, found := getNonExpiredFromCache(cache, cacheKey)
 			if found {
-				return value, "temp_cache", true
+				return value, tempCacheSource, true
 			}
 			cache = s.CacheServer.finalizedCache
 			value, found = getNonExpiredFromCache(cache, cacheKey)
 			if found {
-				return value, "finalized_cache", true
+				return value, finalizedCacheSource, true
+			}
+			value, found = s.CacheServer.getFromFinalizedStore(cacheKey)
+			if found {
+				return value, persistentCacheSource, true
 			}
 		}
 
@@ -25,6 +25,12 @@ const (
 	ExpirationFlagName               = "expiration"
 	ExpirationNonFinalizedFlagName   = "expiration-non-finalized"
 	FlagCacheSizeName                = "max-items"
+	FlagPersistentPathName           = "persistent-path"
+	FlagPersistentMaxSizeName        = "persistent-max-size"
+	FlagPersistentExpirationName     = "persistent-expiration"
+	FlagPersistentWarmLoadName       = "persistent-warm-load"
+	DefaultPersistentExpiration      = 7 * 24 * time.Hour
+	DefaultPersistentWarmLoadItems   = 100000
 	DefaultExpirationForNonFinalized = 500 * time.Millisecond
 	DefaultExpirationTimeFinalized   = time.Hour
 	CacheNumCounters                 = 100000000 // expect 10M items
@@ -37,6 +43,9 @@ type CacheServer struct {
 	ExpirationNonFinalized time.Duration
 	CacheMetrics           *CacheMetrics
 	CacheMaxCost           int64
+	finalizedStore         CacheStore // optional persistent tier behind finalizedCache
+	blockHashes            *blockHashIndex
+	entries                *entriesIndex // only maintained when the admin api is enabled
 }
 
 func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration, expirationNonFinalized time.Duration, metricsAddr string) {
@@ -53,11 +62,52 @@ func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration,
 		utils.LavaFormatFatal("could not create finalized cache", err)
 	}
 	cs.finalizedCache = cache
+	cs.blockHashes = newBlockHashIndex()
 
 	// initialize prometheus
 	cs.CacheMetrics = NewCacheMetricsServer(metricsAddr)
 }
 
+// InitFinalizedStore sets a persistent store behind the finalized cache, the ristretto cache stays as a hot tier in front of it
+// and is warm loaded with up to warmLoadItems of the newest stored entries
+func (cs *CacheServer) InitFinalizedStore(store CacheStore, warmLoadItems int) {
+	cs.finalizedStore = store
+	cs.warmLoadHotTier(warmLoadItems)
+}
+
+func (cs *CacheServer) persistFinalized(chainId string, cacheKey []byte, cacheValue CacheValue) {
+	if cs.finalizedStore == nil {
+		return
+	}
+	err := cs.finalizedStore.Set(chainId, cacheKey, cacheValue)
+	if err != nil {
+		utils.LavaFormatWarning("failed writing finalized entry to persistent store", err, utils.LogAttr("chainId", chainId))
+	}
+}
+
+// getFromFinalizedStore reads from the persistent tier, entries found there are promoted back to the hot tier
+func (cs *CacheServer) getFromFinalizedStore(cacheKey []byte) (interface{}, bool) {
+	if cs.finalizedStore == nil {
+		return nil, false
+	}
+	_, cacheValue, found := cs.finalizedStore.Get(cacheKey)
+	if !found {
+		return nil, false
+	}
+	cs.finalizedCache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), cs.ExpirationFinalized)
+	return cacheValue, true
+}
+
+func (cs *CacheServer) Close() {
+	if cs.finalizedStore == nil {
+		return
+	}
+	err := cs.finalizedStore.Close()
+	if err != nil {
+		utils.LavaFormatError("failed closing persistent store", err)
+	}
+}
+
 func (cs *CacheServer) Serve(ctx context.Context,
 	listenAddr string,
 ) {
@@ -101,6 +151,7 @@ func (cs *CacheServer) Serve(ctx context.Context,
 		if err := httpServer.Shutdown(shutdownCtx); err != nil {
 			utils.LavaFormatFatal("Cache failed to shutdown", err)
 		}
+		cs.Close()
 	}()
 
 	Server := &RelayerCacheServer{CacheServer: cs}
@@ -141,6 +192,49 @@ func Server(
 	cs := CacheServer{CacheMaxCost: cacheMaxCost}
 
 	cs.InitCache(ctx, expiration, expirationNonFinalized, metricsAddr)
+
+	adminAddr, err := flags.GetString(FlagAdminAddress)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagAdminAddress})
+	}
+	if adminAddr != DisabledFlagOption {
+		cs.EnableEntriesIndex()
+	}
+
+	persistentPath, err := flags.GetString(FlagPersistentPathName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagPersistentPathName})
+	}
+	if persistentPath != "" {
+		persistentMaxSize, err := flags.GetInt64(FlagPersistentMaxSizeName)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagPersistentMaxSizeName})
+		}
+		persistentExpiration, err := flags.GetDuration(FlagPersistentExpirationName)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagPersistentExpirationName})
+		}
+		warmLoadItems, err := flags.GetInt(FlagPersistentWarmLoadName)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagPersistentWarmLoadName})
+		}
+		store, err := NewBadgerStore(persistentPath, persistentMaxSize, persistentExpiration)
+		if err != nil {
+			utils.LavaFormatFatal("failed to open persistent store", err, utils.Attribute{Key: "path", Value: persistentPath})
+		}
+		cs.InitFinalizedStore(store, warmLoadItems)
+	}
+	// the admin api reads the stores, so it is started once they are set
+	if adminAddr != DisabledFlagOption {
+		adminToken, err := flags.GetString(FlagAdminToken)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagAdminToken})
+		}
+		if adminToken == "" {
+			adminToken = os.Getenv(AdminTokenEnv)
+		}
+		go cs.ServeAdmin(ctx, adminAddr, adminToken)
+	}
 	// TODO: have a state tracker
 	cs.Serve(ctx, listenAddr)
 }
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// CacheStore is a storage backend for relay cache entries, it is used behind the in memory ristretto caches
+// so entries can outlive the process and be shared by everyone talking to the same cache server
+type CacheStore interface {
+	Get(key []byte) (chainId string, value CacheValue, found bool)
+	Set(chainId string, key []byte, value CacheValue) error
+	Delete(key []byte) error
+	// Iterate walks the stored entries of each chain from the newest seen block to the oldest, returning false from the callback stops the iteration
+	Iterate(callback func(chainId string, key []byte, value CacheValue) bool) error
+	Close() error
+}
+
+// encodeCacheValue serializes a CacheValue as [uvarint hash length][hash][CacheRelayReply proto]
+func encodeCacheValue(value CacheValue) ([]byte, error) {
+	reply := value.ToCacheReply()
+	replyBytes, err := reply.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	encoded := make([]byte, 0, binary.MaxVarintLen64+len(value.Hash)+len(replyBytes))
+	encoded = binary.AppendUvarint(encoded, uint64(len(value.Hash)))
+	encoded = append(encoded, value.Hash...)
+	encoded = append(encoded, replyBytes...)
+	return encoded, nil
+}
+
+func decodeCacheValue(encoded []byte) (CacheValue, error) {
+	hashLen, read := binary.Uvarint(encoded)
+	if read <= 0 || uint64(len(encoded)-read) < hashLen {
+		return CacheValue{}, fmt.Errorf("invalid cache value encoding, length %d", len(encoded))
+	}
+	encoded = encoded[read:]
+	var hash []byte
+	if hashLen > 0 {
+		hash = append([]byte{}, encoded[:hashLen]...)
+	}
+	reply := pairingtypes.CacheRelayReply{}
+	err := reply.Unmarshal(encoded[hashLen:])
+	if err != nil {
+		return CacheValue{}, err
+	}
+	value := CacheValue{
+		Hash:             hash,
+		OptionalMetadata: reply.OptionalMetadata,
+		SeenBlock:        reply.SeenBlock,
+	}
+	if reply.Reply != nil {
+		value.Response = *reply.Reply
+	}
+	return value, nil
+}
+
+// warmLoadHotTier fills the finalized ristretto cache from the persistent store so a restart doesn't start cold
+func (cs *CacheServer) warmLoadHotTier(maxItems int) {
+	if cs.finalizedStore == nil || maxItems <= 0 {
+		return
+	}
+	start := time.Now()
+	loaded := 0
+	err := cs.finalizedStore.Iterate(func(chainId string, key []byte, value CacheValue) bool {
+		cs.finalizedCache.SetWithTTL(key, value, value.Cost(), cs.ExpirationFinalized)
+		loaded++
+		return loaded < maxItems
+	})
+	cs.finalizedCache.Wait()
+	if err != nil {
+		utils.LavaFormatError("failed warm loading cache entries from persistent store", err, utils.LogAttr("loaded", loaded))
+		return
+	}
+	utils.LavaFormatInfo("warm loaded cache entries from persistent store", utils.LogAttr("loaded", loaded), utils.LogAttr("duration", time.Since(start)))
+}
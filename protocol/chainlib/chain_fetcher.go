@@ -479,22 +479,25 @@ func NewVerificationsOnlyChainFetcher(ctx context.Context, chainRouter ChainRout
 // couldn't be used in parallel
 func HashCacheRequest(relayData *pairingtypes.RelayPrivateData, chainId string) ([]byte, func([]byte) []byte, error) {
 	originalData := relayData.Data
+	originalApiUrl := relayData.ApiUrl
 	originalSalt := relayData.Salt
 	originalRequestedBlock := relayData.RequestBlock
 	originalSeenBlock := relayData.SeenBlock
 	defer func() {
 		// return all information back to the object on defer (in any case)
 		relayData.Data = originalData
+		relayData.ApiUrl = originalApiUrl
 		relayData.Salt = originalSalt
 		relayData.RequestBlock = originalRequestedBlock
 		relayData.SeenBlock = originalSeenBlock
 	}()
 
 	// we need to remove some data from the request so the cache will hit properly.
-	inputFormatter, outputFormatter := formatter.FormatterForRelayRequestAndResponse(relayData.ApiInterface)
-	relayData.Data = inputFormatter(relayData.Data) // remove id from request.
-	relayData.Salt = nil                            // remove salt
-	relayData.SeenBlock = 0                         // remove seen block
+	inputFormatter, outputFormatter := formatter.FormatterForRelayRequestAndResponse(relayData.ApiInterface, relayData.ApiUrl)
+	relayData.Data = inputFormatter(relayData.Data)                                           // remove id from request.
+	relayData.ApiUrl = formatter.FormatterForApiUrl(relayData.ApiInterface)(relayData.ApiUrl) // normalize query params order and path
+	relayData.Salt = nil                                                                      // remove salt
+	relayData.SeenBlock = 0                                                                   // remove seen block
 	// we remove the discrepancy of requested block from the hash, and add it on the cache side instead
 	// this is due to the fact that we don't know the latest seen block at this moment, as on shared state
 	// only the cache has this information. we make sure the hashing at this stage does not include the requested block.
@@ -2,18 +2,25 @@ package chainlib
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
 type chainRouterEntry struct {
 	ChainProxy
 	addonsSupported map[string]struct{}
+	nodeName        string
+	health          *nodeHealth
 }
 
 func (cre *chainRouterEntry) isSupporting(addon string) bool {
@@ -28,22 +35,32 @@ func (cre *chainRouterEntry) isSupporting(addon string) bool {
 
 type chainRouterImpl struct {
 	lock             *sync.RWMutex
-	chainProxyRouter map[lavasession.RouterKey][]chainRouterEntry
+	chainProxyRouter map[lavasession.RouterKey][]*chainRouterEntry
+	balancer         *nodeBalancer
+	providerMetrics  *metrics.ProviderMetricsManager
+	chainID          string
+	apiInterface     string
 }
 
-func (cri *chainRouterImpl) getChainProxySupporting(addon string, extensions []string) (ChainProxy, error) {
+// returns the nodes supporting the addon and extensions, ordered by the load balancing preference
+func (cri *chainRouterImpl) getChainProxiesSupporting(addon string, extensions []string) ([]*chainRouterEntry, error) {
 	cri.lock.RLock()
 	defer cri.lock.RUnlock()
 	wantedRouterKey := lavasession.NewRouterKey(extensions)
 	if chainProxyEntries, ok := cri.chainProxyRouter[wantedRouterKey]; ok {
+		supporting := make([]*chainRouterEntry, 0, len(chainProxyEntries))
 		for _, chainRouterEntry := range chainProxyEntries {
 			if chainRouterEntry.isSupporting(addon) {
-				return chainRouterEntry.ChainProxy, nil
+				supporting = append(supporting, chainRouterEntry)
+				continue
 			}
 			if debug {
 				utils.LavaFormatDebug("chainProxy supporting extensions but not supporting addon", utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "wantedRouterKey", Value: wantedRouterKey})
 			}
 		}
+		if len(supporting) > 0 {
+			return cri.balancer.order(supporting), nil
+		}
 		// no support for this addon
 		return nil, utils.LavaFormatError("no chain proxy supporting requested addon", nil, utils.Attribute{Key: "addon", Value: addon})
 	}
@@ -51,6 +68,16 @@ func (cri *chainRouterImpl) getChainProxySupporting(addon string, extensions []s
 	return nil, utils.LavaFormatError("no chain proxy supporting requested extensions", nil, utils.Attribute{Key: "extensions", Value: extensions})
 }
 
+func (cri *chainRouterImpl) allEntries() []*chainRouterEntry {
+	cri.lock.RLock()
+	defer cri.lock.RUnlock()
+	entries := []*chainRouterEntry{}
+	for _, chainProxyEntries := range cri.chainProxyRouter {
+		entries = append(entries, chainProxyEntries...)
+	}
+	return entries
+}
+
 func (cri chainRouterImpl) ExtensionsSupported(extensions []string) bool {
 	routerKey := lavasession.NewRouterKey(extensions)
 	_, ok := cri.chainProxyRouter[routerKey]
@@ -60,12 +87,28 @@ func (cri chainRouterImpl) ExtensionsSupported(extensions []string) bool {
 func (cri chainRouterImpl) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
 	// add the parsed addon from the apiCollection
 	addon := chainMessage.GetApiCollection().CollectionData.AddOn
-	selectedChainProxy, err := cri.getChainProxySupporting(addon, extensions)
+	selectedChainProxies, err := cri.getChainProxiesSupporting(addon, extensions)
 	if err != nil {
 		return nil, "", nil, common.NodeUrl{}, "", err
 	}
-	relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
-	proxyUrl, chainId = selectedChainProxy.GetChainProxyInformation()
+	for idx, selectedChainProxy := range selectedChainProxies {
+		atomic.AddInt64(&selectedChainProxy.health.inflight, 1)
+		sendTime := time.Now()
+		relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
+		atomic.AddInt64(&selectedChainProxy.health.inflight, -1)
+		proxyUrl, chainId = selectedChainProxy.GetChainProxyInformation()
+		if err == nil {
+			cri.onNodeSuccess(selectedChainProxy, time.Since(sendTime))
+			return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, nil
+		}
+		cri.onNodeFailure(selectedChainProxy)
+		// only fail over when the node never got the request, a node that did may have acted on it (a broadcast tx)
+		// and a subscription must not end up open on two nodes
+		if ch != nil || !isNodeUnreachableError(err) || ctx.Err() != nil || idx == len(selectedChainProxies)-1 {
+			break
+		}
+		utils.LavaFormatDebug("node failed, failing over to the next node", utils.LogAttr("GUID", ctx), utils.LogAttr("node", selectedChainProxy.nodeName), utils.LogAttr("error", err))
+	}
 	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
 }
 
@@ -80,6 +123,7 @@ func batchNodeUrlsByServices(rpcProviderEndpoint lavasession.RPCProviderEndpoint
 				ApiInterface:   rpcProviderEndpoint.ApiInterface,
 				Geolocation:    rpcProviderEndpoint.Geolocation,
 				NodeUrls:       []common.NodeUrl{nodeUrl}, // add existing nodeUrl to the batch
+				LoadBalancing:  rpcProviderEndpoint.LoadBalancing,
 			}
 		} else {
 			existingEndpoint.NodeUrls = append(existingEndpoint.NodeUrls, nodeUrl)
@@ -89,8 +133,55 @@ func batchNodeUrlsByServices(rpcProviderEndpoint lavasession.RPCProviderEndpoint
 	return returnedBatch
 }
 
-func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser, proxyConstructor func(context.Context, uint, lavasession.RPCProviderEndpoint, ChainParser) (ChainProxy, error)) (ChainRouter, error) {
-	chainProxyRouter := map[lavasession.RouterKey][]chainRouterEntry{}
+// splits the nodeUrls of a batch to the nodes serving it, urls that only work together stay in the same node:
+// internal paths of the same node, and the websocket and http urls of a tendermint node
+func splitBatchToNodes(rpcProviderEndpoint lavasession.RPCProviderEndpoint) []lavasession.RPCProviderEndpoint {
+	nodeUrlGroups := [][]common.NodeUrl{}
+	for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		if nodeUrl.InternalPath != "" {
+			return []lavasession.RPCProviderEndpoint{rpcProviderEndpoint}
+		}
+	}
+	if rpcProviderEndpoint.ApiInterface == spectypes.APIInterfaceTendermintRPC {
+		httpUrls, websocketUrls := []common.NodeUrl{}, []common.NodeUrl{}
+		for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+			if strings.HasPrefix(nodeUrl.Url, "ws") {
+				websocketUrls = append(websocketUrls, nodeUrl)
+			} else {
+				httpUrls = append(httpUrls, nodeUrl)
+			}
+		}
+		if len(httpUrls) <= 1 || (len(websocketUrls) != 0 && len(websocketUrls) != len(httpUrls)) {
+			// can't tell which urls belong to the same node
+			return []lavasession.RPCProviderEndpoint{rpcProviderEndpoint}
+		}
+		for idx, httpUrl := range httpUrls {
+			nodeUrlGroup := []common.NodeUrl{httpUrl}
+			if len(websocketUrls) > 0 {
+				nodeUrlGroup = append(nodeUrlGroup, websocketUrls[idx])
+			}
+			nodeUrlGroups = append(nodeUrlGroups, nodeUrlGroup)
+		}
+	} else {
+		for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+			nodeUrlGroups = append(nodeUrlGroups, []common.NodeUrl{nodeUrl})
+		}
+	}
+	nodes := make([]lavasession.RPCProviderEndpoint, 0, len(nodeUrlGroups))
+	for _, nodeUrlGroup := range nodeUrlGroups {
+		node := rpcProviderEndpoint
+		node.NodeUrls = nodeUrlGroup
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser, proxyConstructor func(context.Context, uint, lavasession.RPCProviderEndpoint, ChainParser) (ChainProxy, error), providerMetrics *metrics.ProviderMetricsManager) (ChainRouter, error) {
+	err := validateLoadBalancing(rpcProviderEndpoint.LoadBalancing)
+	if err != nil {
+		return nil, err
+	}
+	chainProxyRouter := map[lavasession.RouterKey][]*chainRouterEntry{}
 
 	requiredMap := map[requirementSt]struct{}{}
 	supportedMap := map[requirementSt]struct{}{}
@@ -117,19 +208,23 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 			return allExtensionsRouterKey
 		}
 		routerKey := updateRouteCombinations(extensions, addons)
-		chainProxy, err := proxyConstructor(ctx, nConns, rpcProviderEndpointEntry, chainParser)
-		if err != nil {
-			// TODO: allow some urls to be down
-			return nil, err
-		}
-		chainRouterEntryInst := chainRouterEntry{
-			ChainProxy:      chainProxy,
-			addonsSupported: addonsSupportedMap,
-		}
-		if chainRouterEntries, ok := chainProxyRouter[routerKey]; !ok {
-			chainProxyRouter[routerKey] = []chainRouterEntry{chainRouterEntryInst}
-		} else {
-			chainProxyRouter[routerKey] = append(chainRouterEntries, chainRouterEntryInst)
+		for _, node := range splitBatchToNodes(rpcProviderEndpointEntry) {
+			chainProxy, err := proxyConstructor(ctx, nConns, node, chainParser)
+			if err != nil {
+				// TODO: allow some urls to be down
+				return nil, err
+			}
+			chainProxy, err = newRewritingChainProxy(chainProxy, node.NodeUrls)
+			if err != nil {
+				return nil, err
+			}
+			nodeUrl, _ := chainProxy.GetChainProxyInformation()
+			chainProxyRouter[routerKey] = append(chainProxyRouter[routerKey], &chainRouterEntry{
+				ChainProxy:      chainProxy,
+				addonsSupported: addonsSupportedMap,
+				nodeName:        nodeUrl.UrlStr(),
+				health:          &nodeHealth{},
+			})
 		}
 	}
 	if len(requiredMap) > len(supportedMap) {
@@ -139,7 +234,15 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 	cri := chainRouterImpl{
 		lock:             &sync.RWMutex{},
 		chainProxyRouter: chainProxyRouter,
+		balancer:         &nodeBalancer{strategy: rpcProviderEndpoint.LoadBalancing},
+		providerMetrics:  providerMetrics,
+		chainID:          rpcProviderEndpoint.ChainID,
+		apiInterface:     rpcProviderEndpoint.ApiInterface,
+	}
+	for _, entry := range cri.allEntries() {
+		providerMetrics.SetNodeHealth(cri.chainID, cri.apiInterface, entry.nodeName, true)
 	}
+	go cri.probeNodes(ctx, rpcProviderEndpoint, chainParser)
 	return cri, nil
 }
 
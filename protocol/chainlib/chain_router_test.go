@@ -332,7 +332,7 @@ func TestChainRouter(t *testing.T) {
 				nodeUrls = append(nodeUrls, nodeUrl)
 			}
 			endpoint.NodeUrls = nodeUrls
-			_, err := GetChainRouter(ctx, 1, endpoint, chainParser)
+			_, err := GetChainRouter(ctx, 1, endpoint, chainParser, nil)
 			if play.success {
 				require.NoError(t, err)
 			} else {
@@ -11,7 +11,6 @@ import (
 	"crypto/x509"
 	"errors"
 	"log"
-	"os"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -40,6 +39,7 @@ type Connector struct {
 	freeClients []*rpcclient.Client
 	usedClients int64
 	nodeUrl     common.NodeUrl
+	authUrls    map[*rpcclient.Client]string // the url including the auth query each client was dialed with
 }
 
 func NewConnector(ctx context.Context, nConns uint, nodeUrl common.NodeUrl) (*Connector, error) {
@@ -47,6 +47,7 @@ func NewConnector(ctx context.Context, nConns uint, nodeUrl common.NodeUrl) (*Co
 	connector := &Connector{
 		freeClients: make([]*rpcclient.Client, 0, nConns),
 		nodeUrl:     nodeUrl,
+		authUrls:    map[*rpcclient.Client]string{},
 	}
 
 	rpcClient, err := connector.createConnection(ctx, nodeUrl, connector.numberOfFreeClients())
@@ -107,9 +108,7 @@ func (connector *Connector) createConnection(ctx context.Context, nodeUrl common
 			return nil, ctx.Err()
 		}
 		timeout := common.AverageWorldLatency * (1 + time.Duration(numberOfConnectionAttempts))
-		nctx, cancel := nodeUrl.LowerContextTimeoutWithDuration(ctx, timeout)
-		// add auth path
-		rpcClient, err = rpcclient.DialContext(nctx, nodeUrl.AuthConfig.AddAuthPath(nodeUrl.Url))
+		rpcClient, err = connector.dial(ctx, nodeUrl, timeout)
 		if err != nil {
 			utils.LavaFormatWarning("Could not connect to the node, retrying", err, []utils.Attribute{
 				{Key: "Current Number Of Connections", Value: currentNumberOfConnections},
@@ -117,17 +116,50 @@ func (connector *Connector) createConnection(ctx context.Context, nodeUrl common
 				{Key: "Number Of Attempts", Value: numberOfConnectionAttempts},
 				{Key: "timeout", Value: timeout},
 			}...)
-			cancel()
 			continue
 		}
-		cancel()
-		nodeUrl.SetAuthHeaders(ctx, rpcClient.SetHeader)
 		break
 	}
 
 	return rpcClient, err
 }
 
+func (connector *Connector) dial(ctx context.Context, nodeUrl common.NodeUrl, timeout time.Duration) (*rpcclient.Client, error) {
+	nctx, cancel := nodeUrl.LowerContextTimeoutWithDuration(ctx, timeout)
+	defer cancel()
+	// add auth path
+	authUrl := nodeUrl.AuthConfig.AddAuthPath(nodeUrl.Url)
+	rpcClient, err := rpcclient.DialContext(nctx, authUrl)
+	if err != nil {
+		return nil, err
+	}
+	nodeUrl.SetAuthHeaders(ctx, rpcClient.SetHeader)
+	connector.lock.Lock()
+	defer connector.lock.Unlock()
+	connector.authUrls[rpcClient] = authUrl
+	return rpcClient, nil
+}
+
+// returns the url including the current auth query when the auth query references a secret, empty otherwise.
+// resolving a secret can wait on a secret endpoint, so it is called before locking
+func (connector *Connector) currentAuthUrl() string {
+	if !common.IsSecretReference(connector.nodeUrl.AuthConfig.AuthQuery) {
+		return ""
+	}
+	return connector.nodeUrl.AuthConfig.AddAuthPath(connector.nodeUrl.Url)
+}
+
+// an auth query referencing a secret can rotate, clients dialed with the previous one are replaced. must be called while locked
+func (connector *Connector) isStaleClient(rpc *rpcclient.Client, authUrl string) bool {
+	return authUrl != "" && connector.authUrls[rpc] != authUrl
+}
+
+// must be called while locked
+func (connector *Connector) closeClient(rpc *rpcclient.Client) {
+	rpc.Close()
+	delete(connector.authUrls, rpc)
+}
+
 func (connector *Connector) connectorLoop(ctx context.Context) {
 	<-ctx.Done()
 	log.Println("connectorLoop ctx.Done")
@@ -138,7 +170,7 @@ func (connector *Connector) Close() {
 	for i := 0; ; i++ {
 		connector.lock.Lock()
 		for i := 0; i < len(connector.freeClients); i++ {
-			connector.freeClients[i].Close()
+			connector.closeClient(connector.freeClients[i])
 		}
 		connector.freeClients = []*rpcclient.Client{}
 
@@ -160,16 +192,13 @@ func (connector *Connector) increaseNumberOfClients(ctx context.Context, numberO
 	var rpcClient *rpcclient.Client
 	var err error
 	for connectionAttempt := 0; connectionAttempt < MaximumNumberOfParallelConnectionsAttempts; connectionAttempt++ {
-		nctx, cancel := connector.nodeUrl.LowerContextTimeoutWithDuration(ctx, common.AverageWorldLatency*2)
-		rpcClient, err = rpcclient.DialContext(nctx, connector.nodeUrl.Url)
+		rpcClient, err = connector.dial(ctx, connector.nodeUrl, common.AverageWorldLatency*2)
 		if err != nil {
 			utils.LavaFormatDebug(
 				"could no increase number of connections to the node jsonrpc connector, retrying",
 				[]utils.Attribute{{Key: "err", Value: err.Error()}, {Key: "Number Of Attempts", Value: connectionAttempt}}...)
-			cancel()
 			continue
 		}
-		cancel()
 
 		connector.lock.Lock() // add connection to free list.
 		defer connector.lock.Unlock()
@@ -180,8 +209,28 @@ func (connector *Connector) increaseNumberOfClients(ctx context.Context, numberO
 }
 
 func (connector *Connector) GetRpc(ctx context.Context, block bool) (*rpcclient.Client, error) {
+	authUrl := connector.currentAuthUrl()
+	rpc, err := connector.getRpc(ctx, block, authUrl)
+	if err != nil {
+		return nil, err
+	}
+	// auth headers referencing secrets may have rotated since the client was created
+	connector.nodeUrl.SetAuthHeaders(ctx, rpc.SetHeader)
+	return rpc, nil
+}
+
+func (connector *Connector) getRpc(ctx context.Context, block bool, authUrl string) (*rpcclient.Client, error) {
 	connector.lock.Lock()
 	defer connector.lock.Unlock()
+	freeClients := connector.freeClients[:0]
+	for _, rpc := range connector.freeClients {
+		if connector.isStaleClient(rpc, authUrl) {
+			connector.closeClient(rpc)
+			continue
+		}
+		freeClients = append(freeClients, rpc)
+	}
+	connector.freeClients = freeClients
 	numberOfFreeClients := len(connector.freeClients)
 	if numberOfFreeClients <= int(connector.usedClients) { // if we reached half of the free clients start creating new connections
 		go connector.increaseNumberOfClients(ctx, numberOfFreeClients) // increase asynchronously the free list.
@@ -214,13 +263,14 @@ func (connector *Connector) GetRpc(ctx context.Context, block bool) (*rpcclient.
 }
 
 func (connector *Connector) ReturnRpc(rpc *rpcclient.Client) {
+	authUrl := connector.currentAuthUrl()
 	connector.lock.Lock()
 	defer connector.lock.Unlock()
 
 	connector.usedClients--
-	if len(connector.freeClients) > (int(connector.usedClients) + int(NumberOfParallelConnections) /* the number we started with */) {
-		rpc.Close() // close connection
-		return      // return without appending back to decrease idle connections
+	if len(connector.freeClients) > (int(connector.usedClients)+int(NumberOfParallelConnections) /* the number we started with */) || connector.isStaleClient(rpc, authUrl) {
+		connector.closeClient(rpc) // close connection
+		return                     // return without appending back to decrease idle connections
 	}
 	connector.freeClients = append(connector.freeClients, rpc)
 }
@@ -253,34 +303,79 @@ func getTlsConf(nodeUrl common.NodeUrl) *tls.Config {
 	var tlsConf tls.Config
 	cacert := nodeUrl.AuthConfig.GetCaCertificateParams()
 	if cacert != "" {
-		utils.LavaFormatDebug("Loading ca certificate from local path", utils.Attribute{Key: "cacert", Value: cacert})
-		caCert, err := os.ReadFile(cacert)
+		utils.LavaFormatDebug("Loading ca certificate", utils.Attribute{Key: "cacert", Value: cacert})
+		caCert, err := nodeUrl.AuthConfig.LoadCaCertificate()
 		if err == nil {
-			caCertPool := x509.NewCertPool()
-			caCertPool.AppendCertsFromPEM(caCert)
-			tlsConf.RootCAs = caCertPool
+			// the ca is loaded on every handshake so a rotated ca is trusted by new connections,
+			// the default verification is skipped as it only checks the roots the config was created with
+			lock := sync.Mutex{}
+			lastGoodCaCert := caCert
 			tlsConf.InsecureSkipVerify = true
+			tlsConf.VerifyConnection = func(state tls.ConnectionState) error {
+				lock.Lock()
+				caCert, err := nodeUrl.AuthConfig.LoadCaCertificate()
+				if err != nil {
+					utils.LavaFormatWarning("Failed reloading CA certificate, using the previous one", err, utils.Attribute{Key: "cacert", Value: cacert})
+					caCert = lastGoodCaCert
+				} else {
+					lastGoodCaCert = caCert
+				}
+				lock.Unlock()
+				return verifyPeerCertificate(state, caCert)
+			}
 		} else {
 			utils.LavaFormatError("Failed loading CA certificate, continuing with a default certificate", err)
 		}
 	} else {
 		keyPem, certPem := nodeUrl.AuthConfig.GetLoadingCertificateParams()
 		if keyPem != "" && certPem != "" {
-			utils.LavaFormatDebug("Loading certificate from local path", utils.Attribute{Key: "certPem", Value: certPem}, utils.Attribute{Key: "keyPem", Value: keyPem})
-			cert, err := tls.LoadX509KeyPair(certPem, keyPem)
+			utils.LavaFormatDebug("Loading certificate", utils.Attribute{Key: "certPem", Value: certPem}, utils.Attribute{Key: "keyPem", Value: keyPem})
+			cert, err := nodeUrl.AuthConfig.LoadClientCertificate()
 			if err != nil {
-				utils.LavaFormatError("Failed setting up tls certificate from local path, continuing with dynamic certificates", err)
+				utils.LavaFormatError("Failed setting up tls certificate, continuing with dynamic certificates", err)
 			} else {
-				tlsConf.Certificates = []tls.Certificate{cert}
+				// the certificate is loaded on every handshake so rotated certificates are used by new connections
+				lock := sync.Mutex{}
+				lastGoodCert := cert
+				tlsConf.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+					lock.Lock()
+					defer lock.Unlock()
+					cert, err := nodeUrl.AuthConfig.LoadClientCertificate()
+					if err != nil {
+						// the key and certificate might be mid rotation
+						utils.LavaFormatWarning("Failed reloading tls certificate, using the previous one", err, utils.Attribute{Key: "certPem", Value: certPem})
+						return lastGoodCert, nil
+					}
+					lastGoodCert = cert
+					return cert, nil
+				}
 			}
 		}
 	}
 	if nodeUrl.AuthConfig.AllowInsecure {
 		tlsConf.InsecureSkipVerify = true
+		tlsConf.VerifyConnection = nil
 	}
 	return &tlsConf
 }
 
+// verifies the server's certificate chain against the ca, the host name isn't verified
+func verifyPeerCertificate(state tls.ConnectionState, caCert []byte) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCert) {
+		return errors.New("no valid CA certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates})
+	return err
+}
+
 func (connector *GRPCConnector) setCredentials(credentials credentials.TransportCredentials) {
 	connector.lock.Lock() // add connection to free list.
 	defer connector.lock.Unlock()
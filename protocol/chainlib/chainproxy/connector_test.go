@@ -2,10 +2,20 @@ package chainproxy
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"log"
+	"math/big"
 	"net"
 	"net/http"
 	"net/rpc"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -167,3 +177,74 @@ func TestConnectorGrpcAndInvoke(t *testing.T) {
 	}
 	require.Equal(t, int(conn.usedClients), 0) // checking we dont have clients used
 }
+
+// creates a ca and a server certificate signed by it
+func createCaAndServerCertificate(t *testing.T, name string) (caPem []byte, serverCert tls.Certificate) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serverTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name + " node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	serverDer, err := x509.CreateCertificate(rand.Reader, serverTemplate, caTemplate, &serverKey.PublicKey, caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer}), tls.Certificate{Certificate: [][]byte{serverDer}, PrivateKey: serverKey}
+}
+
+func TestTlsConfReloadsCaCertificate(t *testing.T) {
+	common.SecretFileCheckInterval = time.Millisecond
+	defer func() { common.SecretFileCheckInterval = 5 * time.Second }()
+	oldCa, oldServerCert := createCaAndServerCertificate(t, "old ca")
+	newCa, newServerCert := createCaAndServerCertificate(t, "new ca")
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, oldCa, 0o600))
+
+	serve := func(cert tls.Certificate) string {
+		lis, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+		require.NoError(t, err)
+		t.Cleanup(func() { lis.Close() })
+		go func() {
+			for {
+				conn, err := lis.Accept()
+				if err != nil {
+					return
+				}
+				conn.(*tls.Conn).Handshake()
+				conn.Close()
+			}
+		}()
+		return lis.Addr().String()
+	}
+	oldNode, newNode := serve(oldServerCert), serve(newServerCert)
+	tlsConf := getTlsConf(common.NodeUrl{AuthConfig: common.AuthConfig{CaCert: caPath}})
+	handshake := func(addr string) error {
+		conn, err := tls.Dial("tcp", addr, tlsConf)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+	require.NoError(t, handshake(oldNode))
+	require.Error(t, handshake(newNode))
+
+	// the rotated ca is trusted by new connections
+	require.NoError(t, os.WriteFile(caPath, newCa, 0o600))
+	require.Eventually(t, func() bool { return handshake(newNode) == nil }, time.Second, 5*time.Millisecond)
+	require.Error(t, handshake(oldNode))
+}
@@ -1,6 +1,7 @@
 package rpcInterfaceMessages
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -140,6 +141,19 @@ func ParseJsonRPCMsg(data []byte) (msgRet []JsonrpcMessage, err error) {
 	return []JsonrpcMessage{msg}, nil
 }
 
+// SplitJsonRPCBatch returns the raw items of a json-rpc batch request, ok is false if the data isn't a batch
+func SplitJsonRPCBatch(data []byte) (items []json.RawMessage, ok bool) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return nil, false
+	}
+	err := json.Unmarshal(trimmed, &items)
+	if err != nil {
+		return nil, false
+	}
+	return items, true
+}
+
 type JsonrpcBatchMessage struct {
 	batch []rpcclient.BatchElemWithId
 	chainproxy.BaseMessage
@@ -136,7 +136,7 @@ func CreateChainLibMocks(ctx context.Context, specIndex string, apiInterface str
 			}
 		}()
 		time.Sleep(10 * time.Millisecond)
-		chainRouter, err = GetChainRouter(ctx, 1, endpoint, chainParser)
+		chainRouter, err = GetChainRouter(ctx, 1, endpoint, chainParser, nil)
 		if err != nil {
 			return nil, nil, nil, closeServer, err
 		}
@@ -144,7 +144,7 @@ func CreateChainLibMocks(ctx context.Context, specIndex string, apiInterface str
 		mockServer := httptest.NewServer(serverCallback)
 		closeServer = mockServer.Close
 		endpoint.NodeUrls = append(endpoint.NodeUrls, common.NodeUrl{Url: mockServer.URL, Addons: addons})
-		chainRouter, err = GetChainRouter(ctx, 1, endpoint, chainParser)
+		chainRouter, err = GetChainRouter(ctx, 1, endpoint, chainParser, nil)
 		if err != nil {
 			return nil, nil, nil, closeServer, err
 		}
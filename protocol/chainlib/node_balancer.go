@@ -0,0 +1,265 @@
+package chainlib
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	LoadBalancingRoundRobin      = "round-robin"
+	LoadBalancingLeastInflight   = "least-inflight"
+	LoadBalancingLatencyWeighted = "latency-weighted"
+
+	NodeEjectionFailures     = 3                // consecutive failures that eject a node
+	NodeEjectionBaseDuration = 10 * time.Second // doubled on every consecutive ejection
+	NodeEjectionMaxDuration  = 5 * time.Minute
+	NodeProbeMinInterval     = time.Second
+	nodeLatencyDecay         = 0.8 // weight of the previous latency in the moving average
+)
+
+func validateLoadBalancing(strategy string) error {
+	switch strategy {
+	case "", LoadBalancingRoundRobin, LoadBalancingLeastInflight, LoadBalancingLatencyWeighted:
+		return nil
+	}
+	return utils.LavaFormatError("invalid load-balancing, expected "+LoadBalancingRoundRobin+", "+LoadBalancingLeastInflight+" or "+LoadBalancingLatencyWeighted, nil, utils.LogAttr("load-balancing", strategy))
+}
+
+// nodeHealth tracks whether a node serves relays, nodes that keep failing or fall behind the other nodes are ejected for a while
+type nodeHealth struct {
+	inflight int64 // atomic
+
+	lock                sync.Mutex
+	consecutiveFailures int
+	ejections           int // consecutive ejections, the node is on probation after one until it succeeds
+	ejectedUntil        time.Time
+	lagging             bool
+	latency             time.Duration // moving average of successful relays
+}
+
+func (nh *nodeHealth) isHealthy(now time.Time) bool {
+	nh.lock.Lock()
+	defer nh.lock.Unlock()
+	return !nh.lagging && !now.Before(nh.ejectedUntil)
+}
+
+// returns true if the node was just ejected
+func (nh *nodeHealth) onFailure(now time.Time) bool {
+	nh.lock.Lock()
+	defer nh.lock.Unlock()
+	nh.consecutiveFailures++
+	onProbation := nh.ejections > 0 && !now.Before(nh.ejectedUntil)
+	if nh.consecutiveFailures < NodeEjectionFailures && !onProbation {
+		return false
+	}
+	ejectionDuration := NodeEjectionBaseDuration << nh.ejections
+	if ejectionDuration > NodeEjectionMaxDuration || ejectionDuration <= 0 {
+		ejectionDuration = NodeEjectionMaxDuration
+	}
+	nh.ejections++
+	nh.consecutiveFailures = 0
+	nh.ejectedUntil = now.Add(ejectionDuration)
+	return true
+}
+
+// returns true if the node was re-admitted after an ejection
+func (nh *nodeHealth) onSuccess(latency time.Duration) bool {
+	nh.lock.Lock()
+	defer nh.lock.Unlock()
+	readmitted := nh.ejections > 0
+	nh.ejections = 0
+	nh.consecutiveFailures = 0
+	if nh.latency == 0 {
+		nh.latency = latency
+	} else {
+		nh.latency = time.Duration(nodeLatencyDecay*float64(nh.latency) + (1-nodeLatencyDecay)*float64(latency))
+	}
+	return readmitted
+}
+
+// returns true if the lag state of the node changed
+func (nh *nodeHealth) setBlockLag(blockLag, allowedBlockLag int64) bool {
+	nh.lock.Lock()
+	defer nh.lock.Unlock()
+	lagging := blockLag > allowedBlockLag
+	changed := lagging != nh.lagging
+	nh.lagging = lagging
+	return changed
+}
+
+func (nh *nodeHealth) getLatency() time.Duration {
+	nh.lock.Lock()
+	defer nh.lock.Unlock()
+	return nh.latency
+}
+
+// nodeBalancer picks the node of a relay among the nodes supporting it
+type nodeBalancer struct {
+	strategy string
+	next     uint64 // atomic, round robin position
+}
+
+// candidates are ordered by preference, the relay fails over to the next candidate
+func (nb *nodeBalancer) order(entries []*chainRouterEntry) []*chainRouterEntry {
+	if len(entries) <= 1 {
+		return entries
+	}
+	now := time.Now()
+	healthy := make([]*chainRouterEntry, 0, len(entries))
+	sick := []*chainRouterEntry{}
+	for _, entry := range entries {
+		if entry.health.isHealthy(now) {
+			healthy = append(healthy, entry)
+		} else {
+			sick = append(sick, entry)
+		}
+	}
+	if len(healthy) == 0 {
+		// all nodes are sick, keep trying them rather than failing every relay
+		healthy, sick = sick, nil
+	}
+	start := int(atomic.AddUint64(&nb.next, 1) % uint64(len(healthy)))
+	ordered := make([]*chainRouterEntry, 0, len(entries))
+	for idx := range healthy {
+		ordered = append(ordered, healthy[(start+idx)%len(healthy)])
+	}
+	switch nb.strategy {
+	case LoadBalancingLeastInflight:
+		// the round robin start breaks ties
+		best := 0
+		for idx, entry := range ordered {
+			if atomic.LoadInt64(&entry.health.inflight) < atomic.LoadInt64(&ordered[best].health.inflight) {
+				best = idx
+			}
+		}
+		ordered[0], ordered[best] = ordered[best], ordered[0]
+	case LoadBalancingLatencyWeighted:
+		// chosen in proportion to the inverse latency, nodes that weren't measured yet get the weight of the fastest one
+		weights := make([]float64, len(ordered))
+		maxWeight := 0.0
+		for idx, entry := range ordered {
+			if latency := entry.health.getLatency(); latency > 0 {
+				weights[idx] = 1 / latency.Seconds()
+				maxWeight = math.Max(maxWeight, weights[idx])
+			}
+		}
+		total := 0.0
+		for idx := range weights {
+			if weights[idx] == 0 {
+				weights[idx] = math.Max(maxWeight, 1)
+			}
+			total += weights[idx]
+		}
+		chosen := rand.Float64() * total
+		for idx, weight := range weights {
+			chosen -= weight
+			if chosen <= 0 || idx == len(weights)-1 {
+				ordered[0], ordered[idx] = ordered[idx], ordered[0]
+				break
+			}
+		}
+	}
+	return append(ordered, sick...)
+}
+
+// nodeRouter sends to a single node, it lets the chain fetcher measure the latest block of each node
+type nodeRouter struct {
+	ChainProxy
+}
+
+func (nr nodeRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
+	relayReply, subscriptionID, relayReplyServer, err = nr.ChainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	proxyUrl, chainId = nr.GetChainProxyInformation()
+	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+}
+
+func (nr nodeRouter) ExtensionsSupported([]string) bool {
+	return true
+}
+
+// probeNodes measures the latest block of every node and ejects nodes that fall behind the others, until the context is done
+func (cri chainRouterImpl) probeNodes(ctx context.Context, endpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) {
+	entries := cri.allEntries()
+	if len(entries) <= 1 {
+		return
+	}
+	if _, _, ok := chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCKNUM); !ok {
+		return
+	}
+	allowedBlockLag, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	interval := averageBlockTime
+	if interval < NodeProbeMinInterval {
+		interval = NodeProbeMinInterval
+	}
+	fetchers := make([]*ChainFetcher, len(entries))
+	for idx, entry := range entries {
+		fetchers[idx] = NewChainFetcher(ctx, &ChainFetcherOptions{ChainRouter: nodeRouter{ChainProxy: entry.ChainProxy}, ChainParser: chainParser, Endpoint: &endpoint})
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		latestBlocks := make([]int64, len(entries))
+		maxLatestBlock := int64(0)
+		for idx, entry := range entries {
+			probeCtx, cancel := context.WithTimeout(ctx, interval)
+			latestBlock, err := fetchers[idx].FetchLatestBlockNum(probeCtx)
+			cancel()
+			if err != nil {
+				latestBlocks[idx] = spectypes.NOT_APPLICABLE
+				cri.onNodeFailure(entry)
+				continue
+			}
+			latestBlocks[idx] = latestBlock
+			if latestBlock > maxLatestBlock {
+				maxLatestBlock = latestBlock
+			}
+		}
+		for idx, entry := range entries {
+			if latestBlocks[idx] == spectypes.NOT_APPLICABLE {
+				continue
+			}
+			blockLag := maxLatestBlock - latestBlocks[idx]
+			cri.providerMetrics.SetNodeBlockLag(cri.chainID, cri.apiInterface, entry.nodeName, blockLag)
+			if entry.health.setBlockLag(blockLag, allowedBlockLag) {
+				if blockLag > allowedBlockLag {
+					utils.LavaFormatWarning("node is behind the other nodes, ejecting it", nil, utils.LogAttr("node", entry.nodeName), utils.LogAttr("chainID", cri.chainID), utils.LogAttr("blockLag", blockLag))
+				} else {
+					utils.LavaFormatInfo("node caught up, re-admitting it", utils.LogAttr("node", entry.nodeName), utils.LogAttr("chainID", cri.chainID))
+				}
+				cri.providerMetrics.SetNodeHealth(cri.chainID, cri.apiInterface, entry.nodeName, entry.health.isHealthy(time.Now()))
+			}
+		}
+	}
+}
+
+func (cri chainRouterImpl) onNodeFailure(entry *chainRouterEntry) {
+	cri.providerMetrics.AddNodeRelay(cri.chainID, cri.apiInterface, entry.nodeName, false)
+	if entry.health.onFailure(time.Now()) {
+		utils.LavaFormatWarning("node keeps failing, ejecting it", nil, utils.LogAttr("node", entry.nodeName), utils.LogAttr("chainID", cri.chainID), utils.LogAttr("apiInterface", cri.apiInterface))
+		cri.providerMetrics.SetNodeHealth(cri.chainID, cri.apiInterface, entry.nodeName, false)
+	}
+}
+
+func (cri chainRouterImpl) onNodeSuccess(entry *chainRouterEntry, latency time.Duration) {
+	cri.providerMetrics.AddNodeRelay(cri.chainID, cri.apiInterface, entry.nodeName, true)
+	if entry.health.onSuccess(latency) {
+		utils.LavaFormatInfo("node recovered, re-admitting it", utils.LogAttr("node", entry.nodeName), utils.LogAttr("chainID", cri.chainID), utils.LogAttr("apiInterface", cri.apiInterface))
+		cri.providerMetrics.SetNodeHealth(cri.chainID, cri.apiInterface, entry.nodeName, entry.health.isHealthy(time.Now()))
+	}
+}
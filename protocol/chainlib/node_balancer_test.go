@@ -0,0 +1,230 @@
+package chainlib
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockNodeChainProxy struct {
+	url     string
+	lock    sync.Mutex
+	fail    bool
+	failErr error // the error of a failing node, defaults to an unreachable node
+	calls   int
+}
+
+func (mncp *mockNodeChainProxy) GetChainProxyInformation() (common.NodeUrl, string) {
+	return common.NodeUrl{Url: mncp.url}, "mock"
+}
+
+func (mncp *mockNodeChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	mncp.lock.Lock()
+	defer mncp.lock.Unlock()
+	mncp.calls++
+	if mncp.fail {
+		if mncp.failErr != nil {
+			return nil, "", nil, mncp.failErr
+		}
+		return nil, "", nil, fmt.Errorf("node %s is down: %w", mncp.url, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED})
+	}
+	return &pairingtypes.RelayReply{Data: []byte(mncp.url)}, "", nil, nil
+}
+
+func (mncp *mockNodeChainProxy) setFail(fail bool) {
+	mncp.lock.Lock()
+	defer mncp.lock.Unlock()
+	mncp.fail = fail
+}
+
+type mockChainMessageForSend struct{}
+
+func (mockChainMessageForSend) GetApi() *spectypes.Api { return &spectypes.Api{} }
+
+func (mockChainMessageForSend) GetRPCMessage() rpcInterfaceMessages.GenericMessage { return nil }
+
+func (mockChainMessageForSend) GetApiCollection() *spectypes.ApiCollection {
+	return &spectypes.ApiCollection{}
+}
+
+func newMockNodesRouter(strategy string, proxies ...*mockNodeChainProxy) chainRouterImpl {
+	entries := []*chainRouterEntry{}
+	for _, proxy := range proxies {
+		entries = append(entries, &chainRouterEntry{ChainProxy: proxy, addonsSupported: map[string]struct{}{}, nodeName: proxy.url, health: &nodeHealth{}})
+	}
+	return chainRouterImpl{
+		lock:             &sync.RWMutex{},
+		chainProxyRouter: map[lavasession.RouterKey][]*chainRouterEntry{lavasession.NewRouterKey(nil): entries},
+		balancer:         &nodeBalancer{strategy: strategy},
+	}
+}
+
+func TestChainRouterLoadBalancing(t *testing.T) {
+	rand.InitRandomSeed()
+	for _, strategy := range []string{"", LoadBalancingRoundRobin, LoadBalancingLeastInflight, LoadBalancingLatencyWeighted} {
+		t.Run(strategy, func(t *testing.T) {
+			proxies := []*mockNodeChainProxy{{url: "http://node1"}, {url: "http://node2"}, {url: "http://node3"}}
+			router := newMockNodesRouter(strategy, proxies...)
+			for i := 0; i < 300; i++ {
+				_, _, _, _, _, err := router.SendNodeMsg(context.Background(), nil, mockChainMessageForSend{}, nil)
+				require.NoError(t, err)
+			}
+			for _, proxy := range proxies {
+				// every healthy node gets a share of the relays
+				require.Greater(t, proxy.calls, 30, proxy.url)
+			}
+		})
+	}
+}
+
+func TestChainRouterFailover(t *testing.T) {
+	proxies := []*mockNodeChainProxy{{url: "http://node1"}, {url: "http://node2"}}
+	router := newMockNodesRouter(LoadBalancingRoundRobin, proxies...)
+	proxies[0].setFail(true)
+	for i := 0; i < 10; i++ {
+		reply, _, _, proxyUrl, _, err := router.SendNodeMsg(context.Background(), nil, mockChainMessageForSend{}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://node2", string(reply.Data))
+		require.Equal(t, "http://node2", proxyUrl.Url)
+	}
+	// the failing node is ejected after consecutive failures and no longer tried first
+	require.Equal(t, NodeEjectionFailures, proxies[0].calls)
+	require.False(t, router.chainProxyRouter[lavasession.NewRouterKey(nil)][0].health.isHealthy(time.Now()))
+
+	// all nodes failing returns the error
+	proxies[1].setFail(true)
+	_, _, _, _, _, err := router.SendNodeMsg(context.Background(), nil, mockChainMessageForSend{}, nil)
+	require.Error(t, err)
+}
+
+func TestChainRouterNoFailover(t *testing.T) {
+	// a node that got the request may have acted on it, so only unreachable nodes fail over
+	t.Run("request sent", func(t *testing.T) {
+		proxies := []*mockNodeChainProxy{{url: "http://node1", fail: true, failErr: common.ContextDeadlineExceededError}, {url: "http://node2", fail: true, failErr: common.ContextDeadlineExceededError}}
+		router := newMockNodesRouter(LoadBalancingRoundRobin, proxies...)
+		_, _, _, _, _, err := router.SendNodeMsg(context.Background(), nil, mockChainMessageForSend{}, nil)
+		require.ErrorIs(t, err, common.ContextDeadlineExceededError)
+		require.Equal(t, 1, proxies[0].calls+proxies[1].calls)
+	})
+
+	// a subscription is never opened on two nodes
+	t.Run("subscription", func(t *testing.T) {
+		proxies := []*mockNodeChainProxy{{url: "ws://node1", fail: true}, {url: "ws://node2", fail: true}}
+		router := newMockNodesRouter(LoadBalancingRoundRobin, proxies...)
+		_, _, _, _, _, err := router.SendNodeMsg(context.Background(), make(chan interface{}), mockChainMessageForSend{}, nil)
+		require.Error(t, err)
+		require.Equal(t, 1, proxies[0].calls+proxies[1].calls)
+	})
+}
+
+func TestNodeUnreachableError(t *testing.T) {
+	require.True(t, isNodeUnreachableError(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}))
+	require.True(t, isNodeUnreachableError(fmt.Errorf("wrapped: %w", &net.DNSError{Err: "no such host"})))
+	require.True(t, isNodeUnreachableError(utils.LavaFormatWarning("masked", common.NodeUnreachableError)))
+	require.False(t, isNodeUnreachableError(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}))
+	require.False(t, isNodeUnreachableError(common.ContextDeadlineExceededError))
+	require.False(t, isNodeUnreachableError(nil))
+}
+
+func TestNodeHealthEjection(t *testing.T) {
+	health := &nodeHealth{}
+	now := time.Now()
+	for i := 1; i < NodeEjectionFailures; i++ {
+		require.False(t, health.onFailure(now))
+	}
+	require.True(t, health.isHealthy(now))
+	require.True(t, health.onFailure(now))
+	require.False(t, health.isHealthy(now))
+	require.False(t, health.isHealthy(now.Add(NodeEjectionBaseDuration-time.Millisecond)))
+
+	// re-admitted on probation, a single failure ejects it for longer
+	now = now.Add(NodeEjectionBaseDuration)
+	require.True(t, health.isHealthy(now))
+	require.True(t, health.onFailure(now))
+	require.False(t, health.isHealthy(now.Add(2*NodeEjectionBaseDuration-time.Millisecond)))
+	now = now.Add(2 * NodeEjectionBaseDuration)
+	require.True(t, health.isHealthy(now))
+
+	// a success ends the probation
+	require.True(t, health.onSuccess(time.Millisecond))
+	require.False(t, health.onFailure(now))
+	require.True(t, health.isHealthy(now))
+
+	// lagging nodes are ejected until they catch up
+	require.True(t, health.setBlockLag(10, 2))
+	require.False(t, health.isHealthy(now))
+	require.False(t, health.setBlockLag(5, 2))
+	require.True(t, health.setBlockLag(1, 2))
+	require.True(t, health.isHealthy(now))
+}
+
+func TestSplitBatchToNodes(t *testing.T) {
+	urls := func(endpoints []lavasession.RPCProviderEndpoint) [][]string {
+		ret := [][]string{}
+		for _, endpoint := range endpoints {
+			nodeUrls := []string{}
+			for _, nodeUrl := range endpoint.NodeUrls {
+				nodeUrls = append(nodeUrls, nodeUrl.Url)
+			}
+			ret = append(ret, nodeUrls)
+		}
+		return ret
+	}
+	playbook := []struct {
+		name         string
+		apiInterface string
+		nodeUrls     []common.NodeUrl
+		expected     [][]string
+	}{
+		{
+			name:         "node per url",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			nodeUrls:     []common.NodeUrl{{Url: "http://a"}, {Url: "http://b"}},
+			expected:     [][]string{{"http://a"}, {"http://b"}},
+		},
+		{
+			name:         "internal paths are a single node",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			nodeUrls:     []common.NodeUrl{{Url: "http://a", InternalPath: "/x"}, {Url: "http://a/y", InternalPath: "/y"}},
+			expected:     [][]string{{"http://a", "http://a/y"}},
+		},
+		{
+			name:         "tendermint pairs",
+			apiInterface: spectypes.APIInterfaceTendermintRPC,
+			nodeUrls:     []common.NodeUrl{{Url: "http://a"}, {Url: "ws://a"}, {Url: "http://b"}, {Url: "ws://b"}},
+			expected:     [][]string{{"http://a", "ws://a"}, {"http://b", "ws://b"}},
+		},
+		{
+			name:         "tendermint single node",
+			apiInterface: spectypes.APIInterfaceTendermintRPC,
+			nodeUrls:     []common.NodeUrl{{Url: "http://a"}, {Url: "ws://a"}},
+			expected:     [][]string{{"http://a", "ws://a"}},
+		},
+		{
+			name:         "tendermint without matching websockets",
+			apiInterface: spectypes.APIInterfaceTendermintRPC,
+			nodeUrls:     []common.NodeUrl{{Url: "http://a"}, {Url: "http://b"}, {Url: "ws://b"}},
+			expected:     [][]string{{"http://a", "http://b", "ws://b"}},
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			endpoint := lavasession.RPCProviderEndpoint{ChainID: "mock", ApiInterface: play.apiInterface, NodeUrls: play.nodeUrls}
+			require.Equal(t, play.expected, urls(splitBatchToNodes(endpoint)))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package chainlib
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -31,10 +32,10 @@ func (geh *genericErrorHandler) handleConnectionError(err error) error {
 	} else if opErr, ok := err.(*net.OpError); ok && opErr.Timeout() {
 		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Network operation timed out", nil)
 	} else if _, ok := err.(*net.DNSError); ok {
-		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: DNS resolution failed", nil)
+		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: DNS resolution failed", common.NodeUnreachableError)
 	} else if opErr, ok := err.(*net.OpError); ok {
 		if sysErr, ok := opErr.Err.(*os.SyscallError); ok && sysErr.Err == syscall.ECONNREFUSED {
-			return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Connection refused", nil)
+			return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: Connection refused", common.NodeUnreachableError)
 		}
 	} else if strings.Contains(err.Error(), "http: server gave HTTP response to HTTPS client") {
 		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: misconfigured http endpoint as https", nil)
@@ -42,6 +43,23 @@ func (geh *genericErrorHandler) handleConnectionError(err error) error {
 	return nil // do not return here so the caller will return the error inside the data so it reaches the user when it doesn't match any specific cases
 }
 
+// isNodeUnreachableError returns whether the node could not be reached at all, so the request was never sent to it
+// and can be sent to another node without the node acting on it twice
+func isNodeUnreachableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, common.NodeUnreachableError) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
 func (geh *genericErrorHandler) handleGenericErrors(ctx context.Context, nodeError error) error {
 	if nodeError == context.DeadlineExceeded || ctx.Err() == context.DeadlineExceeded {
 		return utils.LavaFormatProduction("Provider Failed Sending Message", common.ContextDeadlineExceededError)
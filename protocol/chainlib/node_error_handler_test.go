@@ -9,6 +9,7 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -56,8 +57,9 @@ func TestNodeErrorHandlerGenericErrors(t *testing.T) {
 		Err: "dummy",
 	}
 	err = neh.handleGenericErrors(ctx, dnsErr)
-	expectedError = utils.LavaFormatError("Provider Side Failed Sending Message, Reason: DNS resolution failed", nil)
+	expectedError = utils.LavaFormatError("Provider Side Failed Sending Message, Reason: DNS resolution failed", common.NodeUnreachableError)
 	require.Equal(t, err.Error(), expectedError.Error())
+	require.ErrorIs(t, err, common.NodeUnreachableError)
 
 	// Test net.OpError with connection refused error
 	opErr = &net.OpError{
@@ -71,8 +73,9 @@ func TestNodeErrorHandlerGenericErrors(t *testing.T) {
 		},
 	}
 	err = neh.handleGenericErrors(ctx, opErr)
-	expectedError = utils.LavaFormatError("Provider Side Failed Sending Message, Reason: Connection refused", nil)
+	expectedError = utils.LavaFormatError("Provider Side Failed Sending Message, Reason: Connection refused", common.NodeUnreachableError)
 	require.Equal(t, err.Error(), expectedError.Error())
+	require.ErrorIs(t, err, common.NodeUnreachableError)
 
 	// Test non-matching error
 	err = neh.handleGenericErrors(ctx, errors.New("dummy error"))
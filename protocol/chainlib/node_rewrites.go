@@ -0,0 +1,522 @@
+package chainlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const rewriteFieldWildcard = "*"
+
+// rewritingChainProxy applies the rewrite rules of the node url serving a relay to it
+type rewritingChainProxy struct {
+	ChainProxy
+	nodeUrls []common.NodeUrl
+}
+
+// wraps the chain proxy if any of the node urls has rewrite rules, the rules are validated so bad configs fail on startup
+func newRewritingChainProxy(chainProxy ChainProxy, nodeUrls []common.NodeUrl) (ChainProxy, error) {
+	hasRules := false
+	for _, nodeUrl := range nodeUrls {
+		for idx := range nodeUrl.Rewrites {
+			if err := nodeUrl.Rewrites[idx].Validate(); err != nil {
+				return nil, utils.LavaFormatError("invalid rewrite rule", err, utils.LogAttr("url", nodeUrl.UrlStr()), utils.LogAttr("rule", idx))
+			}
+		}
+		hasRules = hasRules || len(nodeUrl.Rewrites) > 0
+	}
+	if !hasRules {
+		return chainProxy, nil
+	}
+	return &rewritingChainProxy{ChainProxy: chainProxy, nodeUrls: nodeUrls}, nil
+}
+
+// servingNodeUrl returns the node url the chain proxy sends a relay of the internal path with: urls are chosen by their internal path,
+// subscriptions are sent over websocket and other relays over http when the path has both (like the tendermint ws and http urls)
+func (rcp *rewritingChainProxy) servingNodeUrl(internalPath string, subscription bool) *common.NodeUrl {
+	var fallback *common.NodeUrl
+	for idx := range rcp.nodeUrls {
+		nodeUrl := &rcp.nodeUrls[idx]
+		if nodeUrl.InternalPath != internalPath {
+			continue
+		}
+		isWebsocket := strings.HasPrefix(nodeUrl.Url, "ws")
+		if isWebsocket == subscription {
+			return nodeUrl
+		}
+		if fallback == nil {
+			fallback = nodeUrl
+		}
+	}
+	return fallback
+}
+
+func (rcp *rewritingChainProxy) matchingRules(chainMessage ChainMessageForSend, subscription bool) []common.RewriteRule {
+	internalPath := ""
+	if apiCollection := chainMessage.GetApiCollection(); apiCollection != nil {
+		internalPath = apiCollection.CollectionData.InternalPath
+	}
+	nodeUrl := rcp.servingNodeUrl(internalPath, subscription)
+	if nodeUrl == nil {
+		return nil
+	}
+	apiName := ""
+	if api := chainMessage.GetApi(); api != nil {
+		apiName = api.Name
+	}
+	apiPath := rewritableMessagePath(chainMessage.GetRPCMessage())
+	matching := []common.RewriteRule{}
+	for _, rule := range nodeUrl.Rewrites {
+		if rule.Matches(apiName, apiPath) {
+			matching = append(matching, rule)
+		}
+	}
+	return matching
+}
+
+// rewrittenChainMessage sends a rewritten copy of a chain message's request, so the relay itself is never modified
+type rewrittenChainMessage struct {
+	ChainMessageForSend
+	rpcMessage rpcInterfaceMessages.GenericMessage
+}
+
+func (rcm *rewrittenChainMessage) GetRPCMessage() rpcInterfaceMessages.GenericMessage {
+	return rcm.rpcMessage
+}
+
+// the node is sent a rewritten copy of the request, so failing over to another node starts from the original request
+func (rcp *rewritingChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	rules := rcp.matchingRules(chainMessage, ch != nil)
+	if len(rules) == 0 {
+		return rcp.ChainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	}
+	rewritten, err := rewriteRequest(chainMessage.GetRPCMessage(), rules)
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatError("failed rewriting node request", err, utils.LogAttr("GUID", ctx))
+	}
+	relayReply, subscriptionID, relayReplyServer, err = rcp.ChainProxy.SendNodeMsg(ctx, ch, &rewrittenChainMessage{ChainMessageForSend: chainMessage, rpcMessage: rewritten})
+	if err != nil || relayReply == nil {
+		return relayReply, subscriptionID, relayReplyServer, err
+	}
+	err = rewriteResponse(relayReply, rules)
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatError("failed rewriting node response", err, utils.LogAttr("GUID", ctx))
+	}
+	return relayReply, subscriptionID, relayReplyServer, nil
+}
+
+// the path rules match against, empty for messages that are identified by their method
+func rewritableMessagePath(rpcMessage rpcInterfaceMessages.GenericMessage) string {
+	switch message := rpcMessage.(type) {
+	case *rpcInterfaceMessages.RestMessage:
+		return message.Path
+	case *rpcInterfaceMessages.GrpcMessage:
+		return message.Path
+	case *rpcInterfaceMessages.TendermintrpcMessage:
+		return message.Path
+	}
+	return ""
+}
+
+// rewriteRequest returns a copy of the message with the request actions of the rules applied
+func rewriteRequest(rpcMessage rpcInterfaceMessages.GenericMessage, rules []common.RewriteRule) (rewritten rpcInterfaceMessages.GenericMessage, err error) {
+	var baseMessage *chainproxy.BaseMessage
+	var method *string
+	keepQuery := false
+	var params *interface{}
+	var body *[]byte
+	switch message := rpcMessage.(type) {
+	case *rpcInterfaceMessages.JsonrpcMessage:
+		copied := *message
+		rewritten, baseMessage, method, params = &copied, &copied.BaseMessage, &copied.Method, &copied.Params
+	case *rpcInterfaceMessages.TendermintrpcMessage:
+		copied := *message
+		rewritten, baseMessage, method, params = &copied, &copied.BaseMessage, &copied.Method, &copied.Params
+		if copied.Path != "" {
+			// uri requests are sent by their path, their query holds the params
+			method, keepQuery = &copied.Path, true
+		}
+	case *rpcInterfaceMessages.RestMessage:
+		copied := *message
+		rewritten, baseMessage, method, body = &copied, &copied.BaseMessage, &copied.Path, &copied.Msg
+	case *rpcInterfaceMessages.GrpcMessage:
+		copied := *message
+		rewritten, baseMessage, method, body = &copied, &copied.BaseMessage, &copied.Path, &copied.Msg
+	case *rpcInterfaceMessages.JsonrpcBatchMessage:
+		// the items of a batch can't be edited, only its headers
+		copied := *message
+		rewritten, baseMessage = &copied, &copied.BaseMessage
+	default:
+		return nil, fmt.Errorf("rewriting %T messages is not supported", rpcMessage)
+	}
+
+	// the copy shares the original's fields until they are replaced, so fields are only ever replaced and never edited
+	headers := append([]pairingtypes.Metadata{}, baseMessage.Headers...)
+	for _, rule := range rules {
+		actions := rule.Request
+		if actions.Method != "" {
+			if method == nil {
+				return nil, fmt.Errorf("the method of %T messages can't be rewritten", rpcMessage)
+			}
+			newMethod := actions.Method
+			if _, query, found := strings.Cut(*method, "?"); keepQuery && found {
+				newMethod += "?" + query
+			}
+			*method = newMethod
+		}
+		headers = rewriteHeaders(headers, actions)
+		if len(actions.SetFields) == 0 && len(actions.RemoveFields) == 0 {
+			continue
+		}
+		switch {
+		case params != nil:
+			*params, err = rewriteParams(*params, actions)
+		case body != nil && len(*body) > 0 && ((*body)[0] == '{' || (*body)[0] == '['):
+			*body, err = rewriteJSON(*body, actions, false)
+		default:
+			err = fmt.Errorf("the fields of %T messages without a json body can't be rewritten", rpcMessage)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	baseMessage.Headers = headers
+	return rewritten, nil
+}
+
+func rewriteResponse(relayReply *pairingtypes.RelayReply, rules []common.RewriteRule) (err error) {
+	for _, rule := range rules {
+		actions := rule.Response
+		relayReply.Metadata = rewriteHeaders(relayReply.Metadata, actions)
+		if len(actions.SetFields) == 0 && len(actions.RemoveFields) == 0 {
+			continue
+		}
+		relayReply.Data, err = rewriteJSON(relayReply.Data, actions, true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rewriteHeaders(headers []pairingtypes.Metadata, actions common.RewriteActions) []pairingtypes.Metadata {
+	if len(actions.RemoveHeaders) == 0 && len(actions.SetHeaders) == 0 {
+		return headers
+	}
+	rewritten := make([]pairingtypes.Metadata, 0, len(headers)+len(actions.SetHeaders))
+	for _, header := range headers {
+		removed := false
+		for _, name := range actions.RemoveHeaders {
+			removed = removed || strings.EqualFold(header.Name, name)
+		}
+		for name := range actions.SetHeaders {
+			removed = removed || strings.EqualFold(header.Name, name)
+		}
+		if !removed {
+			rewritten = append(rewritten, header)
+		}
+	}
+	for name, value := range actions.SetHeaders {
+		rewritten = append(rewritten, pairingtypes.Metadata{Name: name, Value: common.ResolveSecretOrWarn(value)})
+	}
+	return rewritten
+}
+
+func rewriteParams(params interface{}, actions common.RewriteActions) (interface{}, error) {
+	data, err := encodeRewriteJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		data = []byte("{}")
+	}
+	data, err = rewriteJSON(data, actions, false)
+	if err != nil {
+		return nil, err
+	}
+	document, err := decodeRewriteJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	// the node clients only send params of plain maps and slices
+	return plainRewriteJSON(document), nil
+}
+
+// rewriteJSON applies the field actions to a json document, when perItem is set the actions of a batch response apply to every item.
+// the document keeps its key order and its html characters, only the rewritten fields change
+func rewriteJSON(data []byte, actions common.RewriteActions, perItem bool) ([]byte, error) {
+	document, err := decodeRewriteJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("can't rewrite fields of a response that isn't json: %w", err)
+	}
+	documents := []interface{}{document}
+	items, isBatch := document.([]interface{})
+	if perItem && isBatch {
+		documents = items
+	}
+	for idx := range documents {
+		for _, fieldPath := range actions.RemoveFields {
+			segments, err := common.SplitRewriteFieldPath(fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			documents[idx] = removeRewriteField(documents[idx], segments)
+		}
+		for _, field := range actions.SetFields {
+			segments, err := common.SplitRewriteFieldPath(field.Path)
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeRewriteJSON([]byte(field.Value))
+			if err != nil {
+				value = field.Value
+			}
+			documents[idx], err = setRewriteField(documents[idx], segments, value)
+			if err != nil {
+				return nil, fmt.Errorf("failed setting %s: %w", field.Path, err)
+			}
+		}
+	}
+	if !(perItem && isBatch) {
+		document = documents[0]
+	}
+	return encodeRewriteJSON(document)
+}
+
+// rewriteObject is a json object that keeps the order of its keys
+type rewriteObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newRewriteObject() *rewriteObject {
+	return &rewriteObject{values: map[string]interface{}{}}
+}
+
+func (ro *rewriteObject) set(key string, value interface{}) {
+	if _, found := ro.values[key]; !found {
+		ro.keys = append(ro.keys, key)
+	}
+	ro.values[key] = value
+}
+
+func (ro *rewriteObject) remove(key string) {
+	if _, found := ro.values[key]; !found {
+		return
+	}
+	delete(ro.values, key)
+	for idx, existing := range ro.keys {
+		if existing == key {
+			ro.keys = append(ro.keys[:idx:idx], ro.keys[idx+1:]...)
+			return
+		}
+	}
+}
+
+func (ro *rewriteObject) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	for idx, key := range ro.keys {
+		if idx > 0 {
+			buffer.WriteByte(',')
+		}
+		encodedKey, err := encodeRewriteJSON(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := encodeRewriteJSON(ro.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(encodedKey)
+		buffer.WriteByte(':')
+		buffer.Write(encodedValue)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// encodes json without escaping html characters, unlike json.Marshal
+func encodeRewriteJSON(value interface{}) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// decodes json keeping numbers as they are, so big numbers aren't rounded, and objects in their key order
+func decodeRewriteJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	document, err := decodeRewriteValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the json value")
+	}
+	return document, nil
+}
+
+func decodeRewriteValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		// strings, numbers, booleans and null
+		return token, nil
+	}
+	switch delim {
+	case '{':
+		object := newRewriteObject()
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid object key %v", keyToken)
+			}
+			value, err := decodeRewriteValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object.set(key, value)
+		}
+		_, err = decoder.Token()
+		return object, err
+	case '[':
+		array := []interface{}{}
+		for decoder.More() {
+			value, err := decodeRewriteValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = decoder.Token()
+		return array, err
+	}
+	return nil, fmt.Errorf("unexpected %s", delim)
+}
+
+// plainRewriteJSON converts the ordered objects of a document to maps
+func plainRewriteJSON(node interface{}) interface{} {
+	switch typed := node.(type) {
+	case *rewriteObject:
+		plain := make(map[string]interface{}, len(typed.keys))
+		for key, value := range typed.values {
+			plain[key] = plainRewriteJSON(value)
+		}
+		return plain
+	case []interface{}:
+		for idx, child := range typed {
+			typed[idx] = plainRewriteJSON(child)
+		}
+		return typed
+	}
+	return node
+}
+
+// setRewriteField sets the value at the path, missing objects on the way are created
+func setRewriteField(node interface{}, segments []string, value interface{}) (interface{}, error) {
+	if len(segments) == 0 {
+		return value, nil
+	}
+	segment := segments[0]
+	switch typed := node.(type) {
+	case *rewriteObject:
+		if segment == rewriteFieldWildcard {
+			for _, key := range typed.keys {
+				updated, err := setRewriteField(typed.values[key], segments[1:], value)
+				if err != nil {
+					return nil, err
+				}
+				typed.values[key] = updated
+			}
+			return typed, nil
+		}
+		updated, err := setRewriteField(typed.values[segment], segments[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		typed.set(segment, updated)
+		return typed, nil
+	case []interface{}:
+		if segment == rewriteFieldWildcard {
+			for idx, child := range typed {
+				updated, err := setRewriteField(child, segments[1:], value)
+				if err != nil {
+					return nil, err
+				}
+				typed[idx] = updated
+			}
+			return typed, nil
+		}
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 || idx >= len(typed) {
+			return nil, fmt.Errorf("index %s out of range", segment)
+		}
+		typed[idx], err = setRewriteField(typed[idx], segments[1:], value)
+		return typed, err
+	case nil:
+		if segment == rewriteFieldWildcard {
+			return nil, nil
+		}
+		return setRewriteField(newRewriteObject(), segments, value)
+	}
+	return nil, fmt.Errorf("%s is not an object or an array", segment)
+}
+
+// removeRewriteField removes the value at the path, missing paths are left as they are
+func removeRewriteField(node interface{}, segments []string) interface{} {
+	segment, last := segments[0], len(segments) == 1
+	switch typed := node.(type) {
+	case *rewriteObject:
+		for _, key := range append([]string{}, typed.keys...) {
+			if segment != rewriteFieldWildcard && key != segment {
+				continue
+			}
+			if last {
+				typed.remove(key)
+			} else {
+				typed.values[key] = removeRewriteField(typed.values[key], segments[1:])
+			}
+		}
+	case []interface{}:
+		if segment == rewriteFieldWildcard {
+			if last {
+				return []interface{}{}
+			}
+			for idx, child := range typed {
+				typed[idx] = removeRewriteField(child, segments[1:])
+			}
+			return typed
+		}
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 || idx >= len(typed) {
+			return typed
+		}
+		if last {
+			return append(typed[:idx:idx], typed[idx+1:]...)
+		}
+		typed[idx] = removeRewriteField(typed[idx], segments[1:])
+	}
+	return node
+}
@@ -0,0 +1,231 @@
+package chainlib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createRewritingChainRouter(t *testing.T, ctx context.Context, specIndex string, apiInterface string, serverCallback http.HandlerFunc, rewrites []common.RewriteRule) (ChainParser, ChainRouter) {
+	spec, err := keepertest.GetASpec(specIndex, "../../", nil, nil)
+	require.NoError(t, err)
+	chainParser, err := NewChainParser(apiInterface)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	mockServer := httptest.NewServer(serverCallback)
+	t.Cleanup(mockServer.Close)
+	endpoint := &lavasession.RPCProviderEndpoint{
+		ChainID:      specIndex,
+		ApiInterface: apiInterface,
+		Geolocation:  1,
+		NodeUrls:     []common.NodeUrl{{Url: mockServer.URL, Rewrites: rewrites}},
+	}
+	chainRouter, err := GetChainRouter(ctx, 1, endpoint, chainParser, nil)
+	require.NoError(t, err)
+	return chainParser, chainRouter
+}
+
+func TestRewriteRulesJsonRPC(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var request rpcInterfaceMessages.JsonrpcMessage
+		require.NoError(t, json.Unmarshal(body, &request))
+		if request.Method != "eth_getBlockByNumber" {
+			// the chain fetcher and other relays are not rewritten
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+			return
+		}
+		params, err := json.Marshal(request.Params)
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x10","vendorField":"internal","header":%q,"params":%s}}`, r.Header.Get("X-Vendor-Key"), params)
+	})
+	rewrites := []common.RewriteRule{{
+		Match:    common.RewriteMatch{ApiNames: []string{"eth_getBlockByHash"}},
+		Request:  common.RewriteActions{Method: "eth_getBlockByNumber", SetHeaders: map[string]string{"X-Vendor-Key": "secret"}, SetFields: []common.RewriteField{{Path: "1", Value: "false"}}},
+		Response: common.RewriteActions{RemoveFields: []string{"result.vendorField"}, SetFields: []common.RewriteField{{Path: "result.rewritten", Value: "true"}}, SetHeaders: map[string]string{"Lava-Node-Vendor": "test"}},
+	}}
+	chainParser, chainRouter := createRewritingChainRouter(t, ctx, "ETH1", spectypes.APIInterfaceJsonRPC, serverHandle, rewrites)
+
+	request := `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByHash","params":["0xabc",true]}`
+	chainMessage, err := chainParser.ParseMsg("", []byte(request), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	reply, _, _, _, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	require.NoError(t, err)
+	var response struct {
+		Result map[string]interface{} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(reply.Data, &response))
+	require.Equal(t, "secret", response.Result["header"])
+	require.Equal(t, []interface{}{"0xabc", false}, response.Result["params"])
+	require.Equal(t, true, response.Result["rewritten"])
+	require.NotContains(t, response.Result, "vendorField")
+	require.Contains(t, reply.Metadata, pairingtypes.Metadata{Name: "Lava-Node-Vendor", Value: "test"})
+
+	// the node is sent a rewritten copy, so a failover or a retry starts from the original request
+	nodeMessage, ok := chainMessage.GetRPCMessage().(*rpcInterfaceMessages.JsonrpcMessage)
+	require.True(t, ok)
+	require.Equal(t, "eth_getBlockByHash", nodeMessage.Method)
+	require.Equal(t, []interface{}{"0xabc", true}, nodeMessage.Params)
+	require.Empty(t, nodeMessage.GetHeaders())
+
+	// other apis are sent as they are
+	chainMessage, err = chainParser.ParseMsg("", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	reply, _, _, _, _, err = chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, string(reply.Data))
+
+	// invalid rules fail the router creation
+	invalidRules := []common.RewriteRule{{Match: common.RewriteMatch{ApiNames: []string{"eth_getBlockByHash"}}}}
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	_, err = GetChainRouter(ctx, 1, &lavasession.RPCProviderEndpoint{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, NodeUrls: []common.NodeUrl{{Url: "http://127.0.0.1:0", Rewrites: invalidRules}}}, chainParser, nil)
+	require.Error(t, err)
+}
+
+func TestRewriteRulesRest(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"block":{"header":{"height":"17","internal":"x"}},"path":%q,"header":%q}`, r.URL.Path, r.Header.Get("X-Vendor-Key"))
+	})
+	rewrites := []common.RewriteRule{{
+		Match:    common.RewriteMatch{Paths: []string{"/cosmos/base/tendermint/v1beta1/blocks/*"}},
+		Request:  common.RewriteActions{Method: "/cosmos/base/tendermint/v1beta1/blocks/latest", SetHeaders: map[string]string{"X-Vendor-Key": "secret"}},
+		Response: common.RewriteActions{RemoveFields: []string{"block.header.internal"}},
+	}}
+	chainParser, chainRouter := createRewritingChainRouter(t, ctx, "LAV1", spectypes.APIInterfaceRest, serverHandle, rewrites)
+
+	chainMessage, err := chainParser.ParseMsg("/cosmos/base/tendermint/v1beta1/blocks/17", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	reply, _, _, _, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"block":{"header":{"height":"17"}},"path":"/cosmos/base/tendermint/v1beta1/blocks/latest","header":"secret"}`, string(reply.Data))
+	nodeMessage, ok := chainMessage.GetRPCMessage().(*rpcInterfaceMessages.RestMessage)
+	require.True(t, ok)
+	require.Equal(t, "/cosmos/base/tendermint/v1beta1/blocks/17", nodeMessage.Path)
+}
+
+func TestRewriteRulesTendermintRPC(t *testing.T) {
+	ctx := context.Background()
+	serverHandle := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"path":%q,"height":%q,"node_info":{"moniker":"internal","network":"lava"}}}`, r.URL.Path, r.URL.Query().Get("height"))
+	})
+	rewrites := []common.RewriteRule{{
+		Match:    common.RewriteMatch{Paths: []string{"block?*"}},
+		Request:  common.RewriteActions{Method: "block_v2"},
+		Response: common.RewriteActions{RemoveFields: []string{"result.node_info.moniker"}, SetFields: []common.RewriteField{{Path: "result.node_info.vendor", Value: "not json"}}},
+	}}
+	chainParser, chainRouter := createRewritingChainRouter(t, ctx, "LAV1", spectypes.APIInterfaceTendermintRPC, serverHandle, rewrites)
+
+	chainMessage, err := chainParser.ParseMsg("block?height=5", nil, "", nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	reply, _, _, _, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{"path":"/block_v2","height":"5","node_info":{"network":"lava","vendor":"not json"}}}`, string(reply.Data))
+	nodeMessage, ok := chainMessage.GetRPCMessage().(*rpcInterfaceMessages.TendermintrpcMessage)
+	require.True(t, ok)
+	require.Equal(t, "block?height=5", nodeMessage.Path)
+}
+
+func TestRewriteRulesGrpc(t *testing.T) {
+	message := &rpcInterfaceMessages.GrpcMessage{Msg: []byte(`{"address":"lava@1","pagination":{"limit":"10"}}`), Path: "cosmos.bank.v1beta1.Query/AllBalances"}
+	message.Headers = []pairingtypes.Metadata{{Name: "x-remove-me", Value: "1"}, {Name: "x-keep", Value: "2"}}
+	rules := []common.RewriteRule{{
+		Match:   common.RewriteMatch{Paths: []string{"cosmos.bank.v1beta1.Query/*"}},
+		Request: common.RewriteActions{Method: "cosmos.bank.v1beta2.Query/AllBalances", RemoveHeaders: []string{"X-Remove-Me"}, RemoveFields: []string{"pagination"}, SetFields: []common.RewriteField{{Path: "denom", Value: `"ulava"`}}},
+	}}
+	require.True(t, rules[0].Matches("", rewritableMessagePath(message)))
+	rewritten, err := rewriteRequest(message, rules)
+	require.NoError(t, err)
+	rewrittenMessage, ok := rewritten.(*rpcInterfaceMessages.GrpcMessage)
+	require.True(t, ok)
+	require.Equal(t, "cosmos.bank.v1beta2.Query/AllBalances", rewrittenMessage.Path)
+	require.JSONEq(t, `{"address":"lava@1","denom":"ulava"}`, string(rewrittenMessage.Msg))
+	require.Equal(t, []pairingtypes.Metadata{{Name: "x-keep", Value: "2"}}, rewrittenMessage.Headers)
+	// the original message is left as it is
+	require.Equal(t, "cosmos.bank.v1beta1.Query/AllBalances", message.Path)
+	require.JSONEq(t, `{"address":"lava@1","pagination":{"limit":"10"}}`, string(message.Msg))
+	require.Len(t, message.Headers, 2)
+
+	// binary grpc bodies can't have their fields rewritten
+	message.Msg = []byte{0x0a, 0x01}
+	_, err = rewriteRequest(message, rules)
+	require.Error(t, err)
+}
+
+func TestRewriteRulesPerNodeUrl(t *testing.T) {
+	spec, err := keepertest.GetASpec("LAV1", "../../", nil, nil)
+	require.NoError(t, err)
+	chainParser, err := NewChainParser(spectypes.APIInterfaceTendermintRPC)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	chainMessage, err := chainParser.ParseMsg("", []byte(`{"jsonrpc":"2.0","id":1,"method":"status","params":[]}`), "", nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+
+	httpRule := common.RewriteRule{Match: common.RewriteMatch{ApiNames: []string{"status"}}, Request: common.RewriteActions{SetHeaders: map[string]string{"X-Url": "http"}}}
+	wsRule := common.RewriteRule{Match: common.RewriteMatch{ApiNames: []string{"status"}}, Request: common.RewriteActions{SetHeaders: map[string]string{"X-Url": "ws"}}}
+	chainProxy, err := newRewritingChainProxy(nil, []common.NodeUrl{{Url: "ws://127.0.0.1:26657/websocket", Rewrites: []common.RewriteRule{wsRule}}, {Url: "http://127.0.0.1:26657", Rewrites: []common.RewriteRule{httpRule}}})
+	require.NoError(t, err)
+	rewriting, ok := chainProxy.(*rewritingChainProxy)
+	require.True(t, ok)
+	// the rules of the url that serves the relay apply, not those of its sibling urls
+	require.Equal(t, []common.RewriteRule{httpRule}, rewriting.matchingRules(chainMessage, false))
+	require.Equal(t, []common.RewriteRule{wsRule}, rewriting.matchingRules(chainMessage, true))
+
+	// a path with a single url serves all of its relays
+	chainProxy, err = newRewritingChainProxy(nil, []common.NodeUrl{{Url: "http://127.0.0.1:26657", Rewrites: []common.RewriteRule{httpRule}}, {Url: "http://127.0.0.1:26658", InternalPath: "/other"}})
+	require.NoError(t, err)
+	rewriting, ok = chainProxy.(*rewritingChainProxy)
+	require.True(t, ok)
+	require.Equal(t, []common.RewriteRule{httpRule}, rewriting.matchingRules(chainMessage, true))
+	require.Nil(t, rewriting.servingNodeUrl("/missing", false))
+
+	// urls without rules aren't wrapped
+	chainProxy, err = newRewritingChainProxy(nil, []common.NodeUrl{{Url: "http://127.0.0.1:26657"}})
+	require.NoError(t, err)
+	require.Nil(t, chainProxy)
+}
+
+func TestRewriteJSONFields(t *testing.T) {
+	actions := common.RewriteActions{
+		RemoveFields: []string{"result.*.secret", "result.1", "missing.path"},
+		SetFields:    []common.RewriteField{{Path: "result.0.big", Value: "123456789012345678901234567890"}, {Path: "created.on.the.way", Value: `{"a":1}`}},
+	}
+	data, err := rewriteJSON([]byte(`{"result":[{"secret":1,"keep":2},{"secret":3},{"keep":4}]}`), actions, true)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result":[{"keep":2,"big":123456789012345678901234567890},{"keep":4}],"created":{"on":{"the":{"way":{"a":1}}}}}`, string(data))
+
+	// response actions apply to every item of a batch
+	data, err = rewriteJSON([]byte(`[{"id":1,"result":{"secret":1}},{"id":2,"error":{"code":1}}]`), common.RewriteActions{RemoveFields: []string{"result.secret"}}, true)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"id":1,"result":{}},{"id":2,"error":{"code":1}}]`, string(data))
+
+	// the document keeps its key order and html characters
+	data, err = rewriteJSON([]byte(`{"result":{"z":"<a&b>","secret":1,"a":[3,1]},"id":1,"jsonrpc":"2.0"}`), common.RewriteActions{RemoveFields: []string{"result.secret"}, SetFields: []common.RewriteField{{Path: "result.added", Value: `{"y":1,"x":2}`}}}, true)
+	require.NoError(t, err)
+	require.Equal(t, `{"result":{"z":"<a&b>","a":[3,1],"added":{"y":1,"x":2}},"id":1,"jsonrpc":"2.0"}`, string(data))
+
+	_, err = rewriteJSON([]byte(`{"result":"0x1"}`), common.RewriteActions{SetFields: []common.RewriteField{{Path: "result.field", Value: "1"}}}, true)
+	require.Error(t, err)
+	_, err = rewriteJSON([]byte(`not json`), actions, true)
+	require.Error(t, err)
+}
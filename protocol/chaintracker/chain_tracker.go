@@ -51,7 +51,8 @@ type blockTimeUpdatable interface {
 }
 
 type ChainTracker struct {
-	chainFetcher            ChainFetcher // used to communicate with the node
+	chainFetcherMu          sync.RWMutex
+	chainFetcher            ChainFetcher // used to communicate with the node, nil while polling is paused
 	blocksToSave            uint64       // how many finalized blocks to keep
 	latestBlockNum          int64
 	blockQueueMu            sync.RWMutex
@@ -147,15 +148,42 @@ func (cs *ChainTracker) setLatestBlockNum(value int64) {
 	atomic.StoreInt64(&cs.latestBlockNum, value)
 }
 
+// SetChainFetcher moves the tracker to poll the chain through another fetcher (and its node connections),
+// a nil fetcher pauses polling until a fetcher is set again
+func (cs *ChainTracker) SetChainFetcher(chainFetcher ChainFetcher) {
+	cs.chainFetcherMu.Lock()
+	defer cs.chainFetcherMu.Unlock()
+	cs.chainFetcher = chainFetcher
+}
+
+// IsPaused returns true while the tracker has no fetcher to poll the chain with
+func (cs *ChainTracker) IsPaused() bool {
+	return cs.getChainFetcher() == nil
+}
+
+func (cs *ChainTracker) getChainFetcher() ChainFetcher {
+	cs.chainFetcherMu.RLock()
+	defer cs.chainFetcherMu.RUnlock()
+	return cs.chainFetcher
+}
+
 func (cs *ChainTracker) fetchLatestBlockNum(ctx context.Context) (int64, error) {
-	return cs.chainFetcher.FetchLatestBlockNum(ctx)
+	chainFetcher := cs.getChainFetcher()
+	if chainFetcher == nil {
+		return 0, ErrorChainTrackerPaused
+	}
+	return chainFetcher.FetchLatestBlockNum(ctx)
 }
 
 func (cs *ChainTracker) fetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
 	if blockNum < cs.GetAtomicLatestBlockNum()-int64(cs.serverBlockMemory) {
 		return "", ErrorFailedToFetchTooEarlyBlock.Wrapf("requested Block: %d, latest block: %d, server memory %d", blockNum, cs.GetAtomicLatestBlockNum(), cs.serverBlockMemory)
 	}
-	return cs.chainFetcher.FetchBlockHashByNum(ctx, blockNum)
+	chainFetcher := cs.getChainFetcher()
+	if chainFetcher == nil {
+		return "", ErrorChainTrackerPaused
+	}
+	return chainFetcher.FetchBlockHashByNum(ctx, blockNum)
 }
 
 // this function fetches all previous blocks from the node starting at the latest provided going backwards blocksToSave blocks
@@ -375,6 +403,11 @@ func (cs *ChainTracker) start(ctx context.Context, pollingTime time.Duration) er
 		for {
 			select {
 			case <-cs.timer.C:
+				if cs.IsPaused() {
+					// no endpoint of the chain to poll through, keep the blocks we have until one is set
+					cs.timer.Reset(pollingTime)
+					continue
+				}
 				if debug {
 					utils.LavaFormatDebug("chain tracker fetch triggered", utils.Attribute{Key: "currTime", Value: time.Now()})
 				}
@@ -29,6 +29,20 @@ const (
 	RelayHealthIntervalFlag         = "relays-health-interval" // interval between each relay health check, default 5m
 	SharedStateFlag                 = "shared-state"
 	DisableConflictTransactionsFlag = "disable-conflict-transactions" // disable conflict transactions, this will hard the network's data reliability and therefore will harm the service.
+	OptimizerSnapshotPathFlag       = "optimizer-snapshot-path"       // file to persist the provider optimizer scores in, so they survive restarts
+	OptimizerSnapshotIntervalFlag   = "optimizer-snapshot-interval"   // interval between optimizer snapshots, default 1m
+	OptimizerDebugAddressFlag       = "optimizer-debug-address"       // address of the http endpoint explaining the provider optimizer choices
+	HedgeLatencyPercentileFlag      = "hedge-latency-percentile"      // fraction of a provider's expected latency after which the relay is also sent to the next provider
+	HedgeMaxCuFlag                  = "hedge-max-cu"                  // apis costing more cu are not hedged
+	HedgeApisFlag                   = "hedge-apis"                    // limits hedging to these api names
+	QuorumProvidersFlag             = "quorum-providers"              // number of providers a quorum relay is sent to
+	QuorumAgreementFlag             = "quorum-agreement"              // number of providers that must agree on a quorum relay response
+	QuorumChainsFlag                = "quorum-chains"                 // chains whose relays are all sent in quorum mode
+	QuorumApisFlag                  = "quorum-apis"                   // api names that are sent in quorum mode
+	SplitBatchesFlag                = "split-jsonrpc-batches"         // fan json-rpc batches out into relays of their items
+	BatchSplitSizeFlag              = "jsonrpc-batch-split-size"      // max items of a sub-batch when splitting json-rpc batches
+	BatchMaxItemsFlag               = "jsonrpc-batch-max-items"       // max items of a json-rpc batch that is split
+	BatchParallelRelaysFlag         = "jsonrpc-batch-parallel-relays" // max relays of a split json-rpc batch sent at the same time
 )
 
 const (
@@ -51,6 +65,20 @@ type ConsumerCmdFlags struct {
 	RelaysHealthIntervalFlag    time.Duration // interval for relay health check
 	DebugRelays                 bool          // enables debug mode for relays
 	DisableConflictTransactions bool          // disable conflict transactions
+	OptimizerSnapshotPath       string        // file to persist the provider optimizer scores in, empty disables it
+	OptimizerSnapshotInterval   time.Duration // interval between optimizer snapshots
+	OptimizerDebugAddress       string        // address of the optimizer introspection endpoint
+	HedgeLatencyPercentile      float64       // fraction of the expected latency to wait before hedging a relay, 0 disables hedging
+	HedgeMaxCu                  uint64        // apis costing more cu are not hedged, 0 for no cap
+	HedgeApis                   []string      // when set only these apis are hedged
+	QuorumProviders             int           // number of providers a quorum relay is sent to
+	QuorumAgreement             int           // number of providers that must agree on a quorum relay response
+	QuorumChains                []string      // chains whose relays are all sent in quorum mode
+	QuorumApis                  []string      // api names that are sent in quorum mode
+	SplitBatches                bool          // fan json-rpc batches out into relays of their items
+	BatchSplitSize              int           // uncached items of a split batch are sent in sub-batches of up to this size
+	BatchMaxItems               int           // batches with more items are rejected instead of being split
+	BatchParallelRelays         int           // max relays of a split batch sent at the same time
 }
 
 // default rolling logs behavior (if enabled) will store 3 files each 100MB for up to 1 day every time.
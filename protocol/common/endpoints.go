@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"net/url"
 	"strings"
@@ -23,11 +24,14 @@ const (
 	PROVIDER_ADDRESS_HEADER_NAME                    = "Lava-Provider-Address"
 	RETRY_COUNT_HEADER_NAME                         = "Lava-Retries"
 	GUID_HEADER_NAME                                = "Lava-Guid"
+	SUBSCRIPTION_RESUMED_HEADER_NAME                = "Lava-Subscription-Resumed"
 	// these headers need to be lowercase
 	BLOCK_PROVIDERS_ADDRESSES_HEADER_NAME = "lava-providers-block"
 	RELAY_TIMEOUT_HEADER_NAME             = "lava-relay-timeout"
 	EXTENSION_OVERRIDE_HEADER_NAME        = "lava-extension"
 	FORCE_CACHE_REFRESH_HEADER_NAME       = "lava-force-cache-refresh"
+	QUORUM_HEADER_NAME                    = "lava-quorum"
+	SPLIT_BATCH_HEADER_NAME               = "lava-split-batch"
 	// send http request to /lava/health to see if the process is up - (ret code 200)
 	DEFAULT_HEALTH_PATH                                       = "/lava/health"
 	MAXIMUM_ALLOWED_TIMEOUT_EXTEND_MULTIPLIER_BY_THE_CONSUMER = 4
@@ -41,6 +45,7 @@ type NodeUrl struct {
 	Timeout           time.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty" mapstructure:"timeout"`
 	Addons            []string      `yaml:"addons,omitempty" json:"addons,omitempty" mapstructure:"addons"`
 	SkipVerifications []string      `yaml:"skip-verifications,omitempty" json:"skip-verifications,omitempty" mapstructure:"skip-verifications"`
+	Rewrites          []RewriteRule `yaml:"rewrites,omitempty" json:"rewrites,omitempty" mapstructure:"rewrites"`
 }
 
 type ChainMessageGetApiInterface interface {
@@ -65,9 +70,10 @@ func (nurl *NodeUrl) UrlStr() string {
 	return parsedURL.String()
 }
 
+// header values may reference secrets, they are resolved on every call so rotated secrets are used right away
 func (url *NodeUrl) SetAuthHeaders(ctx context.Context, headerSetter func(string, string)) {
 	for header, headerValue := range url.AuthConfig.AuthHeaders {
-		headerSetter(header, headerValue)
+		headerSetter(header, ResolveSecretOrWarn(headerValue))
 	}
 }
 
@@ -129,6 +135,46 @@ func (ac *AuthConfig) GetUseTls() bool {
 	return ac.UseTLS
 }
 
+// LoadClientCertificate loads the client certificate to present to the server, it is meant to be called on every tls handshake so rotated certificates are used.
+// the pem values are file paths that are re-read when they change, or secret references holding the pem content itself
+func (ac *AuthConfig) LoadClientCertificate() (*tls.Certificate, error) {
+	keyPem, certPem := ac.GetLoadingCertificateParams()
+	if keyPem == "" || certPem == "" {
+		return &tls.Certificate{}, nil
+	}
+	keyPemBlock, err := resolvePem(keyPem)
+	if err != nil {
+		return nil, err
+	}
+	certPemBlock, err := resolvePem(certPem)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair([]byte(certPemBlock), []byte(keyPemBlock))
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// LoadCaCertificate loads the trusted root certificates for verifying the server, see LoadClientCertificate
+func (ac *AuthConfig) LoadCaCertificate() ([]byte, error) {
+	cacert := ac.GetCaCertificateParams()
+	if cacert == "" {
+		return nil, nil
+	}
+	caCert, err := resolvePem(cacert)
+	return []byte(caCert), err
+}
+
+func resolvePem(value string) (string, error) {
+	if !IsSecretReference(value) {
+		// a local path, watched for changes
+		value = SecretFilePrefix + value
+	}
+	return ResolveSecret(value)
+}
+
 // File containing client certificate (public key), to present to the
 // server. + File containing client private key, to present to the server.
 func (ac *AuthConfig) GetLoadingCertificateParams() (string, string) {
@@ -154,13 +200,14 @@ func (ac *AuthConfig) AddAuthPath(url string) string {
 	if ac.AuthQuery == "" {
 		return url
 	}
+	authQuery := ResolveSecretOrWarn(ac.AuthQuery)
 	// AuthPath is expected to be added as a uri optional parameter
 	if strings.Contains(url, "?") {
 		// there are already optional parameters
-		return url + URL_QUERY_PARAMETERS_SEPARATOR_OTHER_PARAMETERS + ac.AuthQuery
+		return url + URL_QUERY_PARAMETERS_SEPARATOR_OTHER_PARAMETERS + authQuery
 	}
 	// path doesn't have query parameters
-	return url + URL_QUERY_PARAMETERS_SEPARATOR_FROM_PATH + ac.AuthQuery
+	return url + URL_QUERY_PARAMETERS_SEPARATOR_FROM_PATH + authQuery
 }
 
 func ValidateEndpoint(endpoint, apiInterface string) error {
@@ -282,6 +329,12 @@ func GetTokenFromGrpcContext(ctx context.Context) string {
 	return ""
 }
 
+// the consumer forwards the force cache refresh directive to the provider in the grpc metadata
+func IsForceCacheRefreshFromGrpcContext(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(FORCE_CACHE_REFRESH_HEADER_NAME)) > 0
+}
+
 func GetUniqueToken(consumerAddress string, ip string) string {
 	data := []byte(consumerAddress + ip)
 	return base64.StdEncoding.EncodeToString(sigs.HashMsg(data))
@@ -7,4 +7,5 @@ var (
 	StatusCodeError504           = sdkerrors.New("Disallowed StatusCode Error", 504, "Disallowed status code error")
 	StatusCodeError429           = sdkerrors.New("Disallowed StatusCode Error", 429, "Disallowed status code error")
 	StatusCodeErrorStrict        = sdkerrors.New("Disallowed StatusCode Error", 800, "Disallowed status code error")
+	NodeUnreachableError         = sdkerrors.New("NodeUnreachable Error", 801, "node unreachable, the request was not sent")
 )
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const RewriteFieldPathSeparator = "."
+
+// RewriteRule edits the requests of the matching apis before they are sent to the node, and the node's responses before they are returned.
+// it lets a provider adapt to a specific node without changing the chain proxies
+type RewriteRule struct {
+	Match    RewriteMatch   `yaml:"match,omitempty" json:"match,omitempty" mapstructure:"match"`
+	Request  RewriteActions `yaml:"request,omitempty" json:"request,omitempty" mapstructure:"request"`
+	Response RewriteActions `yaml:"response,omitempty" json:"response,omitempty" mapstructure:"response"`
+}
+
+// RewriteMatch selects the relays a rule applies to, a relay matches if it matches any of the api names or paths. an empty match applies to all relays
+type RewriteMatch struct {
+	ApiNames []string `yaml:"api-names,omitempty" json:"api-names,omitempty" mapstructure:"api-names"` // spec api names, the json-rpc method for json-rpc
+	Paths    []string `yaml:"paths,omitempty" json:"paths,omitempty" mapstructure:"paths"`             // glob patterns of the rest, grpc and tendermint uri paths
+}
+
+// RewriteActions are applied in order: method, removed headers, set headers, removed fields, set fields.
+// field paths are dot separated keys and array indexes, * matches all the elements. request fields are relative to the json-rpc params
+// or the rest and grpc json body, response fields to the json response, or to every item of a batch response
+type RewriteActions struct {
+	Method        string            `yaml:"method,omitempty" json:"method,omitempty" mapstructure:"method"`                // request only, the method or path the node is called with
+	SetHeaders    map[string]string `yaml:"set-headers,omitempty" json:"set-headers,omitempty" mapstructure:"set-headers"` // values may reference secrets
+	RemoveHeaders []string          `yaml:"remove-headers,omitempty" json:"remove-headers,omitempty" mapstructure:"remove-headers"`
+	SetFields     []RewriteField    `yaml:"set-fields,omitempty" json:"set-fields,omitempty" mapstructure:"set-fields"`
+	RemoveFields  []string          `yaml:"remove-fields,omitempty" json:"remove-fields,omitempty" mapstructure:"remove-fields"`
+}
+
+// RewriteField sets the field at the path to a json value, values that aren't valid json are set as strings.
+// it is a list and not a map since config keys are lower cased and field paths are case sensitive
+type RewriteField struct {
+	Path  string `yaml:"path" json:"path" mapstructure:"path"`
+	Value string `yaml:"value" json:"value" mapstructure:"value"`
+}
+
+func (ra *RewriteActions) IsEmpty() bool {
+	return ra.Method == "" && len(ra.SetHeaders) == 0 && len(ra.RemoveHeaders) == 0 && len(ra.SetFields) == 0 && len(ra.RemoveFields) == 0
+}
+
+func (ra *RewriteActions) validate() error {
+	for _, field := range ra.SetFields {
+		if _, err := SplitRewriteFieldPath(field.Path); err != nil {
+			return err
+		}
+	}
+	for _, fieldPath := range ra.RemoveFields {
+		if _, err := SplitRewriteFieldPath(fieldPath); err != nil {
+			return err
+		}
+	}
+	for header := range ra.SetHeaders {
+		if strings.TrimSpace(header) == "" {
+			return fmt.Errorf("empty header name")
+		}
+	}
+	return nil
+}
+
+func (rr *RewriteRule) Validate() error {
+	if rr.Request.IsEmpty() && rr.Response.IsEmpty() {
+		return fmt.Errorf("rewrite rule has no request or response actions")
+	}
+	if rr.Response.Method != "" {
+		return fmt.Errorf("rewrite rule method can only be set on the request")
+	}
+	for _, pattern := range rr.Match.Paths {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid rewrite rule path pattern %q: %w", pattern, err)
+		}
+	}
+	if err := rr.Request.validate(); err != nil {
+		return fmt.Errorf("invalid rewrite rule request: %w", err)
+	}
+	if err := rr.Response.validate(); err != nil {
+		return fmt.Errorf("invalid rewrite rule response: %w", err)
+	}
+	return nil
+}
+
+// Matches returns true if the rule applies to a relay of the api name and path, path is empty for apis without one
+func (rr *RewriteRule) Matches(apiName, apiPath string) bool {
+	if len(rr.Match.ApiNames) == 0 && len(rr.Match.Paths) == 0 {
+		return true
+	}
+	for _, name := range rr.Match.ApiNames {
+		if name == apiName {
+			return true
+		}
+	}
+	if apiPath == "" {
+		return false
+	}
+	for _, pattern := range rr.Match.Paths {
+		if matched, _ := path.Match(pattern, apiPath); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func SplitRewriteFieldPath(fieldPath string) ([]string, error) {
+	segments := strings.Split(fieldPath, RewriteFieldPathSeparator)
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid rewrite field path %q", fieldPath)
+		}
+	}
+	return segments, nil
+}
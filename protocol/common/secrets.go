@@ -0,0 +1,213 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+// auth values of a node url can reference a secret instead of holding it:
+// env://NAME reads an environment variable, file:///path reads a file and re-reads it when it changes,
+// secret+https://host/path#json.field fetches an http secret endpoint (such as vault) and optionally picks a field of its json response
+const (
+	SecretEnvPrefix      = "env://"
+	SecretFilePrefix     = "file://"
+	SecretHTTPPrefix     = "secret+"
+	SecretsTokenEnv      = "LAVA_SECRETS_TOKEN" // sent to https secret endpoints when set, never over plain http
+	secretRequestTimeout = 10 * time.Second
+)
+
+var (
+	SecretFileCheckInterval   = 5 * time.Second
+	SecretHTTPRefreshInterval = time.Minute
+	secrets                   = &secretStore{entries: map[string]*secretEntry{}}
+	secretsHTTPClient         = http.DefaultClient
+)
+
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretEnvPrefix) || strings.HasPrefix(value, SecretFilePrefix) ||
+		strings.HasPrefix(value, SecretHTTPPrefix+"http://") || strings.HasPrefix(value, SecretHTTPPrefix+"https://")
+}
+
+// ResolveSecret returns the current value of a secret reference, values that aren't references are returned as is.
+// rotated secrets are picked up in the background, the last good value is kept if refreshing fails
+func ResolveSecret(value string) (string, error) {
+	if !IsSecretReference(value) {
+		return value, nil
+	}
+	if strings.HasPrefix(value, SecretEnvPrefix) {
+		name := strings.TrimPrefix(value, SecretEnvPrefix)
+		secret, ok := os.LookupEnv(name)
+		if !ok {
+			return "", utils.LavaFormatError("secret environment variable is not set", nil, utils.LogAttr("name", name))
+		}
+		return secret, nil
+	}
+	return secrets.get(value)
+}
+
+// ResolveSecretOrWarn resolves a secret reference for use in a request, failures are logged and resolve to an empty value
+func ResolveSecretOrWarn(value string) string {
+	secret, err := ResolveSecret(value)
+	if err != nil {
+		utils.LavaFormatWarning("failed resolving secret", err, utils.LogAttr("reference", value))
+	}
+	return secret
+}
+
+type secretEntry struct {
+	reference  string
+	lock       sync.Mutex
+	value      string
+	loaded     bool
+	refreshing bool
+	checkedAt  time.Time
+	modTime    time.Time // files are only re-read when they change
+	size       int64
+}
+
+type secretStore struct {
+	lock    sync.Mutex
+	entries map[string]*secretEntry
+}
+
+func (ss *secretStore) get(reference string) (string, error) {
+	ss.lock.Lock()
+	entry, ok := ss.entries[reference]
+	if !ok {
+		entry = &secretEntry{reference: reference}
+		ss.entries[reference] = entry
+	}
+	ss.lock.Unlock()
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	if !entry.loaded {
+		// the first load is synchronous, there is nothing to return yet
+		err := entry.refreshLocked()
+		if err != nil {
+			return "", err
+		}
+		return entry.value, nil
+	}
+	if !entry.refreshing && time.Since(entry.checkedAt) >= entry.refreshInterval() {
+		entry.refreshing = true
+		go func() {
+			entry.lock.Lock()
+			defer entry.lock.Unlock()
+			entry.refreshing = false
+			err := entry.refreshLocked()
+			if err != nil {
+				utils.LavaFormatWarning("failed refreshing secret, keeping the previous value", err, utils.LogAttr("reference", entry.reference))
+			}
+		}()
+	}
+	return entry.value, nil
+}
+
+func (se *secretEntry) refreshInterval() time.Duration {
+	if strings.HasPrefix(se.reference, SecretFilePrefix) {
+		return SecretFileCheckInterval
+	}
+	return SecretHTTPRefreshInterval
+}
+
+// must be called while locked
+func (se *secretEntry) refreshLocked() error {
+	se.checkedAt = time.Now()
+	if strings.HasPrefix(se.reference, SecretFilePrefix) {
+		path := strings.TrimPrefix(se.reference, SecretFilePrefix)
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if se.loaded && info.ModTime().Equal(se.modTime) && info.Size() == se.size {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if se.loaded {
+			utils.LavaFormatInfo("secret file changed, reloading it", utils.LogAttr("path", path))
+		}
+		se.modTime, se.size = info.ModTime(), info.Size()
+		se.setValue(strings.TrimSpace(string(data)))
+		return nil
+	}
+	value, err := fetchHTTPSecret(strings.TrimPrefix(se.reference, SecretHTTPPrefix))
+	if err != nil {
+		return err
+	}
+	se.setValue(value)
+	return nil
+}
+
+func (se *secretEntry) setValue(value string) {
+	se.value = value
+	se.loaded = true
+}
+
+func fetchHTTPSecret(secretUrl string) (string, error) {
+	field := ""
+	if idx := strings.Index(secretUrl, "#"); idx >= 0 {
+		secretUrl, field = secretUrl[:idx], secretUrl[idx+1:]
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), secretRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	if token := os.Getenv(SecretsTokenEnv); token != "" {
+		if req.URL.Scheme == "https" {
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("X-Vault-Token", token)
+		} else {
+			utils.LavaFormatWarning("not sending "+SecretsTokenEnv+" to a plain http secret endpoint, use https", nil, utils.LogAttr("url", secretUrl))
+		}
+	}
+	res, err := secretsHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secret endpoint %s returned status %d", secretUrl, res.StatusCode)
+	}
+	if field == "" {
+		return strings.TrimSpace(string(body)), nil
+	}
+	var parsed interface{}
+	err = json.Unmarshal(body, &parsed)
+	if err != nil {
+		return "", fmt.Errorf("secret endpoint %s didn't return json: %w", secretUrl, err)
+	}
+	for _, key := range strings.Split(field, ".") {
+		object, ok := parsed.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("secret field %s not found in %s", field, secretUrl)
+		}
+		parsed, ok = object[key]
+		if !ok {
+			return "", fmt.Errorf("secret field %s not found in %s", field, secretUrl)
+		}
+	}
+	value, ok := parsed.(string)
+	if !ok {
+		return "", fmt.Errorf("secret field %s in %s is not a string", field, secretUrl)
+	}
+	return value, nil
+}
@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecret(t *testing.T) {
+	value, err := ResolveSecret("plain-value")
+	require.NoError(t, err)
+	require.Equal(t, "plain-value", value)
+
+	t.Setenv("LAVA_TEST_SECRET", "from-env")
+	value, err = ResolveSecret("env://LAVA_TEST_SECRET")
+	require.NoError(t, err)
+	require.Equal(t, "from-env", value)
+	_, err = ResolveSecret("env://LAVA_TEST_SECRET_MISSING")
+	require.Error(t, err)
+}
+
+func TestResolveSecretFileRotation(t *testing.T) {
+	SecretFileCheckInterval = time.Millisecond
+	defer func() { SecretFileCheckInterval = 5 * time.Second }()
+	path := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	value, err := ResolveSecret(SecretFilePrefix + path)
+	require.NoError(t, err)
+	require.Equal(t, "first", value)
+
+	require.NoError(t, os.WriteFile(path, []byte("second-key\n"), 0o600))
+	require.Eventually(t, func() bool {
+		value, err := ResolveSecret(SecretFilePrefix + path)
+		return err == nil && value == "second-key"
+	}, time.Second, 5*time.Millisecond)
+
+	// a missing file keeps the last value
+	require.NoError(t, os.Remove(path))
+	time.Sleep(10 * time.Millisecond)
+	value, err = ResolveSecret(SecretFilePrefix + path)
+	require.NoError(t, err)
+	require.Equal(t, "second-key", value)
+}
+
+func TestResolveSecretHTTP(t *testing.T) {
+	SecretHTTPRefreshInterval = time.Millisecond
+	defer func() { SecretHTTPRefreshInterval = time.Minute }()
+	t.Setenv(SecretsTokenEnv, "token")
+	version := int32(1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if atomic.LoadInt32(&version) == 1 {
+			w.Write([]byte(`{"data":{"data":{"api_key":"key-1"}}}`))
+		} else {
+			w.Write([]byte(`{"data":{"data":{"api_key":"key-2"}}}`))
+		}
+	}))
+	defer server.Close()
+	secretsHTTPClient = server.Client()
+	defer func() { secretsHTTPClient = http.DefaultClient }()
+
+	reference := SecretHTTPPrefix + server.URL + "/v1/secret#data.data.api_key"
+	require.True(t, IsSecretReference(reference))
+	value, err := ResolveSecret(reference)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", value)
+
+	atomic.StoreInt32(&version, 2)
+	require.Eventually(t, func() bool {
+		value, err := ResolveSecret(reference)
+		return err == nil && value == "key-2"
+	}, time.Second, 5*time.Millisecond)
+
+	_, err = ResolveSecret(SecretHTTPPrefix + server.URL + "/v1/secret#data.missing")
+	require.Error(t, err)
+}
+
+func TestResolveSecretHTTPNoTokenInCleartext(t *testing.T) {
+	t.Setenv(SecretsTokenEnv, "token")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "" || r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("public"))
+	}))
+	defer server.Close()
+
+	value, err := ResolveSecret(SecretHTTPPrefix + server.URL + "/v1/public")
+	require.NoError(t, err)
+	require.Equal(t, "public", value)
+}
+
+func TestAuthConfigSecrets(t *testing.T) {
+	t.Setenv("LAVA_TEST_AUTH_QUERY", "key=rotating")
+	t.Setenv("LAVA_TEST_AUTH_HEADER", "header-secret")
+	nodeUrl := NodeUrl{AuthConfig: AuthConfig{AuthQuery: "env://LAVA_TEST_AUTH_QUERY", AuthHeaders: map[string]string{"X-Api-Key": "env://LAVA_TEST_AUTH_HEADER", "X-Plain": "plain"}}}
+	require.Equal(t, "http://node?key=rotating", nodeUrl.AuthConfig.AddAuthPath("http://node"))
+	headers := map[string]string{}
+	nodeUrl.SetAuthHeaders(context.Background(), func(key, value string) { headers[key] = value })
+	require.Equal(t, map[string]string{"X-Api-Key": "header-secret", "X-Plain": "plain"}, headers)
+
+	t.Setenv("LAVA_TEST_AUTH_QUERY", "key=rotated")
+	require.Equal(t, "http://node?a=b&key=rotated", nodeUrl.AuthConfig.AddAuthPath("http://node?a=b"))
+}
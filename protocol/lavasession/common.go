@@ -38,6 +38,7 @@ const (
 	IndexNotFound                                    = -15
 	MinValidAddressesForBlockingProbing              = 2
 	BACKOFF_TIME_ON_FAILURE                          = 3 * time.Second
+	BACKOFF_TIME_ON_PROVIDER_OVERLOAD                = 2 * time.Second         // providers rejecting relays as overloaded aren't picked for this long
 	BLOCKING_PROBE_SLEEP_TIME                        = 1000 * time.Millisecond // maximum amount of time to sleep before triggering probe, to scatter probes uniformly across chains
 	BLOCKING_PROBE_TIMEOUT                           = time.Minute             // maximum time to wait for probe to complete before updating pairing
 )
@@ -58,6 +59,11 @@ func IsSessionSyncLoss(err error) bool {
 	return code == codes.Code(SessionOutOfSyncError.ABCICode())
 }
 
+func IsProviderOverloaded(err error) bool {
+	code := status.Code(err)
+	return code == codes.Code(ProviderOverloadedError.ABCICode())
+}
+
 func ConnectgRPCClient(ctx context.Context, address string, allowInsecure bool) (*grpc.ClientConn, error) {
 	var tlsConf tls.Config
 	if allowInsecure {
@@ -166,3 +172,15 @@ func SortByGeolocations(pairingEndpoints []*Endpoint, currentGeo planstypes.Geol
 	}
 	slices.SortStableFunc(pairingEndpoints, lessFunc)
 }
+
+type dapp_id_ctx_key struct{}
+
+// WithDappID attaches the requesting dapp to the context, so GetSessions can let the optimizer strategy pick providers per dapp
+func WithDappID(ctx context.Context, dappID string) context.Context {
+	return context.WithValue(ctx, dapp_id_ctx_key{}, dappID)
+}
+
+func DappIDFromContext(ctx context.Context) (dappID string, found bool) {
+	dappID, found = ctx.Value(dapp_id_ctx_key{}).(string)
+	return dappID, found
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/common"
 	metrics "github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/provideroptimizer"
@@ -51,6 +52,11 @@ func (csm *ConsumerSessionManager) RPCEndpoint() RPCEndpoint {
 	return *csm.rpcEndpoint
 }
 
+// CrossCheck returns true if the selection strategy wants every response verified against another provider
+func (csm *ConsumerSessionManager) CrossCheck() bool {
+	return csm.providerOptimizer.CrossCheck()
+}
+
 func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList map[uint64]*ConsumerSessionsWithProvider) error {
 	pairingListLength := len(pairingList)
 	// TODO: we can block updating until some of the probing is done, this can prevent failed attempts on epoch change when we have no information on the providers,
@@ -83,10 +89,13 @@ func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList
 	csm.closePurgedUnusedPairingsConnections() // this must be before updating csm.pairingPurge as we want to close the connections of older sessions (prev 2 epochs)
 	csm.pairingPurge = csm.pairing
 	csm.pairing = make(map[string]*ConsumerSessionsWithProvider, pairingListLength)
+	stakes := make(map[string]sdk.Coin, pairingListLength)
 	for idx, provider := range pairingList {
 		csm.pairingAddresses[idx] = provider.PublicLavaAddress
 		csm.pairing[provider.PublicLavaAddress] = provider
+		stakes[provider.PublicLavaAddress] = provider.getProviderStakeSize()
 	}
+	csm.providerOptimizer.UpdateProviderStakes(stakes)
 	csm.setValidAddressesToDefaultValue("", nil) // the starting point is that valid addresses are equal to pairing addresses.
 	csm.resetMetricsManager()
 	utils.LavaFormatDebug("updated providers", utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "spec", Value: csm.rpcEndpoint.Key()})
@@ -332,8 +341,9 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 		currentEpoch: csm.atomicReadCurrentEpoch(),
 	}
 
+	dappID, _ := DappIDFromContext(ctx)
 	// Get a valid consumerSessionsWithProvider
-	sessionWithProviderMap, err := csm.getValidConsumerSessionsWithProvider(tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch)
+	sessionWithProviderMap, err := csm.getValidConsumerSessionsWithProvider(dappID, tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -455,7 +465,7 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 		}
 
 		// If we do not have enough fetch more
-		sessionWithProviderMap, err = csm.getValidConsumerSessionsWithProvider(tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch)
+		sessionWithProviderMap, err = csm.getValidConsumerSessionsWithProvider(dappID, tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch)
 
 		// If error exists but we have sessions, return them
 		if err != nil && len(sessions) != 0 {
@@ -470,7 +480,7 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 }
 
 // Get a valid provider address.
-func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersList map[string]struct{}, cu uint64, requestedBlock int64, addon string, extensions []string, stateful uint32) (addresses []string, err error) {
+func (csm *ConsumerSessionManager) getValidProviderAddresses(dappID string, ignoredProvidersList map[string]struct{}, cu uint64, requestedBlock int64, addon string, extensions []string, stateful uint32) (addresses []string, err error) {
 	// cs.Lock must be Rlocked here.
 	ignoredProvidersListLength := len(ignoredProvidersList)
 	validAddresses := csm.getValidAddresses(addon, extensions)
@@ -494,7 +504,7 @@ func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersLis
 	if stateful == common.CONSISTENCY_SELECT_ALLPROVIDERS && csm.providerOptimizer.Strategy() != provideroptimizer.STRATEGY_COST {
 		providers = GetAllProviders(validAddresses, ignoredProvidersList)
 	} else {
-		providers = csm.providerOptimizer.ChooseProvider(validAddresses, ignoredProvidersList, cu, requestedBlock, OptimizerPerturbation)
+		providers = csm.providerOptimizer.ChooseProviderForRequest(provideroptimizer.SelectionRequest{DappID: dappID, ApiInterface: csm.rpcEndpoint.ApiInterface, Cu: cu, RequestedBlock: requestedBlock, Perturbation: OptimizerPerturbation}, validAddresses, ignoredProvidersList)
 	}
 	if debug {
 		utils.LavaFormatDebug("choosing providers",
@@ -517,7 +527,7 @@ func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersLis
 	return providers, nil
 }
 
-func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredProviders *ignoredProviders, cuNeededForSession uint64, requestedBlock int64, addon string, extensions []string, stateful uint32, virtualEpoch uint64) (sessionWithProviderMap SessionWithProviderMap, err error) {
+func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(dappID string, ignoredProviders *ignoredProviders, cuNeededForSession uint64, requestedBlock int64, addon string, extensions []string, stateful uint32, virtualEpoch uint64) (sessionWithProviderMap SessionWithProviderMap, err error) {
 	csm.lock.RLock()
 	defer csm.lock.RUnlock()
 	if debug {
@@ -531,7 +541,7 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 	}
 
 	// Fetch provider addresses
-	providerAddresses, err := csm.getValidProviderAddresses(ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful)
+	providerAddresses, err := csm.getValidProviderAddresses(dappID, ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful)
 	if err != nil {
 		utils.LavaFormatError(csm.rpcEndpoint.ChainID+" could not get a provider addresses", err)
 		return nil, err
@@ -564,6 +574,10 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 				ignoredProviders.providers[providerAddress] = struct{}{}
 				continue
 			}
+			if consumerSessionsWithProvider.isOverloaded(time.Now()) {
+				ignoredProviders.providers[providerAddress] = struct{}{}
+				continue
+			}
 
 			// If no error, add provider session map
 			sessionWithProviderMap[providerAddress] = &SessionWithProvider{
@@ -580,7 +594,7 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 		}
 
 		// If we do not have enough fetch more
-		providerAddresses, err = csm.getValidProviderAddresses(ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful)
+		providerAddresses, err = csm.getValidProviderAddresses(dappID, ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful)
 
 		// If error exists but we have providers, return them
 		if err != nil && len(sessionWithProviderMap) != 0 {
@@ -684,6 +698,16 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 		return sdkerrors.Wrapf(SessionIsAlreadyBlockListedError, "trying to report a session failure of a blocklisted consumer session")
 	}
 
+	if IsProviderOverloaded(errorReceived) {
+		// the provider is healthy but busy, back off from it instead of counting the failure against it
+		parentConsumerSessionsWithProvider := consumerSession.Parent
+		parentConsumerSessionsWithProvider.backOffOnOverload(time.Now())
+		cuToDecrease := consumerSession.LatestRelayCu
+		consumerSession.LatestRelayCu = 0
+		consumerSession.lock.Unlock()
+		return parentConsumerSessionsWithProvider.decreaseUsedComputeUnits(cuToDecrease)
+	}
+
 	// check if need to block & report
 	var blockProvider, reportProvider bool
 	if ReportAndBlockProviderError.Is(errorReceived) {
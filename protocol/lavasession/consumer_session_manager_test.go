@@ -18,7 +18,9 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -45,7 +47,7 @@ func CreateConsumerSessionManager() *ConsumerSessionManager {
 	AllowInsecureConnectionToProviders = true // set to allow insecure for tests purposes
 	rand.InitRandomSeed()
 	baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
-	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", false, "/", 0}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil, nil)
+	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", false, "/", 0, ""}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil, nil)
 }
 
 var grpcServer *grpc.Server
@@ -545,6 +547,45 @@ func TestSessionFailureAndGetReportedProviders(t *testing.T) {
 	}
 }
 
+func TestSessionFailureProviderOverloaded(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.NoError(t, err)
+	css, err := csm.GetSessions(ctx, cuForFirstRequest, nil, servicedBlockNumber, "", nil, common.NOSTATE, 0) // get a session
+	require.NoError(t, err)
+
+	overloadedProviders := map[string]struct{}{}
+	for _, cs := range css {
+		err = csm.OnSessionFailure(cs.Session, status.Error(codes.Code(ProviderOverloadedError.ABCICode()), "overloaded"))
+		require.NoError(t, err)
+		require.Equal(t, cs.Session.Parent.UsedComputeUnits, cuSumOnFailure)
+		require.Equal(t, cs.Session.LatestRelayCu, latestRelayCuAfterDone)
+		// an overloaded provider isn't at fault, it is neither blocked nor reported
+		require.Empty(t, cs.Session.ConsecutiveErrors)
+		require.False(t, cs.Session.BlockListed)
+		require.False(t, csm.reportedProviders.IsReported(cs.Session.Parent.PublicLavaAddress))
+		require.Contains(t, csm.validAddresses, cs.Session.Parent.PublicLavaAddress)
+		overloadedProviders[cs.Session.Parent.PublicLavaAddress] = struct{}{}
+	}
+
+	// the provider isn't picked until the back off ends
+	for i := 0; i < numberOfProviders*2; i++ {
+		css, err = csm.GetSessions(ctx, cuForFirstRequest, nil, servicedBlockNumber, "", nil, common.NOSTATE, 0)
+		require.NoError(t, err)
+		for providerAddress, cs := range css {
+			require.NotContains(t, overloadedProviders, providerAddress)
+			require.NoError(t, csm.OnSessionUnUsed(cs.Session))
+		}
+	}
+	for _, provider := range csm.pairing {
+		if _, ok := overloadedProviders[provider.PublicLavaAddress]; ok {
+			require.False(t, provider.isOverloaded(time.Now().Add(BACKOFF_TIME_ON_PROVIDER_OVERLOAD)))
+		}
+	}
+}
+
 // Test the basic functionality of the consumerSessionManager
 func TestSessionFailureEpochMisMatch(t *testing.T) {
 	ctx := context.Background()
@@ -762,7 +803,7 @@ func TestNoPairingsError(t *testing.T) {
 	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
 	require.NoError(t, err)
 	time.Sleep(5 * time.Millisecond) // let probes finish
-	_, err = csm.getValidProviderAddresses(map[string]struct{}{}, 10, 100, "invalid", nil, common.NOSTATE)
+	_, err = csm.getValidProviderAddresses("", map[string]struct{}{}, 10, 100, "invalid", nil, common.NOSTATE)
 	require.Error(t, err)
 	require.True(t, PairingListEmptyError.Is(err))
 }
@@ -37,9 +37,11 @@ type ProviderOptimizer interface {
 	AppendProbeRelayData(providerAddress string, latency time.Duration, success bool)
 	AppendRelayFailure(providerAddress string)
 	AppendRelayData(providerAddress string, latency time.Duration, isHangingApi bool, cu, syncBlock uint64)
-	ChooseProvider(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string)
+	ChooseProviderForRequest(request provideroptimizer.SelectionRequest, allAddresses []string, ignoredProviders map[string]struct{}) (addresses []string)
 	GetExcellenceQoSReportForProvider(string) *pairingtypes.QualityOfServiceReport
 	Strategy() provideroptimizer.Strategy
+	CrossCheck() bool
+	UpdateProviderStakes(stakes map[string]sdk.Coin)
 }
 
 type ignoredProviders struct {
@@ -104,6 +106,7 @@ type RPCEndpoint struct {
 	TLSEnabled      bool   `yaml:"tls-enabled,omitempty" json:"tls-enabled,omitempty" mapstructure:"tls-enabled"`
 	HealthCheckPath string `yaml:"health-check-path,omitempty" json:"health-check-path,omitempty" mapstructure:"health-check-path"` // health check status code 200 path, default is "/"
 	Geolocation     uint64 `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
+	Strategy        string `yaml:"strategy,omitempty" json:"strategy,omitempty" mapstructure:"strategy"` // provider selection strategy for this endpoint, defaults to the --strategy flag
 }
 
 func (endpoint *RPCEndpoint) String() (retStr string) {
@@ -135,6 +138,7 @@ type ConsumerSessionsWithProvider struct {
 	// whether we already reported this provider this epoch, we can only report one conflict per provider per epoch
 	conflictFoundAndReported uint32   // 0 == not reported, 1 == reported
 	stakeSize                sdk.Coin // the stake size the provider staked
+	overloadedUntil          int64    // unix nano, the provider isn't picked until then after rejecting relays as overloaded
 }
 
 func NewConsumerSessionWithProvider(publicLavaAddress string, pairingEndpoints []*Endpoint, maxCu uint64, epoch uint64, stakeSize sdk.Coin) *ConsumerSessionsWithProvider {
@@ -167,6 +171,14 @@ func (cswp *ConsumerSessionsWithProvider) StoreConflictReported() {
 	cswp.atomicWriteConflictReported()
 }
 
+func (cswp *ConsumerSessionsWithProvider) backOffOnOverload(now time.Time) {
+	atomic.StoreInt64(&cswp.overloadedUntil, now.Add(BACKOFF_TIME_ON_PROVIDER_OVERLOAD).UnixNano())
+}
+
+func (cswp *ConsumerSessionsWithProvider) isOverloaded(now time.Time) bool {
+	return now.UnixNano() < atomic.LoadInt64(&cswp.overloadedUntil)
+}
+
 func (cswp *ConsumerSessionsWithProvider) IsSupportingAddon(addon string) bool {
 	cswp.Lock.RLock()
 	defer cswp.Lock.RUnlock()
@@ -48,4 +48,5 @@ var ( // Provider Side Errors
 	CouldNotFindIndexAsConsumerNotYetRegisteredError = sdkerrors.New("CouldNotFindIndexAsConsumerNotYetRegistered Error", 897, "fetching provider index from psm failed")
 	ProviderIndexMisMatchError                       = sdkerrors.New("ProviderIndexMisMatch Error", 898, "provider index mismatch")
 	SessionIdNotFoundError                           = sdkerrors.New("SessionIdNotFound Error", 899, "Session Id not found")
+	ProviderOverloadedError                          = sdkerrors.New("ProviderOverloaded Error", 900, "Provider is overloaded, retry later")
 )
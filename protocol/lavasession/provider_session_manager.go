@@ -2,6 +2,7 @@ package lavasession
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -449,6 +450,110 @@ func (psm *ProviderSessionManager) UpdateSessionCU(consumerAddress string, epoch
 	return nil
 }
 
+// ProviderSessionsReport is a snapshot of the sessions a provider endpoint holds for its consumers
+type ProviderSessionsReport struct {
+	CurrentEpoch uint64                `json:"current_epoch"`
+	BlockedEpoch uint64                `json:"blocked_epoch"` // relays of this epoch and older are rejected
+	Epochs       []EpochSessionsReport `json:"epochs"`
+}
+
+type EpochSessionsReport struct {
+	Epoch    uint64                  `json:"epoch"`
+	Projects []ProjectSessionsReport `json:"projects"`
+}
+
+type ProjectSessionsReport struct {
+	ProjectID           string          `json:"project_id"`
+	Consumers           []string        `json:"consumers"`
+	UsedComputeUnits    uint64          `json:"used_compute_units"`
+	MaxComputeUnits     uint64          `json:"max_compute_units"`
+	MissingComputeUnits uint64          `json:"missing_compute_units"`
+	Blocked             bool            `json:"blocked"`
+	DataReliability     bool            `json:"data_reliability"`
+	Sessions            []SessionReport `json:"sessions"`
+	Subscriptions       []string        `json:"subscriptions"`
+}
+
+type SessionReport struct {
+	SessionID uint64 `json:"session_id"`
+	CuSum     uint64 `json:"cu_sum"`
+	RelayNum  uint64 `json:"relay_num"`
+	Errors    uint64 `json:"errors"`
+	InUse     bool   `json:"in_use"` // relay number and errors aren't read while a relay holds the session
+	Badge     bool   `json:"badge"`
+}
+
+// Report returns the sessions of every epoch still in memory, filtered by consumer address when it isn't empty
+func (psm *ProviderSessionManager) Report(consumerAddress string) ProviderSessionsReport {
+	psm.lock.RLock()
+	defer psm.lock.RUnlock()
+	report := ProviderSessionsReport{CurrentEpoch: psm.currentEpoch, BlockedEpoch: psm.blockedEpochHeight, Epochs: []EpochSessionsReport{}}
+	for epoch, epochSessions := range psm.sessionsWithAllConsumers {
+		projectConsumers := map[string][]string{}
+		if mapping, ok := psm.consumerPairedWithProjectMap[epoch]; ok {
+			for consumer, projectId := range mapping.consumerToProjectMap {
+				projectConsumers[projectId] = append(projectConsumers[projectId], consumer)
+			}
+		}
+		epochReport := EpochSessionsReport{Epoch: epoch, Projects: []ProjectSessionsReport{}}
+		for projectId, pswc := range epochSessions.sessionMap {
+			consumers := projectConsumers[projectId]
+			if consumerAddress != "" && !slices.Contains(consumers, consumerAddress) {
+				continue
+			}
+			sort.Strings(consumers)
+			epochReport.Projects = append(epochReport.Projects, pswc.report(projectId, consumers))
+		}
+		if len(epochReport.Projects) == 0 {
+			continue
+		}
+		sort.Slice(epochReport.Projects, func(i, j int) bool {
+			return epochReport.Projects[i].ProjectID < epochReport.Projects[j].ProjectID
+		})
+		report.Epochs = append(report.Epochs, epochReport)
+	}
+	sort.Slice(report.Epochs, func(i, j int) bool {
+		return report.Epochs[i].Epoch < report.Epochs[j].Epoch
+	})
+	return report
+}
+
+// must be called while psm is locked, subscriptions are guarded by it
+func (pswc *ProviderSessionsWithConsumerProject) report(projectId string, consumers []string) ProjectSessionsReport {
+	report := ProjectSessionsReport{
+		ProjectID:           projectId,
+		Consumers:           consumers,
+		UsedComputeUnits:    pswc.atomicReadUsedComputeUnits(),
+		MaxComputeUnits:     pswc.atomicReadMaxComputeUnits(),
+		MissingComputeUnits: pswc.atomicReadMissingComputeUnits(),
+		Blocked:             pswc.atomicReadConsumerBlocked() != notBlockListedConsumer,
+		DataReliability:     pswc.atomicReadIsDataReliability() == isDataReliabilityPSWC,
+		Sessions:            []SessionReport{},
+		Subscriptions:       []string{},
+	}
+	for subscriptionId := range pswc.ongoingSubscriptions {
+		report.Subscriptions = append(report.Subscriptions, subscriptionId)
+	}
+	sort.Strings(report.Subscriptions)
+	pswc.Lock.RLock()
+	defer pswc.Lock.RUnlock()
+	for sessionId, session := range pswc.Sessions {
+		sessionReport := SessionReport{SessionID: sessionId, CuSum: session.atomicReadCuSum(), InUse: true}
+		if session.lock.TryRLock() {
+			sessionReport.InUse = false
+			sessionReport.RelayNum = session.RelayNum
+			sessionReport.Errors = session.errorsCount
+			sessionReport.Badge = session.IsBadgeSession()
+			session.lock.RUnlock()
+		}
+		report.Sessions = append(report.Sessions, sessionReport)
+	}
+	sort.Slice(report.Sessions, func(i, j int) bool {
+		return report.Sessions[i].SessionID < report.Sessions[j].SessionID
+	})
+	return report
+}
+
 // Returning a new provider session manager
 func NewProviderSessionManager(rpcProviderEndpoint *RPCProviderEndpoint, numberOfBlocksKeptInMemory uint64) *ProviderSessionManager {
 	return &ProviderSessionManager{
@@ -925,3 +925,30 @@ func initSessionStore(numSessions int, epoch uint64) []*testSessionData {
 	}
 	return retSessions
 }
+
+func TestPSMReport(t *testing.T) {
+	ctx := context.Background()
+	psm, sps := prepareSession(t, ctx)
+	psm.UpdateEpoch(epoch1)
+
+	// the session is held by the relay
+	report := psm.Report("")
+	require.Equal(t, epoch1, report.CurrentEpoch)
+	require.Len(t, report.Epochs, 1)
+	require.Equal(t, epoch1, report.Epochs[0].Epoch)
+	project := report.Epochs[0].Projects[0]
+	require.Equal(t, projectId, project.ProjectID)
+	require.Equal(t, []string{consumerOneAddress}, project.Consumers)
+	require.Equal(t, relayCu, project.UsedComputeUnits)
+	require.Equal(t, maxCu, project.MaxComputeUnits)
+	require.False(t, project.Blocked)
+	require.Equal(t, []SessionReport{{SessionID: sessionId, CuSum: relayCu, InUse: true}}, project.Sessions)
+
+	err := psm.OnSessionDone(sps, relayNumber)
+	require.NoError(t, err)
+	project = psm.Report(consumerOneAddress).Epochs[0].Projects[0]
+	require.Equal(t, []SessionReport{{SessionID: sessionId, CuSum: relayCu, RelayNum: relayNumber}}, project.Sessions)
+
+	// other consumers are filtered out
+	require.Empty(t, psm.Report("consumer2").Epochs)
+}
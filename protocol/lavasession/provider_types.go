@@ -31,6 +31,7 @@ type RPCProviderEndpoint struct {
 	ApiInterface   string             `yaml:"api-interface,omitempty" json:"api-interface,omitempty" mapstructure:"api-interface"`
 	Geolocation    uint64             `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
 	NodeUrls       []common.NodeUrl   `yaml:"node-urls,omitempty" json:"node-urls,omitempty" mapstructure:"node-urls"`
+	LoadBalancing  string             `yaml:"load-balancing,omitempty" json:"load-balancing,omitempty" mapstructure:"load-balancing"` // how relays are spread between node urls serving the same services, round-robin by default
 }
 
 func (endpoint *RPCProviderEndpoint) UrlsString() string {
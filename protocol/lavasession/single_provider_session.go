@@ -89,6 +89,14 @@ func (sps *SingleProviderSession) SetPairingEpoch(epoch uint64) {
 	atomic.StoreUint64(&sps.PairingEpoch, epoch)
 }
 
+// returns the project of the consumer and the compute units it is allowed to use this epoch
+func (sps *SingleProviderSession) GetConsumerProject() (projectId string, maxComputeUnits uint64) {
+	if sps.userSessionsParent == nil {
+		return "", 0
+	}
+	return sps.userSessionsParent.consumersProjectId, sps.userSessionsParent.atomicReadMaxComputeUnits()
+}
+
 // Verify the SingleProviderSession is locked when getting to this function, if its not locked throw an error
 func (sps *SingleProviderSession) VerifyLock() error {
 	if sps.lock.TryLock() { // verify.
@@ -28,6 +28,7 @@ type ConsumerMetricsManager struct {
 	lock                          sync.Mutex
 	protocolVersionMetric         *prometheus.GaugeVec
 	providerRelays                map[string]uint64
+	optimizerScoreMetric          *prometheus.GaugeVec
 }
 
 func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
@@ -93,6 +94,10 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 		Name: "lava_provider_protocol_version",
 		Help: "The current running lavap version for the process. major := version / 1000000, minor := (version / 1000) % 1000, patch := version % 1000",
 	}, []string{"version"})
+	optimizerScoreMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_consumer_optimizer_provider_score",
+		Help: "The provider optimizer score components per provider, for a reference relay on the latest block",
+	}, []string{"spec", "apiInterface", "provider_address", "score_component"})
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(totalCURequestedMetric)
 	prometheus.MustRegister(totalRelaysRequestedMetric)
@@ -106,6 +111,7 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 	prometheus.MustRegister(virtualEpochMetric)
 	prometheus.MustRegister(endpointsHealthChecksOkMetric)
 	prometheus.MustRegister(protocolVersionMetric)
+	prometheus.MustRegister(optimizerScoreMetric)
 
 	consumerMetricsManager := &ConsumerMetricsManager{
 		totalCURequestedMetric:        totalCURequestedMetric,
@@ -122,6 +128,7 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 		endpointsHealthChecksOkMetric: endpointsHealthChecksOkMetric,
 		endpointsHealthChecksOk:       1,
 		protocolVersionMetric:         protocolVersionMetric,
+		optimizerScoreMetric:          optimizerScoreMetric,
 	}
 
 	http.Handle("/metrics", promhttp.Handler())
@@ -250,6 +257,22 @@ func (pme *ConsumerMetricsManager) ResetQOSMetrics() {
 	pme.providerRelays = map[string]uint64{}
 }
 
+// SetOptimizerScore sets a component of the score the provider optimizer calculated for a provider
+func (pme *ConsumerMetricsManager) SetOptimizerScore(chainId string, apiInterface string, providerAddress string, component string, value float64) {
+	if pme == nil {
+		return
+	}
+	pme.optimizerScoreMetric.WithLabelValues(chainId, apiInterface, providerAddress, component).Set(value)
+}
+
+// ResetOptimizerScores drops the scores of providers that are no longer paired
+func (pme *ConsumerMetricsManager) ResetOptimizerScores() {
+	if pme == nil {
+		return
+	}
+	pme.optimizerScoreMetric.Reset()
+}
+
 func (pme *ConsumerMetricsManager) SetVersion(version string) {
 	if pme == nil {
 		return
@@ -40,6 +40,11 @@ type ProviderMetricsManager struct {
 	endpointsHealthChecksOk       uint64
 	relaysMonitors                map[string]*RelaysMonitor
 	relaysMonitorsLock            sync.RWMutex
+	nodeHealthMetric              *prometheus.GaugeVec
+	nodeRelaysMetric              *prometheus.CounterVec
+	nodeBlockLagMetric            *prometheus.GaugeVec
+	relayPaymentBatchesMetric     *prometheus.CounterVec
+	relayPaymentProofsMetric      *prometheus.CounterVec
 }
 
 func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
@@ -124,6 +129,30 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		Name: "lava_provider_protocol_version",
 		Help: "The current running lavap version for the process. major := version / 1000000, minor := (version / 1000) % 1000 patch := version % 1000",
 	}, []string{"version"})
+	nodeHealthMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_provider_node_health",
+		Help: "value of 1 for each node serving relays, 0 for nodes ejected by the chain router",
+	}, []string{"spec", "apiInterface", "node"})
+
+	nodeRelaysMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_provider_node_relays",
+		Help: "The total number of requests sent to each node, by result",
+	}, []string{"spec", "apiInterface", "node", "result"})
+
+	nodeBlockLagMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_provider_node_block_lag",
+		Help: "The number of blocks each node is behind the most synced node of the chain",
+	}, []string{"spec", "apiInterface", "node"})
+
+	relayPaymentBatchesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_provider_relay_payment_batches",
+		Help: "The total number of relay payment batches, by outcome: sent, failed, split or rejected when a single proof fails simulation",
+	}, []string{"result"})
+
+	relayPaymentProofsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_provider_relay_payment_proofs",
+		Help: "The total number of proofs in relay payment batches, by outcome of their batch",
+	}, []string{"result"})
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(totalCUServicedMetric)
 	prometheus.MustRegister(totalCUPaidMetric)
@@ -140,6 +169,11 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 	prometheus.MustRegister(virtualEpochMetric)
 	prometheus.MustRegister(endpointsHealthChecksOkMetric)
 	prometheus.MustRegister(protocolVersionMetric)
+	prometheus.MustRegister(nodeHealthMetric)
+	prometheus.MustRegister(nodeRelaysMetric)
+	prometheus.MustRegister(nodeBlockLagMetric)
+	prometheus.MustRegister(relayPaymentBatchesMetric)
+	prometheus.MustRegister(relayPaymentProofsMetric)
 
 	providerMetricsManager := &ProviderMetricsManager{
 		providerMetrics:               map[string]*ProviderMetrics{},
@@ -160,6 +194,11 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		endpointsHealthChecksOk:       1,
 		protocolVersionMetric:         protocolVersionMetric,
 		relaysMonitors:                map[string]*RelaysMonitor{},
+		nodeHealthMetric:              nodeHealthMetric,
+		nodeRelaysMetric:              nodeRelaysMetric,
+		nodeBlockLagMetric:            nodeBlockLagMetric,
+		relayPaymentBatchesMetric:     relayPaymentBatchesMetric,
+		relayPaymentProofsMetric:      relayPaymentProofsMetric,
 	}
 
 	http.Handle("/metrics", promhttp.Handler())
@@ -189,12 +228,18 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 }
 
 func (pme *ProviderMetricsManager) getProviderMetric(specID, apiInterface string) *ProviderMetrics {
+	if pme == nil {
+		return nil
+	}
 	pme.lock.RLock()
 	defer pme.lock.RUnlock()
 	return pme.providerMetrics[specID+apiInterface]
 }
 
 func (pme *ProviderMetricsManager) setProviderMetric(metrics *ProviderMetrics) {
+	if pme == nil {
+		return
+	}
 	pme.lock.Lock()
 	defer pme.lock.Unlock()
 	specID := metrics.specID
@@ -349,3 +394,40 @@ func (pme *ProviderMetricsManager) RegisterRelaysMonitor(chainID, apiInterface s
 	defer pme.relaysMonitorsLock.Unlock()
 	pme.relaysMonitors[chainID+apiInterface] = relaysMonitor
 }
+
+func (pme *ProviderMetricsManager) SetNodeHealth(specID, apiInterface, node string, healthy bool) {
+	if pme == nil {
+		return
+	}
+	var value float64 = 0
+	if healthy {
+		value = 1
+	}
+	pme.nodeHealthMetric.WithLabelValues(specID, apiInterface, node).Set(value)
+}
+
+func (pme *ProviderMetricsManager) AddNodeRelay(specID, apiInterface, node string, success bool) {
+	if pme == nil {
+		return
+	}
+	result := "success"
+	if !success {
+		result = "error"
+	}
+	pme.nodeRelaysMetric.WithLabelValues(specID, apiInterface, node, result).Add(1)
+}
+
+func (pme *ProviderMetricsManager) SetNodeBlockLag(specID, apiInterface, node string, blockLag int64) {
+	if pme == nil {
+		return
+	}
+	pme.nodeBlockLagMetric.WithLabelValues(specID, apiInterface, node).Set(float64(blockLag))
+}
+
+func (pme *ProviderMetricsManager) AddRelayPaymentBatch(result string, proofs int) {
+	if pme == nil {
+		return
+	}
+	pme.relayPaymentBatchesMetric.WithLabelValues(result).Add(1)
+	pme.relayPaymentProofsMetric.WithLabelValues(result).Add(float64(proofs))
+}
@@ -306,3 +306,26 @@ func checkThatMetricDtoInAggregatedMetricMap(mapData map[string]map[string]map[s
 	}
 	return nil
 }
+
+func TestNilProviderMetricsManager(t *testing.T) {
+	// providers and tools without a metrics listen address run with a nil manager
+	var pme *ProviderMetricsManager
+	pme.AddProviderMetrics("spec", "api").AddRelay("consumer", 10, nil)
+	pme.SetLatestBlock("spec", 100)
+	pme.AddPayment("spec", 10)
+	pme.UpdateHealthCheckStatus(true)
+	pme.SetBlock(100)
+	pme.SetDisabledChain("spec", "api")
+	pme.SetEnabledChain("spec", "api")
+	pme.SetLatestBlockFetchError("spec")
+	pme.SetSpecificBlockFetchError("spec")
+	pme.SetLatestBlockFetchSuccess("spec")
+	pme.SetSpecificBlockFetchSuccess("spec")
+	pme.SetVirtualEpoch(1)
+	pme.SetVersion("version")
+	pme.RegisterRelaysMonitor("spec", "api", nil)
+	pme.SetNodeHealth("spec", "api", "node", false)
+	pme.AddNodeRelay("spec", "api", "node", false)
+	pme.SetNodeBlockLag("spec", "api", "node", 5)
+	pme.AddRelayPaymentBatch("success", 3)
+}
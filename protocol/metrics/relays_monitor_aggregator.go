@@ -32,6 +32,12 @@ func (rma *RelaysMonitorAggregator) RegisterRelaysMonitor(rpcEndpointKey string,
 	rma.relaysMonitors[rpcEndpointKey] = relaysMonitor
 }
 
+func (rma *RelaysMonitorAggregator) UnregisterRelaysMonitor(rpcEndpointKey string) {
+	rma.lock.Lock()
+	defer rma.lock.Unlock()
+	delete(rma.relaysMonitors, rpcEndpointKey)
+}
+
 func (rma *RelaysMonitorAggregator) StartMonitoring(ctx context.Context) {
 	go func() {
 		for {
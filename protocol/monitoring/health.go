@@ -387,7 +387,7 @@ func CheckConsumersAndReferences(ctx context.Context,
 		var chainRouter chainlib.ChainRouter
 		for i := uint64(0); i <= QueryRetries; i++ {
 			sendCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
-			chainRouter, err = chainlib.GetChainRouter(sendCtx, 1, compatibleEndpoint, chainParser)
+			chainRouter, err = chainlib.GetChainRouter(sendCtx, 1, compatibleEndpoint, chainParser, nil)
 			cancel()
 			if err == nil {
 				break
@@ -65,3 +65,14 @@ func (cache *Cache) SetEntry(ctx context.Context, cacheSet *pairingtypes.RelayCa
 	_, err := cache.client.SetRelay(ctx, cacheSet)
 	return err
 }
+
+func (cache *Cache) NotifyBlockHashes(ctx context.Context, update *pairingtypes.BlockHashesUpdate) error {
+	if cache == nil {
+		return NotInitialisedError
+	}
+	if cache.client == nil {
+		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
+	_, err := cache.client.NotifyBlockHashes(ctx, update)
+	return err
+}
@@ -0,0 +1,79 @@
+package provideroptimizer
+
+import (
+	"sort"
+	"time"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	MAX_SELECTION_DECISIONS = 100 // decisions kept for introspection
+	INTROSPECTION_CU        = 10  // the cu of the reference relay the current scores of the providers are calculated for
+)
+
+// SelectionDecision holds the inputs and the result of a provider selection
+type SelectionDecision struct {
+	Time       time.Time           `json:"time"`
+	Strategy   string              `json:"strategy"`
+	Request    SelectionRequest    `json:"request"`
+	Candidates []ProviderCandidate `json:"candidates"`
+	Chosen     []string            `json:"chosen"`   // the first provider is the preferred one
+	Explored   []string            `json:"explored"` // providers relayed to in parallel to the preferred one
+}
+
+// OptimizerReport explains the choices of the optimizer
+type OptimizerReport struct {
+	Strategy  string              `json:"strategy"`
+	Providers []ProviderCandidate `json:"providers"` // scores of the paired providers for a reference relay on the latest block
+	Decisions []SelectionDecision `json:"decisions"` // latest first
+}
+
+func (po *ProviderOptimizer) recordDecision(request SelectionRequest, candidates []ProviderCandidate, chosen []string) {
+	decision := SelectionDecision{
+		Time:       time.Now(),
+		Strategy:   po.strategy.String(),
+		Request:    request,
+		Candidates: candidates,
+		Chosen:     chosen,
+		Explored:   []string{},
+	}
+	if len(chosen) > 1 {
+		decision.Explored = chosen[1:]
+	}
+	po.decisionsLock.Lock()
+	defer po.decisionsLock.Unlock()
+	if len(po.decisions) >= MAX_SELECTION_DECISIONS {
+		po.decisions = po.decisions[len(po.decisions)-MAX_SELECTION_DECISIONS+1:]
+	}
+	po.decisions = append(po.decisions, decision)
+}
+
+// Report returns the current scores of the paired providers and the latest selections of an api interface, an empty api interface returns all of them.
+// limit caps the number of decisions, 0 returns all that are kept
+func (po *ProviderOptimizer) Report(apiInterface string, limit int) OptimizerReport {
+	po.providerStakesLock.RLock()
+	addresses := make([]string, 0, len(po.providerStakes))
+	for providerAddress := range po.providerStakes {
+		addresses = append(addresses, providerAddress)
+	}
+	po.providerStakesLock.RUnlock()
+	sort.Strings(addresses)
+	report := OptimizerReport{
+		Strategy:  po.strategy.String(),
+		Providers: po.getCandidates(addresses, nil, INTROSPECTION_CU, spectypes.LATEST_BLOCK),
+		Decisions: []SelectionDecision{},
+	}
+	po.decisionsLock.RLock()
+	defer po.decisionsLock.RUnlock()
+	for idx := len(po.decisions) - 1; idx >= 0; idx-- {
+		if limit > 0 && len(report.Decisions) >= limit {
+			break
+		}
+		if apiInterface != "" && po.decisions[idx].Request.ApiInterface != apiInterface {
+			continue
+		}
+		report.Decisions = append(report.Decisions, po.decisions[idx])
+	}
+	return report
+}
@@ -50,6 +50,13 @@ type ProviderOptimizer struct {
 	baseWorldLatency                time.Duration
 	wantedNumProvidersInConcurrency uint
 	latestSyncData                  ConcurrentBlockStore
+	policies                        map[Strategy]StrategyPolicy
+	policiesLock                    sync.Mutex
+	providerStakes                  map[string]float64 // the paired providers
+	providerStakesLock              sync.RWMutex
+	restoredProviders               map[string]ProviderData // snapshot data waiting for the pairing, see Restore
+	decisions                       []SelectionDecision     // the latest selections, oldest first
+	decisionsLock                   sync.RWMutex
 }
 
 type ProviderData struct {
@@ -123,14 +130,29 @@ func (po *ProviderOptimizer) AppendProbeRelayData(providerAddress string, latenc
 
 // returns a sub set of selected providers according to their scores, perturbation factor will be added to each score in order to randomly select providers that are not always on top
 func (po *ProviderOptimizer) ChooseProvider(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string) {
-	returnedProviders := make([]string, 1) // location 0 is always the best score
-	latencyScore := math.MaxFloat64        // smaller = better i.e less latency
-	syncScore := math.MaxFloat64           // smaller = better i.e less sync lag
-	numProviders := len(allAddresses)
-	if po.strategy == STRATEGY_DISTRIBUTED {
-		// distribute relays across more providers
-		perturbationPercentage *= 2
+	return po.ChooseProviderForDapp("", allAddresses, ignoredProviders, cu, requestedBlock, perturbationPercentage)
+}
+
+// same as ChooseProvider, the dapp id lets strategies keep a per dapp selection
+func (po *ProviderOptimizer) ChooseProviderForDapp(dappID string, allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string) {
+	return po.ChooseProviderForRequest(SelectionRequest{DappID: dappID, Cu: cu, RequestedBlock: requestedBlock, Perturbation: perturbationPercentage}, allAddresses, ignoredProviders)
+}
+
+// ChooseProviderForRequest returns the providers to relay to according to the strategy, the wanted providers of the request are set by the optimizer
+func (po *ProviderOptimizer) ChooseProviderForRequest(request SelectionRequest, allAddresses []string, ignoredProviders map[string]struct{}) (addresses []string) {
+	candidates := po.getCandidates(allAddresses, ignoredProviders, request.Cu, request.RequestedBlock)
+	request.WantedProviders = int(po.wantedNumProvidersInConcurrency)
+	returnedProviders := po.getPolicy().Choose(request, candidates)
+	po.recordDecision(request, candidates, returnedProviders)
+	if debug {
+		utils.LavaFormatDebug("returned providers", utils.Attribute{Key: "providers", Value: strings.Join(returnedProviders, ",")}, utils.Attribute{Key: "cu", Value: request.Cu})
 	}
+	return returnedProviders
+}
+
+// returns what the optimizer knows about each provider that isn't ignored, for a relay of the given cu and block
+func (po *ProviderOptimizer) getCandidates(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64) []ProviderCandidate {
+	candidates := make([]ProviderCandidate, 0, len(allAddresses))
 	for _, providerAddress := range allAddresses {
 		if _, ok := ignoredProviders[providerAddress]; ok {
 			// ignored provider, skip it
@@ -140,42 +162,85 @@ func (po *ProviderOptimizer) ChooseProvider(allAddresses []string, ignoredProvid
 		if debug && !found {
 			utils.LavaFormatDebug("provider data was not found for address", utils.Attribute{Key: "providerAddress", Value: providerAddress})
 		}
-		// latency score
-		latencyScoreCurrent := po.calculateLatencyScore(providerData, cu, requestedBlock) // smaller == better i.e less latency
-		// latency perturbation
-		latencyScoreCurrent = pertrubWithNormalGaussian(latencyScoreCurrent, perturbationPercentage)
-
-		// sync score
-		syncScoreCurrent := float64(0)
+		candidate := ProviderCandidate{
+			Address:      providerAddress,
+			LatencyScore: po.calculateLatencyScore(providerData, cu, requestedBlock), // smaller == better i.e less latency
+			Availability: 1 - po.CalculateProbabilityOfTimeout(providerData.Availability),
+		}
 		if requestedBlock < 0 {
 			// means user didn't ask for a specific block and we want to give him the best
-			syncScoreCurrent = po.calculateSyncScore(providerData.Sync) // smaller == better i.e less sync lag
-			// sync perturbation
-			syncScoreCurrent = pertrubWithNormalGaussian(syncScoreCurrent, perturbationPercentage)
+			candidate.SyncScore = po.calculateSyncScore(providerData.Sync) // smaller == better i.e less sync lag
 		}
+		candidates = append(candidates, candidate)
+	}
+	po.setCandidatesRatios(candidates)
+	return candidates
+}
 
-		if debug {
-			utils.LavaFormatDebug("scores information", utils.Attribute{Key: "providerAddress", Value: providerAddress}, utils.Attribute{Key: "latencyScoreCurrent", Value: latencyScoreCurrent}, utils.Attribute{Key: "syncScoreCurrent", Value: syncScoreCurrent}, utils.Attribute{Key: "latencyScore", Value: latencyScore}, utils.Attribute{Key: "syncScore", Value: syncScore})
+// sets the stake and usage of each candidate relative to the average of all candidates
+func (po *ProviderOptimizer) setCandidatesRatios(candidates []ProviderCandidate) {
+	if len(candidates) == 0 {
+		return
+	}
+	stakes := make([]float64, len(candidates))
+	usages := make([]float64, len(candidates))
+	totalStake, totalUsage := float64(0), float64(0)
+	po.providerStakesLock.RLock()
+	for idx, candidate := range candidates {
+		stakes[idx] = po.providerStakes[candidate.Address]
+		totalStake += stakes[idx]
+		usages[idx] = float64(len(po.getRelayStatsTimes(candidate.Address)))
+		totalUsage += usages[idx]
+	}
+	po.providerStakesLock.RUnlock()
+	averageStake := totalStake / float64(len(candidates))
+	averageUsage := totalUsage / float64(len(candidates))
+	for idx := range candidates {
+		candidates[idx].StakeRatio, candidates[idx].UsageRatio = 1, 1
+		if averageStake > 0 {
+			candidates[idx].StakeRatio = stakes[idx] / averageStake
 		}
-		// we want the minimum latency and sync diff
-		if po.isBetterProviderScore(latencyScore, latencyScoreCurrent, syncScore, syncScoreCurrent) || len(returnedProviders) == 0 {
-			if returnedProviders[0] != "" && po.shouldExplore(len(returnedProviders), numProviders) {
-				// we are about to overwrite position 0, and this provider needs a chance to be in exploration
-				returnedProviders = append(returnedProviders, returnedProviders[0])
-			}
-			returnedProviders[0] = providerAddress // best provider is always on position 0
-			latencyScore = latencyScoreCurrent
-			syncScore = syncScoreCurrent
-			continue
+		if averageUsage > 0 {
+			candidates[idx].UsageRatio = usages[idx] / averageUsage
 		}
-		if po.shouldExplore(len(returnedProviders), numProviders) {
-			returnedProviders = append(returnedProviders, providerAddress)
+	}
+}
+
+// UpdateProviderStakes is called with the stakes of the providers on every pairing update, the stake is used by strategies that take it into account
+func (po *ProviderOptimizer) UpdateProviderStakes(stakes map[string]sdk.Coin) {
+	providerStakes := make(map[string]float64, len(stakes))
+	for providerAddress, stake := range stakes {
+		if stake.Amount.IsNil() {
+			continue // unknown stake
+		}
+		stakeFloat, err := sdk.NewDecFromInt(stake.Amount).Float64()
+		if err != nil {
+			utils.LavaFormatWarning("failed converting provider stake", err, utils.Attribute{Key: "provider", Value: providerAddress}, utils.Attribute{Key: "stake", Value: stake})
+			continue
 		}
+		providerStakes[providerAddress] = stakeFloat
 	}
-	if debug {
-		utils.LavaFormatDebug("returned providers", utils.Attribute{Key: "providers", Value: strings.Join(returnedProviders, ",")}, utils.Attribute{Key: "cu", Value: cu})
+	po.providerStakesLock.Lock()
+	defer po.providerStakesLock.Unlock()
+	po.providerStakes = providerStakes
+	po.applyRestoredProviders()
+}
+
+// returns the policy of the current strategy, policies are created once per strategy as some keep state
+func (po *ProviderOptimizer) getPolicy() StrategyPolicy {
+	po.policiesLock.Lock()
+	defer po.policiesLock.Unlock()
+	policy, ok := po.policies[po.strategy]
+	if !ok {
+		policy = po.strategy.newPolicy()
+		po.policies[po.strategy] = policy
 	}
-	return returnedProviders
+	return policy
+}
+
+// CrossCheck returns true if the strategy wants every response verified against another provider
+func (po *ProviderOptimizer) CrossCheck() bool {
+	return po.getPolicy().CrossCheck()
 }
 
 // calculate the expected average time until this provider catches up with the given latestSync block
@@ -207,49 +272,6 @@ func (po *ProviderOptimizer) updateLatestSyncData(providerLatestBlock uint64, sa
 	return po.latestSyncData.Block, po.latestSyncData.Time
 }
 
-func (po *ProviderOptimizer) shouldExplore(currentNumProvders, numProviders int) bool {
-	if uint(currentNumProvders) >= po.wantedNumProvidersInConcurrency {
-		return false
-	}
-	explorationChance := DEFAULT_EXPLORATION_CHANCE
-	switch po.strategy {
-	case STRATEGY_LATENCY:
-		return true // we want a lot of parallel tries on latency
-	case STRATEGY_ACCURACY:
-		return true
-	case STRATEGY_COST:
-		explorationChance = COST_EXPLORATION_CHANCE
-	case STRATEGY_DISTRIBUTED:
-		explorationChance = DEFAULT_EXPLORATION_CHANCE * 0.25
-	case STRATEGY_PRIVACY:
-		return false // only one at a time
-	}
-	// Dividing the random threshold by the loop count ensures that the overall probability of success is the requirement for the entire loop not per iteration
-	return rand.Float64() < explorationChance/float64(numProviders)
-}
-
-func (po *ProviderOptimizer) isBetterProviderScore(latencyScore, latencyScoreCurrent, syncScore, syncScoreCurrent float64) bool {
-	var latencyWeight float64
-	switch po.strategy {
-	case STRATEGY_LATENCY:
-		latencyWeight = 0.7
-	case STRATEGY_SYNC_FRESHNESS:
-		latencyWeight = 0.2
-	case STRATEGY_PRIVACY:
-		// pick at random regardless of score
-		if rand.Intn(2) == 0 {
-			return true
-		}
-		return false
-	default:
-		latencyWeight = 0.6
-	}
-	if syncScoreCurrent == 0 {
-		return latencyScore > latencyScoreCurrent
-	}
-	return latencyScore*latencyWeight+syncScore*(1-latencyWeight) > latencyScoreCurrent*latencyWeight+syncScoreCurrent*(1-latencyWeight)
-}
-
 func (po *ProviderOptimizer) calculateSyncScore(syncScore score.ScoreStore) float64 {
 	var historicalSyncLatency time.Duration
 	if syncScore.Denom == 0 {
@@ -440,7 +462,7 @@ func NewProviderOptimizer(strategy Strategy, averageBlockTIme, baseWorldLatency
 		// overwrite
 		wantedNumProvidersInConcurrency = 1
 	}
-	return &ProviderOptimizer{strategy: strategy, providersStorage: cache, averageBlockTime: averageBlockTIme, baseWorldLatency: baseWorldLatency, providerRelayStats: relayCache, wantedNumProvidersInConcurrency: wantedNumProvidersInConcurrency}
+	return &ProviderOptimizer{strategy: strategy, providersStorage: cache, averageBlockTime: averageBlockTIme, baseWorldLatency: baseWorldLatency, providerRelayStats: relayCache, wantedNumProvidersInConcurrency: wantedNumProvidersInConcurrency, policies: map[Strategy]StrategyPolicy{}, providerStakes: map[string]float64{}}
 }
 
 // calculate the probability a random variable with a poisson distribution
@@ -2,11 +2,14 @@ package provideroptimizer
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -572,3 +575,260 @@ func TestPerturbationWithNormalGaussianOnConcurrentComputation(t *testing.T) {
 	wg.Wait()
 	fmt.Println("Test completed successfully")
 }
+
+func TestProviderOptimizerPrivacyPinsProvidersPerDapp(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = STRATEGY_PRIVACY
+	providersGen := (&providersGenerator{}).setupProvidersForTest(10)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+
+	chosen := map[string]string{}
+	for _, dappID := range []string{"dapp1", "dapp2", "dapp3"} {
+		returnedProviders := providerOptimizer.ChooseProviderForDapp(dappID, providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+		require.Len(t, returnedProviders, 1)
+		chosen[dappID] = returnedProviders[0]
+	}
+	// every following relay of a dapp goes to its pinned provider
+	for i := 0; i < 20; i++ {
+		for dappID, pinned := range chosen {
+			returnedProviders := providerOptimizer.ChooseProviderForDapp(dappID, providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+			require.Equal(t, []string{pinned}, returnedProviders)
+		}
+	}
+	// when the pinned provider can't be used, a second one is pinned and the set stays stable
+	ignored := map[string]struct{}{chosen["dapp1"]: {}}
+	second := providerOptimizer.ChooseProviderForDapp("dapp1", providersGen.providersAddresses, ignored, requestCU, requestBlock, 0)
+	require.Len(t, second, 1)
+	require.NotEqual(t, chosen["dapp1"], second[0])
+	for i := 0; i < 20; i++ {
+		require.Equal(t, second, providerOptimizer.ChooseProviderForDapp("dapp1", providersGen.providersAddresses, ignored, requestCU, requestBlock, 0))
+		require.Equal(t, []string{chosen["dapp1"]}, providerOptimizer.ChooseProviderForDapp("dapp1", providersGen.providersAddresses, nil, requestCU, requestBlock, 0))
+	}
+}
+
+func TestPrivacyPolicyKeepsPins(t *testing.T) {
+	rand.InitRandomSeed()
+	providersGen := (&providersGenerator{}).setupProvidersForTest(10)
+	candidates := make([]ProviderCandidate, len(providersGen.providersAddresses))
+	for idx, address := range providersGen.providersAddresses {
+		candidates[idx] = ProviderCandidate{Address: address}
+	}
+	policy := NewPrivacyPolicy()
+	// more dapps than the optimizer caches hold, none of the pins may be dropped
+	dappsCount := CacheMaxCost * 2
+	chosen := make([]string, dappsCount)
+	for i := 0; i < dappsCount; i++ {
+		chosen[i] = policy.Choose(SelectionRequest{DappID: strconv.Itoa(i)}, candidates)[0]
+	}
+	for i := 0; i < dappsCount; i++ {
+		require.Equal(t, []string{chosen[i]}, policy.Choose(SelectionRequest{DappID: strconv.Itoa(i)}, candidates))
+	}
+
+	// when full, only the least recently used dapp is forgotten
+	policy = NewPrivacyPolicy()
+	policy.maxDapps = 2
+	first := policy.Choose(SelectionRequest{DappID: "dapp1"}, candidates)
+	policy.Choose(SelectionRequest{DappID: "dapp2"}, candidates)
+	require.Equal(t, first, policy.Choose(SelectionRequest{DappID: "dapp1"}, candidates))
+	policy.Choose(SelectionRequest{DappID: "dapp3"}, candidates)
+	require.Len(t, policy.pinnedProviders, 2)
+	require.Contains(t, policy.pinnedProviders, "dapp1")
+	require.NotContains(t, policy.pinnedProviders, "dapp2")
+}
+
+func TestProviderOptimizerCostPrefersLowStake(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = STRATEGY_COST
+	providersGen := (&providersGenerator{}).setupProvidersForTest(5)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+	for i := 0; i < 10; i++ {
+		for _, address := range providersGen.providersAddresses {
+			providerOptimizer.AppendRelayData(address, TEST_BASE_WORLD_LATENCY*2, false, requestCU, uint64(requestBlock))
+		}
+		time.Sleep(4 * time.Millisecond)
+	}
+	time.Sleep(4 * time.Millisecond)
+	providerOptimizer.strategy = STRATEGY_BALANCED
+	balancedChoice := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+	providerOptimizer.strategy = STRATEGY_COST
+	stakes := map[string]sdk.Coin{}
+	for idx, address := range providersGen.providersAddresses {
+		stakes[address] = sdk.NewCoin("ulava", sdk.NewInt(1000))
+		if idx == 3 {
+			stakes[address] = sdk.NewCoin("ulava", sdk.NewInt(10))
+		}
+	}
+	providerOptimizer.UpdateProviderStakes(stakes)
+	returnedProviders := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+	require.Equal(t, providersGen.providersAddresses[3], returnedProviders[0])
+
+	// the balanced strategy ignores the stake
+	providerOptimizer.strategy = STRATEGY_BALANCED
+	returnedProviders = providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+	require.Equal(t, balancedChoice, returnedProviders)
+}
+
+func TestProviderOptimizerDistributedSpreadsLoad(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = STRATEGY_DISTRIBUTED
+	providersGen := (&providersGenerator{}).setupProvidersForTest(4)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+	counts := map[string]int{}
+	iterations := 200
+	for i := 0; i < iterations; i++ {
+		returnedProviders := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+		counts[returnedProviders[0]]++
+		providerOptimizer.AppendRelayData(returnedProviders[0], TEST_BASE_WORLD_LATENCY*2, false, requestCU, uint64(requestBlock))
+		time.Sleep(time.Millisecond) // relay stats are set asynchronously
+	}
+	for _, address := range providersGen.providersAddresses {
+		// allow some slack since the scores also move with the relay data
+		require.InDelta(t, iterations/len(providersGen.providersAddresses), counts[address], float64(iterations)/10, address)
+	}
+}
+
+func TestProviderOptimizerAccuracyFansOut(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = STRATEGY_ACCURACY
+	providersGen := (&providersGenerator{}).setupProvidersForTest(5)
+	// even with a single wanted provider accuracy relays to at least two so responses can be compared
+	returnedProviders := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, 10, 1000, 0)
+	require.Len(t, returnedProviders, ACCURACY_MIN_PROVIDERS)
+	require.True(t, providerOptimizer.CrossCheck())
+	providerOptimizer.strategy = STRATEGY_BALANCED
+	require.False(t, providerOptimizer.CrossCheck())
+}
+
+func TestCustomStrategyFromConfig(t *testing.T) {
+	rand.InitRandomSeed()
+	latencyWeight := 1.0
+	maxProviders := 2
+	crossCheck := true
+	strategy, err := RegisterStrategyFromConfig(StrategyConfig{Name: "test-custom", Base: "latency", LatencyWeight: &latencyWeight, MaxProviders: &maxProviders, CrossCheck: &crossCheck})
+	require.NoError(t, err)
+	require.Equal(t, "test-custom", strategy.String())
+	parsed, err := ParseStrategy("TEST-CUSTOM")
+	require.NoError(t, err)
+	require.Equal(t, strategy, parsed)
+	require.Contains(t, StrategyNames(), "test-custom")
+
+	// names are unique, bases must be scoring strategies and weights are validated
+	_, err = RegisterStrategyFromConfig(StrategyConfig{Name: "test-custom"})
+	require.Error(t, err)
+	_, err = RegisterStrategyFromConfig(StrategyConfig{Name: "test-custom-privacy", Base: "privacy"})
+	require.Error(t, err)
+	invalidWeight := 2.0
+	_, err = RegisterStrategyFromConfig(StrategyConfig{Name: "test-custom-weight", LatencyWeight: &invalidWeight})
+	require.Error(t, err)
+
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = strategy
+	providerOptimizer.wantedNumProvidersInConcurrency = 4
+	providersGen := (&providersGenerator{}).setupProvidersForTest(5)
+	// latency always explores, capped by max providers
+	returnedProviders := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, 10, 1000, 0)
+	require.Len(t, returnedProviders, maxProviders)
+	require.True(t, providerOptimizer.CrossCheck())
+}
+
+func TestProviderOptimizerSnapshotRestore(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(3)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+	stakes := map[string]sdk.Coin{}
+	for _, address := range providersGen.providersAddresses {
+		stakes[address] = sdk.NewCoin("ulava", sdk.NewInt(1000))
+	}
+	providerOptimizer.UpdateProviderStakes(stakes)
+	for i := 0; i < 10; i++ {
+		for _, address := range providersGen.providersAddresses {
+			providerOptimizer.AppendRelayData(address, TEST_BASE_WORLD_LATENCY*2, false, requestCU, uint64(requestBlock))
+		}
+		time.Sleep(4 * time.Millisecond)
+	}
+	time.Sleep(4 * time.Millisecond)
+	path := filepath.Join(t.TempDir(), "optimizer.json")
+	snapshotStore := NewSnapshotStore(path)
+	snapshotStore.Register("LAV1-rest", providerOptimizer)
+	require.NoError(t, snapshotStore.Save())
+
+	// a restarted consumer restores the providers still in the pairing
+	restoredOptimizer := setupProviderOptimizer(1)
+	NewSnapshotStore(path).Register("LAV1-rest", restoredOptimizer)
+	delete(stakes, providersGen.providersAddresses[2])
+	restoredOptimizer.UpdateProviderStakes(stakes)
+	time.Sleep(4 * time.Millisecond)
+	for _, address := range providersGen.providersAddresses[:2] {
+		original, found := providerOptimizer.getProviderData(address)
+		require.True(t, found)
+		restored, found := restoredOptimizer.getProviderData(address)
+		require.True(t, found, address)
+		require.InDelta(t, original.Latency.Num/original.Latency.Denom, restored.Latency.Num/restored.Latency.Denom, 0.0001)
+		// the restored samples are decayed, so they weigh less than the original ones
+		require.LessOrEqual(t, restored.Latency.Denom, original.Latency.Denom)
+	}
+	_, found := restoredOptimizer.getProviderData(providersGen.providersAddresses[2])
+	require.False(t, found)
+
+	// unknown keys and invalid files are ignored
+	otherOptimizer := setupProviderOptimizer(1)
+	NewSnapshotStore(path).Register("LAV1-grpc", otherOptimizer)
+	otherOptimizer.UpdateProviderStakes(stakes)
+	time.Sleep(4 * time.Millisecond)
+	_, found = otherOptimizer.getProviderData(providersGen.providersAddresses[0])
+	require.False(t, found)
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	require.Empty(t, NewSnapshotStore(path).loaded.Optimizers)
+}
+
+func TestProviderOptimizerReport(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(3)
+	stakes := map[string]sdk.Coin{}
+	for _, address := range providersGen.providersAddresses {
+		stakes[address] = sdk.NewCoin("ulava", sdk.NewInt(1000))
+	}
+	providerOptimizer.UpdateProviderStakes(stakes)
+	for i := 0; i < MAX_SELECTION_DECISIONS+10; i++ {
+		apiInterface := spectypes.APIInterfaceRest
+		if i%2 == 0 {
+			apiInterface = spectypes.APIInterfaceGrpc
+		}
+		returnedProviders := providerOptimizer.ChooseProviderForRequest(SelectionRequest{ApiInterface: apiInterface, Cu: 10, RequestedBlock: int64(i)}, providersGen.providersAddresses, nil)
+		require.NotEmpty(t, returnedProviders)
+	}
+
+	report := providerOptimizer.Report("", 0)
+	require.Equal(t, STRATEGY_BALANCED.String(), report.Strategy)
+	require.Len(t, report.Providers, len(providersGen.providersAddresses))
+	for _, provider := range report.Providers {
+		require.Positive(t, provider.LatencyScore)
+		require.Equal(t, 1.0, provider.StakeRatio)
+	}
+	// only the latest decisions are kept, latest first
+	require.Len(t, report.Decisions, MAX_SELECTION_DECISIONS)
+	require.Equal(t, int64(MAX_SELECTION_DECISIONS+9), report.Decisions[0].Request.RequestedBlock)
+	for _, decision := range report.Decisions {
+		require.Len(t, decision.Candidates, len(providersGen.providersAddresses))
+		require.Equal(t, decision.Chosen[1:], decision.Explored)
+		for _, candidate := range decision.Candidates {
+			require.Positive(t, candidate.Score)
+		}
+	}
+
+	report = providerOptimizer.Report(spectypes.APIInterfaceRest, 5)
+	require.Len(t, report.Decisions, 5)
+	for _, decision := range report.Decisions {
+		require.Equal(t, spectypes.APIInterfaceRest, decision.Request.ApiInterface)
+	}
+}
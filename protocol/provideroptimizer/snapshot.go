@@ -0,0 +1,174 @@
+package provideroptimizer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/score"
+)
+
+const (
+	DEFAULT_SNAPSHOT_INTERVAL = time.Minute
+	MAX_SNAPSHOT_AGE          = INITIAL_DATA_STALENESS * time.Hour // older snapshots carry no information compared to the defaults
+)
+
+// OptimizersSnapshot is the file format of the snapshot, optimizers are keyed by chain and api interface
+type OptimizersSnapshot struct {
+	Time       time.Time                          `json:"time"`
+	Optimizers map[string]map[string]ProviderData `json:"optimizers"` // key -> provider address -> data
+}
+
+// Snapshot returns the data of the providers in the current pairing
+func (po *ProviderOptimizer) Snapshot() map[string]ProviderData {
+	po.providerStakesLock.RLock()
+	addresses := make([]string, 0, len(po.providerStakes))
+	for providerAddress := range po.providerStakes {
+		addresses = append(addresses, providerAddress)
+	}
+	po.providerStakesLock.RUnlock()
+	providers := make(map[string]ProviderData, len(addresses))
+	for _, providerAddress := range addresses {
+		if providerData, found := po.getProviderData(providerAddress); found {
+			providers[providerAddress] = providerData
+		}
+	}
+	return providers
+}
+
+// Restore keeps the data of a snapshot, decayed for the time passed since each score was updated.
+// the data is applied on the next pairing update, for the providers that are still paired
+func (po *ProviderOptimizer) Restore(providers map[string]ProviderData) {
+	now := time.Now()
+	restored := make(map[string]ProviderData, len(providers))
+	for providerAddress, providerData := range providers {
+		providerData.Availability = decayScore(providerData.Availability, now)
+		providerData.Latency = decayScore(providerData.Latency, now)
+		providerData.Sync = decayScore(providerData.Sync, now)
+		restored[providerAddress] = providerData
+	}
+	po.providerStakesLock.Lock()
+	defer po.providerStakesLock.Unlock()
+	po.restoredProviders = restored
+}
+
+// applies the restored data of the paired providers, must be called with providerStakesLock locked
+func (po *ProviderOptimizer) applyRestoredProviders() {
+	if po.restoredProviders == nil {
+		return
+	}
+	for providerAddress, providerData := range po.restoredProviders {
+		if _, paired := po.providerStakes[providerAddress]; !paired {
+			continue // providers no longer in the pairing are dropped
+		}
+		if _, found := po.getProviderData(providerAddress); found {
+			continue // fresh data arrived before the pairing
+		}
+		po.providersStorage.Set(providerAddress, providerData, 1)
+	}
+	po.restoredProviders = nil
+}
+
+// decays a score as if an empty sample was added now, keeping its value but lowering its weight against new samples
+func decayScore(scoreStore score.ScoreStore, now time.Time) score.ScoreStore {
+	if scoreStore.Time.After(now) {
+		return scoreStore
+	}
+	return score.CalculateTimeDecayFunctionUpdate(scoreStore, score.NewScoreStore(0, 0, now), HALF_LIFE_TIME, 0, now)
+}
+
+// SnapshotStore periodically writes the scores of the registered optimizers to a file, and restores them on registration
+type SnapshotStore struct {
+	path       string
+	lock       sync.Mutex
+	optimizers map[string]*ProviderOptimizer
+	loaded     OptimizersSnapshot
+}
+
+func NewSnapshotStore(path string) *SnapshotStore {
+	ss := &SnapshotStore{path: path, optimizers: map[string]*ProviderOptimizer{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			utils.LavaFormatWarning("failed reading optimizer snapshot, starting without it", err, utils.Attribute{Key: "path", Value: path})
+		}
+		return ss
+	}
+	err = json.Unmarshal(data, &ss.loaded)
+	if err != nil {
+		utils.LavaFormatWarning("failed parsing optimizer snapshot, starting without it", err, utils.Attribute{Key: "path", Value: path})
+		ss.loaded = OptimizersSnapshot{}
+		return ss
+	}
+	if time.Since(ss.loaded.Time) > MAX_SNAPSHOT_AGE {
+		utils.LavaFormatInfo("optimizer snapshot is too old, starting without it", utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "time", Value: ss.loaded.Time})
+		ss.loaded = OptimizersSnapshot{}
+	}
+	return ss
+}
+
+// Register adds an optimizer to the snapshots, restoring the data stored for its key
+func (ss *SnapshotStore) Register(key string, po *ProviderOptimizer) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+	ss.optimizers[key] = po
+	if providers, ok := ss.loaded.Optimizers[key]; ok {
+		po.Restore(providers)
+		utils.LavaFormatInfo("restored optimizer scores from snapshot", utils.Attribute{Key: "key", Value: key}, utils.Attribute{Key: "providers", Value: len(providers)}, utils.Attribute{Key: "snapshotTime", Value: ss.loaded.Time})
+	}
+}
+
+// Save writes the scores of all registered optimizers, the previous file is replaced atomically
+func (ss *SnapshotStore) Save() error {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+	snapshot := OptimizersSnapshot{Time: time.Now(), Optimizers: make(map[string]map[string]ProviderData, len(ss.optimizers))}
+	for key, po := range ss.optimizers {
+		providers := po.Snapshot()
+		if len(providers) == 0 {
+			// not paired yet, keep the previous data of this optimizer
+			providers = ss.loaded.Optimizers[key]
+		}
+		if len(providers) > 0 {
+			snapshot.Optimizers[key] = providers
+		}
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	tmpPath := ss.path + ".tmp"
+	err = os.MkdirAll(filepath.Dir(ss.path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(tmpPath, data, 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, ss.path)
+}
+
+// Start saves a snapshot every interval, and a last one when the context is done
+func (ss *SnapshotStore) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := ss.Save(); err != nil {
+				utils.LavaFormatWarning("failed saving optimizer snapshot", err, utils.Attribute{Key: "path", Value: ss.path})
+			}
+			return
+		case <-ticker.C:
+			if err := ss.Save(); err != nil {
+				utils.LavaFormatWarning("failed saving optimizer snapshot", err, utils.Attribute{Key: "path", Value: ss.path})
+			}
+		}
+	}
+}
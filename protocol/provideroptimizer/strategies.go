@@ -0,0 +1,367 @@
+package provideroptimizer
+
+import (
+	"container/list"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
+)
+
+const (
+	PRIVACY_PINNED_PROVIDERS      = 2     // the amount of providers a dapp is spread on when using the privacy strategy
+	PRIVACY_MAX_PINNED_DAPPS      = 10000 // the amount of dapps whose pins are kept, the least recently used dapp is forgotten first
+	ACCURACY_MIN_PROVIDERS        = 2     // accuracy always fans out so responses can be compared
+	DISTRIBUTED_USAGE_WEIGHT      = 2     // how much relays already sent to a provider penalize its score
+	COST_STAKE_WEIGHT             = 0.5   // how much a provider's stake penalizes its score
+	COST_USAGE_WEIGHT             = 0.5   // how much relays already sent to a provider penalize its score
+	DISTRIBUTED_EXPLORATION       = 0.25  // fraction of the default exploration chance
+	DISTRIBUTED_PERTURBATION      = 2     // multiplier of the perturbation
+	ALWAYS_EXPLORE                = 1     // an exploration chance that adds providers up to the wanted concurrency
+	DEFAULT_LATENCY_WEIGHT        = 0.6
+	LATENCY_STRATEGY_WEIGHT       = 0.7
+	SYNC_FRESHNESS_LATENCY_WEIGHT = 0.2
+)
+
+// ProviderCandidate holds what a strategy knows about a provider when choosing who to relay to
+type ProviderCandidate struct {
+	Address      string
+	LatencyScore float64 // expected latency cost in seconds, smaller is better
+	SyncScore    float64 // expected sync lag in seconds, 0 when a specific block was requested
+	Availability float64 // probability of a successful relay
+	StakeRatio   float64 // stake relative to the average stake of the candidates, 1 when unknown
+	UsageRatio   float64 // relays sent relative to the average of the candidates, 1 when unknown
+	Score        float64 // the score the provider was chosen by, smaller is better, set by scoring strategies
+}
+
+// SelectionRequest holds the data of the relay the providers are chosen for
+type SelectionRequest struct {
+	DappID          string // identifies the requester, empty when unknown
+	ApiInterface    string // the api interface of the relay, used to tell selections apart when introspecting
+	Cu              uint64
+	RequestedBlock  int64
+	WantedProviders int     // how many providers can be relayed to in parallel
+	Perturbation    float64 // random perturbation percentage to add to scores, so the best provider isn't always picked
+}
+
+// StrategyPolicy implements a provider selection strategy
+type StrategyPolicy interface {
+	// Choose returns the providers to relay to out of the candidates, the first one is the preferred provider
+	Choose(request SelectionRequest, candidates []ProviderCandidate) []string
+	// CrossCheck returns true if responses should always be verified against another provider
+	CrossCheck() bool
+}
+
+// ScoringPolicy picks the provider with the best weighted score, and adds more providers in parallel by chance
+type ScoringPolicy struct {
+	LatencyWeight     float64 // weight of the latency score against the sync score, between 0 and 1
+	ExplorationChance float64 // chance of relaying to another provider in parallel, ALWAYS_EXPLORE fans out to the wanted concurrency
+	StakeWeight       float64 // penalty on the stake ratio, favoring small providers
+	UsageWeight       float64 // penalty on the usage ratio, favoring less used providers
+	Perturbation      float64 // multiplier of the requested perturbation
+	MinProviders      int     // overrides the wanted concurrency when higher
+	MaxProviders      int     // caps the wanted concurrency, 0 for no cap
+	AlwaysCrossCheck  bool
+}
+
+func (sp *ScoringPolicy) score(request SelectionRequest, candidate ProviderCandidate) float64 {
+	perturbation := request.Perturbation * sp.Perturbation
+	latencyScore := pertrubWithNormalGaussian(candidate.LatencyScore, perturbation)
+	score := latencyScore
+	if request.RequestedBlock < 0 {
+		// the user didn't ask for a specific block so we want to give him the most synced provider
+		syncScore := pertrubWithNormalGaussian(candidate.SyncScore, perturbation)
+		score = latencyScore*sp.LatencyWeight + syncScore*(1-sp.LatencyWeight)
+	}
+	return score * (1 + sp.StakeWeight*candidate.StakeRatio + sp.UsageWeight*candidate.UsageRatio)
+}
+
+func (sp *ScoringPolicy) wantedProviders(request SelectionRequest) int {
+	wanted := request.WantedProviders
+	if wanted < sp.MinProviders {
+		wanted = sp.MinProviders
+	}
+	if sp.MaxProviders > 0 && wanted > sp.MaxProviders {
+		wanted = sp.MaxProviders
+	}
+	return wanted
+}
+
+func (sp *ScoringPolicy) shouldExplore(currentNumProviders, wantedProviders, numProviders int) bool {
+	if currentNumProviders >= wantedProviders {
+		return false
+	}
+	if sp.ExplorationChance >= ALWAYS_EXPLORE {
+		return true
+	}
+	// Dividing the random threshold by the loop count ensures that the overall probability of success is the requirement for the entire loop not per iteration
+	return rand.Float64() < sp.ExplorationChance/float64(numProviders)
+}
+
+func (sp *ScoringPolicy) Choose(request SelectionRequest, candidates []ProviderCandidate) []string {
+	returnedProviders := make([]string, 1) // location 0 is always the best score
+	bestScore := math.MaxFloat64           // smaller = better
+	wantedProviders := sp.wantedProviders(request)
+	for idx, candidate := range candidates {
+		score := sp.score(request, candidate)
+		candidates[idx].Score = score
+		if debug {
+			utils.LavaFormatDebug("scores information", utils.Attribute{Key: "providerAddress", Value: candidate.Address}, utils.Attribute{Key: "score", Value: score}, utils.Attribute{Key: "bestScore", Value: bestScore})
+		}
+		if score < bestScore {
+			if returnedProviders[0] != "" && sp.shouldExplore(len(returnedProviders), wantedProviders, len(candidates)) {
+				// we are about to overwrite position 0, and this provider needs a chance to be in exploration
+				returnedProviders = append(returnedProviders, returnedProviders[0])
+			}
+			returnedProviders[0] = candidate.Address // best provider is always on position 0
+			bestScore = score
+			continue
+		}
+		if sp.shouldExplore(len(returnedProviders), wantedProviders, len(candidates)) {
+			returnedProviders = append(returnedProviders, candidate.Address)
+		}
+	}
+	return returnedProviders
+}
+
+func (sp *ScoringPolicy) CrossCheck() bool {
+	return sp.AlwaysCrossCheck
+}
+
+// PrivacyPolicy relays each dapp to a single provider out of a small stable set, so no provider sees the full traffic of the consumer
+// and each dapp's traffic is exposed to as few providers as possible
+type PrivacyPolicy struct {
+	lock            sync.Mutex
+	maxDapps        int
+	pinnedProviders map[string]*list.Element // dapp id -> element of pinOrder
+	pinOrder        *list.List               // of *privacyPins, front is the most recently used dapp
+}
+
+type privacyPins struct {
+	dappID string
+	pinned []string // pinned provider addresses, oldest first
+}
+
+// pins are kept in a bounded map this policy controls instead of a cache that may drop writes,
+// a pin only moves to another provider when the pinned ones can't serve the relay or the dapp was the least recently used one
+func NewPrivacyPolicy() *PrivacyPolicy {
+	return &PrivacyPolicy{maxDapps: PRIVACY_MAX_PINNED_DAPPS, pinnedProviders: map[string]*list.Element{}, pinOrder: list.New()}
+}
+
+func (pp *PrivacyPolicy) Choose(request SelectionRequest, candidates []ProviderCandidate) []string {
+	if len(candidates) == 0 {
+		return []string{""}
+	}
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pins, found := pp.getPins(request.DappID)
+	if !found {
+		pins = pp.addPins(request.DappID)
+	}
+	for _, pinnedAddress := range pins.pinned {
+		for _, candidate := range candidates {
+			if candidate.Address == pinnedAddress {
+				return []string{pinnedAddress}
+			}
+		}
+	}
+	// none of the pinned providers can serve this relay, pin a random one instead of the best so the choice doesn't reveal anything
+	chosen := candidates[rand.Intn(len(candidates))].Address
+	pins.pinned = append(pins.pinned, chosen)
+	if len(pins.pinned) > PRIVACY_PINNED_PROVIDERS {
+		pins.pinned = pins.pinned[len(pins.pinned)-PRIVACY_PINNED_PROVIDERS:]
+	}
+	return []string{chosen}
+}
+
+// must be called with the lock held, marks the dapp as the most recently used
+func (pp *PrivacyPolicy) getPins(dappID string) (*privacyPins, bool) {
+	element, ok := pp.pinnedProviders[dappID]
+	if !ok {
+		return nil, false
+	}
+	pp.pinOrder.MoveToFront(element)
+	return element.Value.(*privacyPins), true
+}
+
+// must be called with the lock held, forgets the least recently used dapp when full
+func (pp *PrivacyPolicy) addPins(dappID string) *privacyPins {
+	for len(pp.pinnedProviders) >= pp.maxDapps && pp.pinOrder.Len() > 0 {
+		oldest := pp.pinOrder.Back()
+		pp.pinOrder.Remove(oldest)
+		delete(pp.pinnedProviders, oldest.Value.(*privacyPins).dappID)
+	}
+	pins := &privacyPins{dappID: dappID}
+	pp.pinnedProviders[dappID] = pp.pinOrder.PushFront(pins)
+	return pins
+}
+
+func (pp *PrivacyPolicy) CrossCheck() bool {
+	return false // cross checking sends the request to another provider
+}
+
+type registeredStrategy struct {
+	name      string
+	newPolicy func() StrategyPolicy
+}
+
+var strategiesRegistry = struct {
+	lock       sync.RWMutex
+	strategies []registeredStrategy // indexed by Strategy
+}{
+	strategies: []registeredStrategy{
+		STRATEGY_BALANCED: {name: "balanced", newPolicy: func() StrategyPolicy {
+			return &ScoringPolicy{LatencyWeight: DEFAULT_LATENCY_WEIGHT, ExplorationChance: DEFAULT_EXPLORATION_CHANCE, Perturbation: 1}
+		}},
+		STRATEGY_LATENCY: {name: "latency", newPolicy: func() StrategyPolicy {
+			// we want a lot of parallel tries on latency
+			return &ScoringPolicy{LatencyWeight: LATENCY_STRATEGY_WEIGHT, ExplorationChance: ALWAYS_EXPLORE, Perturbation: 1}
+		}},
+		STRATEGY_SYNC_FRESHNESS: {name: "sync-freshness", newPolicy: func() StrategyPolicy {
+			return &ScoringPolicy{LatencyWeight: SYNC_FRESHNESS_LATENCY_WEIGHT, ExplorationChance: DEFAULT_EXPLORATION_CHANCE, Perturbation: 1}
+		}},
+		STRATEGY_COST: {name: "cost", newPolicy: func() StrategyPolicy {
+			// favor small and less used providers, and rarely pay for parallel relays
+			return &ScoringPolicy{LatencyWeight: DEFAULT_LATENCY_WEIGHT, ExplorationChance: COST_EXPLORATION_CHANCE, StakeWeight: COST_STAKE_WEIGHT, UsageWeight: COST_USAGE_WEIGHT, Perturbation: 1}
+		}},
+		STRATEGY_PRIVACY: {name: "privacy", newPolicy: func() StrategyPolicy {
+			return NewPrivacyPolicy()
+		}},
+		STRATEGY_ACCURACY: {name: "accuracy", newPolicy: func() StrategyPolicy {
+			return &ScoringPolicy{LatencyWeight: DEFAULT_LATENCY_WEIGHT, ExplorationChance: ALWAYS_EXPLORE, Perturbation: 1, MinProviders: ACCURACY_MIN_PROVIDERS, AlwaysCrossCheck: true}
+		}},
+		STRATEGY_DISTRIBUTED: {name: "distributed", newPolicy: func() StrategyPolicy {
+			// spread relays evenly, usage is the main factor and the perturbation is higher so similar providers alternate
+			return &ScoringPolicy{LatencyWeight: DEFAULT_LATENCY_WEIGHT, ExplorationChance: DEFAULT_EXPLORATION_CHANCE * DISTRIBUTED_EXPLORATION, UsageWeight: DISTRIBUTED_USAGE_WEIGHT, Perturbation: DISTRIBUTED_PERTURBATION}
+		}},
+	},
+}
+
+// RegisterStrategy adds a named strategy, newPolicy is called for every optimizer using it
+func RegisterStrategy(name string, newPolicy func() StrategyPolicy) (Strategy, error) {
+	strategiesRegistry.lock.Lock()
+	defer strategiesRegistry.lock.Unlock()
+	for _, registered := range strategiesRegistry.strategies {
+		if strings.EqualFold(registered.name, name) {
+			return 0, fmt.Errorf("strategy %s is already registered", name)
+		}
+	}
+	strategiesRegistry.strategies = append(strategiesRegistry.strategies, registeredStrategy{name: name, newPolicy: newPolicy})
+	return Strategy(len(strategiesRegistry.strategies) - 1), nil
+}
+
+// ParseStrategy returns the registered strategy with the given name, case insensitive
+func ParseStrategy(name string) (Strategy, error) {
+	strategiesRegistry.lock.RLock()
+	defer strategiesRegistry.lock.RUnlock()
+	for idx, registered := range strategiesRegistry.strategies {
+		if strings.EqualFold(registered.name, name) {
+			return Strategy(idx), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid strategy: %s", name)
+}
+
+// StrategyNames returns the names of all registered strategies
+func StrategyNames() []string {
+	strategiesRegistry.lock.RLock()
+	defer strategiesRegistry.lock.RUnlock()
+	names := make([]string, 0, len(strategiesRegistry.strategies))
+	for _, registered := range strategiesRegistry.strategies {
+		names = append(names, registered.name)
+	}
+	return names
+}
+
+func (s Strategy) String() string {
+	strategiesRegistry.lock.RLock()
+	defer strategiesRegistry.lock.RUnlock()
+	if int(s) < 0 || int(s) >= len(strategiesRegistry.strategies) {
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+	return strategiesRegistry.strategies[s].name
+}
+
+func (s Strategy) newPolicy() StrategyPolicy {
+	strategiesRegistry.lock.RLock()
+	defer strategiesRegistry.lock.RUnlock()
+	if int(s) < 0 || int(s) >= len(strategiesRegistry.strategies) {
+		utils.LavaFormatError("unknown strategy, using balanced", nil, utils.Attribute{Key: "strategy", Value: int(s)})
+		s = STRATEGY_BALANCED
+	}
+	return strategiesRegistry.strategies[s].newPolicy()
+}
+
+// StrategyConfig defines a custom strategy on top of a built in scoring strategy, unset fields are taken from the base
+type StrategyConfig struct {
+	Name              string   `yaml:"name,omitempty" json:"name,omitempty" mapstructure:"name"`
+	Base              string   `yaml:"base,omitempty" json:"base,omitempty" mapstructure:"base"`
+	LatencyWeight     *float64 `yaml:"latency-weight,omitempty" json:"latency-weight,omitempty" mapstructure:"latency-weight"`
+	ExplorationChance *float64 `yaml:"exploration-chance,omitempty" json:"exploration-chance,omitempty" mapstructure:"exploration-chance"`
+	StakeWeight       *float64 `yaml:"stake-weight,omitempty" json:"stake-weight,omitempty" mapstructure:"stake-weight"`
+	UsageWeight       *float64 `yaml:"usage-weight,omitempty" json:"usage-weight,omitempty" mapstructure:"usage-weight"`
+	Perturbation      *float64 `yaml:"perturbation,omitempty" json:"perturbation,omitempty" mapstructure:"perturbation"`
+	MinProviders      *int     `yaml:"min-providers,omitempty" json:"min-providers,omitempty" mapstructure:"min-providers"`
+	MaxProviders      *int     `yaml:"max-providers,omitempty" json:"max-providers,omitempty" mapstructure:"max-providers"`
+	CrossCheck        *bool    `yaml:"cross-check,omitempty" json:"cross-check,omitempty" mapstructure:"cross-check"`
+}
+
+func (sc StrategyConfig) policy() (*ScoringPolicy, error) {
+	baseName := sc.Base
+	if baseName == "" {
+		baseName = STRATEGY_BALANCED.String()
+	}
+	base, err := ParseStrategy(baseName)
+	if err != nil {
+		return nil, err
+	}
+	policy, ok := base.newPolicy().(*ScoringPolicy)
+	if !ok {
+		return nil, fmt.Errorf("strategy %s can't be used as a base for custom strategies", baseName)
+	}
+	if sc.LatencyWeight != nil {
+		if *sc.LatencyWeight < 0 || *sc.LatencyWeight > 1 {
+			return nil, fmt.Errorf("latency-weight must be between 0 and 1, got %f", *sc.LatencyWeight)
+		}
+		policy.LatencyWeight = *sc.LatencyWeight
+	}
+	if sc.ExplorationChance != nil {
+		policy.ExplorationChance = *sc.ExplorationChance
+	}
+	if sc.StakeWeight != nil {
+		policy.StakeWeight = *sc.StakeWeight
+	}
+	if sc.UsageWeight != nil {
+		policy.UsageWeight = *sc.UsageWeight
+	}
+	if sc.Perturbation != nil {
+		policy.Perturbation = *sc.Perturbation
+	}
+	if sc.MinProviders != nil {
+		policy.MinProviders = *sc.MinProviders
+	}
+	if sc.MaxProviders != nil {
+		policy.MaxProviders = *sc.MaxProviders
+	}
+	if sc.CrossCheck != nil {
+		policy.AlwaysCrossCheck = *sc.CrossCheck
+	}
+	return policy, nil
+}
+
+// RegisterStrategyFromConfig validates a custom strategy definition and registers it under its name
+func RegisterStrategyFromConfig(config StrategyConfig) (Strategy, error) {
+	if config.Name == "" {
+		return 0, fmt.Errorf("custom strategy must have a name")
+	}
+	if _, err := config.policy(); err != nil {
+		return 0, fmt.Errorf("invalid strategy %s: %w", config.Name, err)
+	}
+	return RegisterStrategy(config.Name, func() StrategyPolicy {
+		policy, _ := config.policy() // validated on registration
+		return policy
+	})
+}
@@ -0,0 +1,432 @@
+package rpcconsumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	DefaultBatchSplitSize      = 1
+	DefaultBatchMaxItems       = 100
+	DefaultBatchParallelRelays = 10
+
+	// json-rpc error codes of batch items that failed on the consumer
+	batchItemInvalidRequestCode = -32600
+	batchItemInternalErrorCode  = -32603
+)
+
+var BatchTooLargeError = sdkerrors.New("BatchTooLarge Error", 687, "json-rpc batch has more items than can be split")
+
+// batchSplitting decides which json-rpc batches are fanned out into relays of their items
+type batchSplitting struct {
+	enabled        bool
+	subBatchSize   int // uncached items sharing an addon and extensions are relayed together in sub-batches of up to this size
+	maxItems       int // batches with more items are rejected instead of being split
+	parallelRelays int // the relays of a split batch sent at the same time
+}
+
+func newBatchSplitting(enabled bool, subBatchSize int, maxItems int, parallelRelays int) batchSplitting {
+	if subBatchSize < 1 {
+		subBatchSize = DefaultBatchSplitSize
+	}
+	if maxItems < 1 {
+		maxItems = DefaultBatchMaxItems
+	}
+	if parallelRelays < 1 {
+		parallelRelays = DefaultBatchParallelRelays
+	}
+	return batchSplitting{enabled: enabled, subBatchSize: subBatchSize, maxItems: maxItems, parallelRelays: parallelRelays}
+}
+
+// returns the items of the request if it is a json-rpc batch that should be split,
+// the split batch directive header overrides the configuration for a relay
+func (bs batchSplitting) split(apiInterface string, directiveHeaders map[string]string, data []byte) (items []json.RawMessage, ok bool) {
+	if apiInterface != spectypes.APIInterfaceJsonRPC {
+		return nil, false
+	}
+	enabled := bs.enabled
+	if headerValue, found := directiveHeaders[common.SPLIT_BATCH_HEADER_NAME]; found {
+		headerEnabled, err := strconv.ParseBool(strings.TrimSpace(headerValue))
+		if err != nil {
+			utils.LavaFormatWarning("invalid "+common.SPLIT_BATCH_HEADER_NAME+" header, expected true or false", nil, utils.LogAttr("value", headerValue))
+		} else {
+			enabled = headerEnabled
+		}
+	}
+	if !enabled {
+		return nil, false
+	}
+	items, ok = rpcInterfaceMessages.SplitJsonRPCBatch(data)
+	if !ok || len(items) < 2 {
+		// a single item batch gains nothing from splitting
+		return nil, false
+	}
+	return items, true
+}
+
+// batchItem is a single request of a split batch and its response
+type batchItem struct {
+	data             json.RawMessage
+	id               json.RawMessage
+	notification     bool // a request without an id, it is relayed but gets no response in the batch reply
+	chainMessage     chainlib.ChainMessage
+	relayRequestData *pairingtypes.RelayPrivateData // only set when the item is looked up in the cache on its own
+	response         json.RawMessage
+	latestBlock      int64
+	provider         string
+	err              error
+	errCode          int
+}
+
+func (bi *batchItem) fail(code int, err error) {
+	bi.errCode = code
+	bi.err = err
+}
+
+// succeeded is true for items answered with a response, notifications succeed once relayed as they get no response
+func (bi *batchItem) succeeded() bool {
+	return bi.err == nil && (bi.notification || len(bi.response) > 0)
+}
+
+// the response of the item, or a json-rpc error with the item's id if it failed
+func (bi *batchItem) reply() json.RawMessage {
+	if bi.err == nil && len(bi.response) > 0 {
+		return bi.response
+	}
+	errMessage := "no response for batch item"
+	if bi.err != nil {
+		errMessage = bi.err.Error()
+	}
+	code := bi.errCode
+	if code == 0 {
+		code = batchItemInternalErrorCode
+	}
+	id := bi.id
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	reply, err := json.Marshal(struct {
+		Version string               `json:"jsonrpc"`
+		ID      json.RawMessage      `json:"id"`
+		Error   *rpcclient.JsonError `json:"error"`
+	}{Version: "2.0", ID: id, Error: &rpcclient.JsonError{Code: code, Message: errMessage}})
+	if err != nil {
+		// can't happen, the id was unmarshalled from json
+		return json.RawMessage(`{"jsonrpc":"2.0","id":null,"error":{"code":-32603,"message":"failed encoding batch item error"}}`)
+	}
+	return reply
+}
+
+// sendSplitBatchRelay relays the items of a json-rpc batch separately: items in the cache are answered locally,
+// the rest are sent alone or in sub-batches of items sharing an addon and extensions, so each is served by providers that support it.
+// up to parallelRelays relays are sent at the same time and batches of more than maxItems items are rejected.
+// the responses are returned in the order of the batch, items that failed get a json-rpc error instead of failing the batch
+// and notifications get no response
+func (rpccs *RPCConsumerServer) sendSplitBatchRelay(
+	ctx context.Context,
+	url string,
+	itemsData []json.RawMessage,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	metadata []pairingtypes.Metadata,
+	directiveHeaders map[string]string,
+) (relayResult *common.RelayResult, errRet error) {
+	if len(itemsData) > rpccs.batchSplitting.maxItems {
+		return nil, utils.LavaFormatWarning("split batch has too many items", BatchTooLargeError, utils.LogAttr("GUID", ctx), utils.LogAttr("items", len(itemsData)), utils.LogAttr("max_items", rpccs.batchSplitting.maxItems))
+	}
+	relaySentTime := time.Now()
+	extensionInfo := rpccs.getExtensionsFromDirectiveHeaders(directiveHeaders)
+	items := make([]*batchItem, len(itemsData))
+	for idx, itemData := range itemsData {
+		item := &batchItem{data: itemData}
+		items[idx] = item
+		var idHolder struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.Unmarshal(itemData, &idHolder); err != nil {
+			item.fail(batchItemInvalidRequestCode, err)
+			continue
+		}
+		item.id = idHolder.ID
+		item.notification = len(idHolder.ID) == 0
+		chainMessage, err := rpccs.chainParser.ParseMsg(url, itemData, connectionType, metadata, extensionInfo)
+		if err != nil {
+			item.fail(batchItemInvalidRequestCode, err)
+			continue
+		}
+		item.chainMessage = chainMessage
+	}
+
+	groups := rpccs.groupBatchItems(ctx, items, url, connectionType, dappID, consumerIp, directiveHeaders)
+	groupsChan := make(chan []*batchItem, len(groups))
+	for _, group := range groups {
+		groupsChan <- group
+	}
+	close(groupsChan)
+	workers := rpccs.batchSplitting.parallelRelays
+	if workers > len(groups) {
+		workers = len(groups)
+	}
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range groupsChan {
+				if len(group) == 1 {
+					rpccs.sendBatchItem(ctx, group[0], url, connectionType, dappID, consumerIp, directiveHeaders, relaySentTime)
+					continue
+				}
+				rpccs.sendSubBatch(ctx, group, url, connectionType, dappID, consumerIp, metadata, extensionInfo, directiveHeaders, relaySentTime)
+			}
+		}()
+	}
+	wg.Wait()
+
+	replies := []json.RawMessage{}
+	providers := []string{}
+	providersSet := map[string]struct{}{}
+	latestBlock := int64(0)
+	computeUnits := uint64(0)
+	succeeded := 0
+	for _, item := range items {
+		if !item.notification {
+			replies = append(replies, item.reply())
+		}
+		if item.succeeded() {
+			succeeded++
+			if item.chainMessage != nil {
+				computeUnits += item.chainMessage.GetApi().ComputeUnits
+			}
+		}
+		if item.latestBlock > latestBlock {
+			latestBlock = item.latestBlock
+		}
+		for _, provider := range strings.Split(item.provider, ",") {
+			if _, found := providersSet[provider]; provider != "" && !found {
+				providersSet[provider] = struct{}{}
+				providers = append(providers, provider)
+			}
+		}
+	}
+	sort.Strings(providers)
+	var data []byte
+	if len(replies) > 0 {
+		// a batch of notifications only is answered with nothing at all rather than an empty array
+		var err error
+		data, err = json.Marshal(replies)
+		if err != nil {
+			return &common.RelayResult{}, utils.LavaFormatError("failed encoding split batch response", err, utils.LogAttr("GUID", ctx))
+		}
+	}
+	relayResult = &common.RelayResult{
+		Reply:        &pairingtypes.RelayReply{Data: data, LatestBlock: latestBlock},
+		ProviderInfo: common.ProviderInfo{ProviderAddress: strings.Join(providers, ",")},
+		Finalized:    false,
+	}
+	rpccs.appendHeadersToRelayResult(ctx, relayResult, 0)
+	if succeeded == 0 {
+		return relayResult, utils.LavaFormatError("Failed all items of split batch", items[0].err, utils.LogAttr("GUID", ctx), utils.LogAttr("items", len(items)), utils.LogAttr("chain_id", rpccs.listenEndpoint.ChainID))
+	}
+	if analytics != nil {
+		analytics.Latency = time.Since(relaySentTime).Milliseconds()
+		analytics.ComputeUnits = computeUnits
+	}
+	utils.LavaFormatDebug("split batch relayed", utils.LogAttr("GUID", ctx), utils.LogAttr("items", len(items)), utils.LogAttr("failed", len(items)-succeeded), utils.LogAttr("groups", len(groups)))
+	return relayResult, nil
+}
+
+// groupBatchItems returns the groups of items to relay together. without sub-batches every item is its own group and the cache is
+// checked by its relay, otherwise the items are looked up in the cache here and the rest are grouped by their addon and extensions
+func (rpccs *RPCConsumerServer) groupBatchItems(ctx context.Context, items []*batchItem, url string, connectionType string, dappID string, consumerIp string, directiveHeaders map[string]string) [][]*batchItem {
+	groups := [][]*batchItem{}
+	if rpccs.batchSplitting.subBatchSize <= 1 {
+		for _, item := range items {
+			if item.err == nil {
+				groups = append(groups, []*batchItem{item})
+			}
+		}
+		return groups
+	}
+
+	var sharedStateId string
+	if rpccs.sharedState {
+		sharedStateId = rpccs.consumerConsistency.Key(dappID, consumerIp)
+	}
+	groupIndexes := map[string]int{}
+	for _, item := range items {
+		if item.err != nil {
+			continue
+		}
+		rpccs.HandleDirectiveHeadersForMessage(item.chainMessage, directiveHeaders)
+		item.relayRequestData = rpccs.newBatchItemRelayData(ctx, item, url, connectionType, dappID, consumerIp)
+		// notifications must reach the node, they are never answered from the cache
+		if !item.notification {
+			if cachedResult, found := rpccs.getCachedRelayResult(ctx, item.chainMessage, item.relayRequestData, dappID, consumerIp, sharedStateId); found {
+				item.response = cachedResult.Reply.Data
+				item.latestBlock = cachedResult.Reply.LatestBlock
+				continue
+			}
+		}
+		if chainlib.IsSubscription(item.chainMessage) {
+			groups = append(groups, []*batchItem{item})
+			continue
+		}
+		extensions := common.GetExtensionNames(item.chainMessage.GetExtensions())
+		sort.Strings(extensions)
+		key := chainlib.GetAddon(item.chainMessage) + "|" + strings.Join(extensions, ",")
+		groupIdx, found := groupIndexes[key]
+		if !found || len(groups[groupIdx]) >= rpccs.batchSplitting.subBatchSize {
+			groupIdx = len(groups)
+			groupIndexes[key] = groupIdx
+			groups = append(groups, []*batchItem{})
+		}
+		groups[groupIdx] = append(groups[groupIdx], item)
+	}
+	return groups
+}
+
+func (rpccs *RPCConsumerServer) newBatchItemRelayData(ctx context.Context, item *batchItem, url string, connectionType string, dappID string, consumerIp string) *pairingtypes.RelayPrivateData {
+	reqBlock, _ := item.chainMessage.RequestedBlock()
+	seenBlock, _ := rpccs.consumerConsistency.GetSeenBlock(dappID, consumerIp)
+	if seenBlock < 0 {
+		seenBlock = 0
+	}
+	return lavaprotocol.NewRelayData(ctx, connectionType, url, item.data, seenBlock, reqBlock, rpccs.listenEndpoint.ApiInterface, item.chainMessage.GetRPCMessage().GetHeaders(), chainlib.GetAddon(item.chainMessage), common.GetExtensionNames(item.chainMessage.GetExtensions()))
+}
+
+// sendBatchItem relays a single item, it goes through the cache and retries like any relay
+func (rpccs *RPCConsumerServer) sendBatchItem(ctx context.Context, item *batchItem, url string, connectionType string, dappID string, consumerIp string, directiveHeaders map[string]string, relaySentTime time.Time) {
+	relayResult, err := rpccs.sendParsedRelay(ctx, item.chainMessage, url, string(item.data), connectionType, dappID, consumerIp, nil, directiveHeaders, relaySentTime)
+	item.provider = relayResult.GetProvider()
+	if err != nil {
+		item.fail(batchItemInternalErrorCode, err)
+		return
+	}
+	item.response = relayResult.GetReply().GetData()
+	item.latestBlock = relayResult.GetReply().GetLatestBlock()
+}
+
+// sendSubBatch relays items sharing an addon and extensions as one batch, and caches the response of every item on its own
+func (rpccs *RPCConsumerServer) sendSubBatch(
+	ctx context.Context,
+	group []*batchItem,
+	url string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	metadata []pairingtypes.Metadata,
+	extensionInfo extensionslib.ExtensionInfo,
+	directiveHeaders map[string]string,
+	relaySentTime time.Time,
+) {
+	failGroup := func(err error) {
+		for _, item := range group {
+			item.fail(batchItemInternalErrorCode, err)
+		}
+	}
+	itemsData := make([]json.RawMessage, len(group))
+	for idx, item := range group {
+		itemsData[idx] = item.data
+	}
+	req, err := json.Marshal(itemsData)
+	if err != nil {
+		failGroup(err)
+		return
+	}
+	chainMessage, err := rpccs.chainParser.ParseMsg(url, req, connectionType, metadata, extensionInfo)
+	if err != nil {
+		failGroup(err)
+		return
+	}
+	relayResult, err := rpccs.sendParsedRelay(ctx, chainMessage, url, string(req), connectionType, dappID, consumerIp, nil, directiveHeaders, relaySentTime)
+	provider := relayResult.GetProvider()
+	for _, item := range group {
+		item.provider = provider
+	}
+	if err != nil {
+		failGroup(err)
+		return
+	}
+	reply := relayResult.GetReply()
+	var responses []json.RawMessage
+	// nodes answer a batch of notifications only with nothing at all
+	if len(bytes.TrimSpace(reply.GetData())) > 0 {
+		err = json.Unmarshal(reply.GetData(), &responses)
+		if err != nil {
+			failGroup(utils.LavaFormatWarning("invalid sub-batch response", err, utils.LogAttr("GUID", ctx)))
+			return
+		}
+	}
+	matchBatchResponses(group, responses)
+
+	var sharedStateId string
+	if rpccs.sharedState {
+		sharedStateId = rpccs.consumerConsistency.Key(dappID, consumerIp)
+	}
+	for _, item := range group {
+		item.latestBlock = reply.GetLatestBlock()
+		if item.err != nil || item.notification || !rpccs.cache.CacheActive() || batchResponseHasError(item.response) {
+			continue
+		}
+		itemReply := &pairingtypes.RelayReply{Data: item.response, LatestBlock: reply.GetLatestBlock()}
+		rpccs.setCachedRelayResult(item.chainMessage, item.relayRequestData, itemReply, false, sharedStateId)
+	}
+}
+
+// matchBatchResponses assigns the responses to the items by their ids, the n-th item with an id gets the n-th response
+// with the same id. notifications get no response, and items left without one fail alone
+func matchBatchResponses(group []*batchItem, responses []json.RawMessage) {
+	byID := map[string][]json.RawMessage{}
+	for _, response := range responses {
+		var idHolder struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if json.Unmarshal(response, &idHolder) == nil && len(idHolder.ID) > 0 {
+			key := string(bytes.TrimSpace(idHolder.ID))
+			byID[key] = append(byID[key], response)
+		}
+	}
+	occurrences := map[string]int{}
+	for _, item := range group {
+		if item.notification {
+			continue
+		}
+		key := string(bytes.TrimSpace(item.id))
+		occurrence := occurrences[key]
+		occurrences[key]++
+		if matching := byID[key]; occurrence < len(matching) {
+			item.response = matching[occurrence]
+			continue
+		}
+		item.fail(batchItemInternalErrorCode, utils.LavaFormatWarning("no response for batch item", nil, utils.LogAttr("id", string(item.id))))
+	}
+}
+
+func batchResponseHasError(response json.RawMessage) bool {
+	var message rpcInterfaceMessages.JsonrpcMessage
+	if err := json.Unmarshal(response, &message); err != nil {
+		return true
+	}
+	return message.Error != nil
+}
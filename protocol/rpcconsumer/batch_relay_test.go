@@ -0,0 +1,244 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+)
+
+func TestBatchSplitting(t *testing.T) {
+	batch := []byte(` [{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"b","method":"eth_chainId"}]`)
+	single := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+	singleItemBatch := []byte(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}]`)
+
+	splitting := newBatchSplitting(true, 0, 0, 0)
+	require.Equal(t, DefaultBatchSplitSize, splitting.subBatchSize)
+	require.Equal(t, DefaultBatchMaxItems, splitting.maxItems)
+	require.Equal(t, DefaultBatchParallelRelays, splitting.parallelRelays)
+	items, ok := splitting.split(spectypes.APIInterfaceJsonRPC, map[string]string{}, batch)
+	require.True(t, ok)
+	require.Len(t, items, 2)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":"b","method":"eth_chainId"}`, string(items[1]))
+	for _, data := range [][]byte{single, singleItemBatch, []byte(`[{"broken"`)} {
+		_, ok = splitting.split(spectypes.APIInterfaceJsonRPC, map[string]string{}, data)
+		require.False(t, ok, string(data))
+	}
+	_, ok = splitting.split(spectypes.APIInterfaceTendermintRPC, map[string]string{}, batch)
+	require.False(t, ok)
+
+	// the directive header enables or disables splitting for a relay
+	_, ok = splitting.split(spectypes.APIInterfaceJsonRPC, map[string]string{common.SPLIT_BATCH_HEADER_NAME: "false"}, batch)
+	require.False(t, ok)
+	disabled := newBatchSplitting(false, 5, 0, 0)
+	_, ok = disabled.split(spectypes.APIInterfaceJsonRPC, map[string]string{}, batch)
+	require.False(t, ok)
+	_, ok = disabled.split(spectypes.APIInterfaceJsonRPC, map[string]string{common.SPLIT_BATCH_HEADER_NAME: "true"}, batch)
+	require.True(t, ok)
+	_, ok = disabled.split(spectypes.APIInterfaceJsonRPC, map[string]string{common.SPLIT_BATCH_HEADER_NAME: "maybe"}, batch)
+	require.False(t, ok)
+}
+
+func TestBatchItemReply(t *testing.T) {
+	item := &batchItem{id: json.RawMessage(`"abc"`), response: json.RawMessage(`{"jsonrpc":"2.0","id":"abc","result":"0x1"}`)}
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":"abc","result":"0x1"}`, string(item.reply()))
+
+	item.fail(batchItemInvalidRequestCode, errors.New("unsupported method"))
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":"abc","error":{"code":-32600,"message":"unsupported method"}}`, string(item.reply()))
+
+	// items without an id or a response still get an error in their place
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32603,"message":"no response for batch item"}}`, string((&batchItem{}).reply()))
+
+	// an empty response is a failure, unless the item is a notification that expects none
+	require.False(t, (&batchItem{id: json.RawMessage(`1`)}).succeeded())
+	require.True(t, (&batchItem{id: json.RawMessage(`1`), response: json.RawMessage(`{"id":1,"result":"a"}`)}).succeeded())
+	require.True(t, (&batchItem{notification: true}).succeeded())
+	require.False(t, (&batchItem{notification: true, err: errors.New("node failed")}).succeeded())
+}
+
+func TestMatchBatchResponses(t *testing.T) {
+	newGroup := func(ids ...string) []*batchItem {
+		group := []*batchItem{}
+		for _, id := range ids {
+			group = append(group, &batchItem{id: json.RawMessage(id), notification: id == ""})
+		}
+		return group
+	}
+
+	// responses are matched by id even when the node reorders them
+	group := newGroup(`1`, `"two"`, `3`)
+	matchBatchResponses(group, []json.RawMessage{
+		json.RawMessage(`{"id":3,"result":"c"}`),
+		json.RawMessage(`{"id":1,"result":"a"}`),
+		json.RawMessage(`{"id":"two","result":"b"}`),
+	})
+	require.JSONEq(t, `{"id":1,"result":"a"}`, string(group[0].reply()))
+	require.JSONEq(t, `{"id":"two","result":"b"}`, string(group[1].reply()))
+	require.JSONEq(t, `{"id":3,"result":"c"}`, string(group[2].reply()))
+
+	// duplicate ids are matched by their occurrence, never by position
+	group = newGroup(`1`, `2`, `1`)
+	matchBatchResponses(group, []json.RawMessage{
+		json.RawMessage(`{"id":2,"result":"b"}`),
+		json.RawMessage(`{"id":1,"result":"a"}`),
+		json.RawMessage(`{"id":1,"result":"c"}`),
+	})
+	require.JSONEq(t, `{"id":1,"result":"a"}`, string(group[0].reply()))
+	require.JSONEq(t, `{"id":2,"result":"b"}`, string(group[1].reply()))
+	require.JSONEq(t, `{"id":1,"result":"c"}`, string(group[2].reply()))
+
+	// a missing response of a duplicate id fails only the occurrence left without one
+	group = newGroup(`1`, `1`)
+	matchBatchResponses(group, []json.RawMessage{json.RawMessage(`{"id":1,"result":"a"}`), json.RawMessage(`{"result":"b"}`)})
+	require.JSONEq(t, `{"id":1,"result":"a"}`, string(group[0].reply()))
+	require.Error(t, group[1].err)
+
+	// notifications don't expect a response
+	group = newGroup(`1`, ``)
+	matchBatchResponses(group, []json.RawMessage{json.RawMessage(`{"id":1,"result":"a"}`)})
+	require.NoError(t, group[1].err)
+	require.True(t, group[1].succeeded())
+
+	// an item without a response fails alone
+	group = newGroup(`1`, `2`)
+	matchBatchResponses(group, []json.RawMessage{json.RawMessage(`{"id":2,"result":"b"}`)})
+	require.Error(t, group[0].err)
+	require.NoError(t, group[1].err)
+	require.JSONEq(t, `{"id":2,"result":"b"}`, string(group[1].reply()))
+}
+
+func TestBatchResponseHasError(t *testing.T) {
+	require.False(t, batchResponseHasError(json.RawMessage(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)))
+	require.True(t, batchResponseHasError(json.RawMessage(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`)))
+	require.True(t, batchResponseHasError(json.RawMessage(`not json`)))
+}
+
+// batchItemHandler answers every request of a relay, alone or in a batch, with its method, and fails relays including eth_getBalance
+func batchItemHandler(inFlight, maxInFlight *atomic.Int64) func(request *pairingtypes.RelayRequest) (string, error) {
+	type batchRequest struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	// like a node, notifications and eth_accounts (standing for a broken node) get no reply
+	reply := func(request batchRequest) string {
+		if len(request.ID) == 0 || request.Method == "eth_accounts" {
+			return ""
+		}
+		return fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%q}`, request.ID, request.Method)
+	}
+	return func(request *pairingtypes.RelayRequest) (string, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for peak := maxInFlight.Load(); current > peak && !maxInFlight.CompareAndSwap(peak, current); peak = maxInFlight.Load() {
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		requests := []batchRequest{}
+		if json.Unmarshal(request.RelayData.Data, &requests) != nil {
+			single := batchRequest{}
+			if err := json.Unmarshal(request.RelayData.Data, &single); err != nil {
+				return "", err
+			}
+			if single.Method == "eth_getBalance" {
+				return "", errors.New("node failed")
+			}
+			return reply(single), nil
+		}
+		replies := []string{}
+		for _, batched := range requests {
+			if batched.Method == "eth_getBalance" {
+				return "", errors.New("node failed")
+			}
+			if batchedReply := reply(batched); batchedReply != "" {
+				replies = append(replies, batchedReply)
+			}
+		}
+		if len(replies) == 0 {
+			return "", nil
+		}
+		return "[" + strings.Join(replies, ",") + "]", nil
+	}
+}
+
+func TestSendSplitBatchRelay(t *testing.T) {
+	itemsData := []json.RawMessage{
+		json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","id":"b","method":"eth_chainId","params":[]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","id":3,"method":"eth_getBalance","params":["0x1234","latest"]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","id":4,"method":"eth_gasPrice","params":[]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","id":5,"method":"eth_noSuchMethod","params":[]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","id":6,"method":"net_version","params":[]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[]}`),
+		json.RawMessage(`{"jsonrpc":"2.0","id":8,"method":"eth_accounts","params":[]}`),
+	}
+	playbook := []struct {
+		name         string
+		subBatchSize int
+		failed       []string // the ids of the items failed by the provider or left without a response
+	}{
+		{name: "items relayed alone", subBatchSize: 1, failed: []string{`3`, `8`}},
+		// eth_getBalance fails the whole sub-batch it is sent in
+		{name: "items relayed in sub-batches", subBatchSize: 2, failed: []string{`3`, `4`, `8`}},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			setup := newConsumerTestSetup(t)
+			setup.rpccs.batchSplitting = newBatchSplitting(true, play.subBatchSize, 0, 2)
+			var inFlight, maxInFlight atomic.Int64
+			setup.addProviders(t, batchItemHandler(&inFlight, &maxInFlight), batchItemHandler(&inFlight, &maxInFlight))
+
+			relayResult, err := setup.rpccs.sendSplitBatchRelay(context.Background(), "", itemsData, http.MethodPost, "dapp", "127.0.0.1", nil, nil, map[string]string{})
+			require.NoError(t, err)
+			replies := []json.RawMessage{}
+			require.NoError(t, json.Unmarshal(relayResult.Reply.Data, &replies))
+			// the notification gets no reply
+			require.Len(t, replies, len(itemsData)-1)
+
+			// the replies are in the order of the batch, failed items get an error with their id
+			require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"eth_blockNumber"}`, string(replies[0]))
+			require.JSONEq(t, `{"jsonrpc":"2.0","id":"b","result":"eth_chainId"}`, string(replies[1]))
+			require.JSONEq(t, `{"jsonrpc":"2.0","id":6,"result":"net_version"}`, string(replies[5]))
+			replyItems := slices.Delete(slices.Clone(itemsData), 6, 7)
+			for idx, itemData := range replyItems {
+				var item, reply struct {
+					ID    json.RawMessage `json:"id"`
+					Error *struct {
+						Code int `json:"code"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.Unmarshal(itemData, &item))
+				require.NoError(t, json.Unmarshal(replies[idx], &reply))
+				require.Equal(t, string(item.ID), string(reply.ID))
+				switch {
+				case string(item.ID) == `5`:
+					require.Equal(t, batchItemInvalidRequestCode, reply.Error.Code)
+				case slices.Contains(play.failed, string(item.ID)):
+					require.Equal(t, batchItemInternalErrorCode, reply.Error.Code)
+				default:
+					require.Nil(t, reply.Error, string(replies[idx]))
+				}
+			}
+			require.LessOrEqual(t, maxInFlight.Load(), int64(2))
+		})
+	}
+
+	// a batch with more items than allowed is rejected before it is relayed
+	setup := newConsumerTestSetup(t)
+	setup.rpccs.batchSplitting = newBatchSplitting(true, 1, len(itemsData)-1, 2)
+	var inFlight, maxInFlight atomic.Int64
+	setup.addProviders(t, batchItemHandler(&inFlight, &maxInFlight))
+	_, err := setup.rpccs.sendSplitBatchRelay(context.Background(), "", itemsData, http.MethodPost, "dapp", "127.0.0.1", nil, nil, map[string]string{})
+	require.ErrorIs(t, err, BatchTooLargeError)
+	require.Equal(t, int64(0), setup.providers[0].relays.Load())
+}
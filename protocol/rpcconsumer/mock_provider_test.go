@@ -0,0 +1,192 @@
+package rpcconsumer
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	plantypes "github.com/lavanet/lava/x/plans/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	mockProviderLatestBlock = 1000
+	mockPairingEpoch        = 20
+)
+
+// mockProvider is a provider's relay server that answers with signed replies from its handler,
+// and streams subscriptions with its subscribe handler
+type mockProvider struct {
+	pairingtypes.UnimplementedRelayerServer
+	address       string
+	listenAddress string
+	privKey       *btcec.PrivateKey
+	consumer      sdk.AccAddress
+	pairing       *lavasession.ConsumerSessionsWithProvider // the consumer's sessions with the provider
+	relays        atomic.Int64
+	handler       func(request *pairingtypes.RelayRequest) (data string, err error)
+	subscribe     func(request *pairingtypes.RelayRequest, send func(data string) error) error
+}
+
+func (mp *mockProvider) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	mp.relays.Add(1)
+	data, err := mp.handler(request)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pairingtypes.RelayReply{Data: []byte(data), LatestBlock: mockProviderLatestBlock}
+	return lavaprotocol.SignRelayResponse(mp.consumer, *request, mp.privKey, reply, true)
+}
+
+func (mp *mockProvider) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
+	return mp.subscribe(request, func(data string) error {
+		return srv.Send(&pairingtypes.RelayReply{Data: []byte(data)})
+	})
+}
+
+// the compute units the consumer was charged for relays with the provider
+func (mp *mockProvider) usedComputeUnits() uint64 {
+	mp.pairing.Lock.RLock()
+	defer mp.pairing.Lock.RUnlock()
+	return mp.pairing.UsedComputeUnits
+}
+
+func (mp *mockProvider) Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error) {
+	return &pairingtypes.ProbeReply{Guid: probeReq.GetGuid(), LatestBlock: mockProviderLatestBlock, LavaEpoch: mockPairingEpoch}, nil
+}
+
+func startMockProvider(t *testing.T, consumer sdk.AccAddress, provider *mockProvider) {
+	privKey, address := sigs.GenerateFloatingKey()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	provider.address, provider.listenAddress, provider.privKey, provider.consumer = address.String(), lis.Addr().String(), privKey, consumer
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(lavasession.GetTlsConfig(lavasession.NetworkAddressData{}))))
+	pairingtypes.RegisterRelayerServer(server, provider)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+}
+
+// mockTxSender records the response conflicts the consumer reports
+type mockTxSender struct {
+	conflicts chan *conflicttypes.ResponseConflict
+}
+
+func (mts *mockTxSender) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict, conflictHandler common.ConflictHandlerInterface) error {
+	if responseConflict != nil {
+		mts.conflicts <- responseConflict
+	}
+	return nil
+}
+
+func (mts *mockTxSender) GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error) {
+	return nil, nil
+}
+
+func (mts *mockTxSender) GetLatestVirtualEpoch() uint64 {
+	return 0
+}
+
+// returns the conflicts reported within the wait
+func (mts *mockTxSender) reportedConflicts(wait time.Duration) int {
+	count := 0
+	timeout := time.After(wait)
+	for {
+		select {
+		case <-mts.conflicts:
+			count++
+		case <-timeout:
+			return count
+		}
+	}
+}
+
+// consumerTestSetup is a consumer server of the ETH1 json rpc spec paired with mock providers
+type consumerTestSetup struct {
+	rpccs     *RPCConsumerServer
+	txSender  *mockTxSender
+	consumer  sdk.AccAddress
+	providers []*mockProvider
+}
+
+func newConsumerTestSetup(t *testing.T) *consumerTestSetup {
+	rand.InitRandomSeed()
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	spec.DataReliabilityEnabled = false // the mock providers don't sign finalization data
+	chainParser, err := chainlib.NewChainParser(spec.ApiCollections[0].CollectionData.ApiInterface)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+
+	privKey, consumer := sigs.GenerateFloatingKey()
+	listenEndpoint := &lavasession.RPCEndpoint{ChainID: spec.Index, ApiInterface: spec.ApiCollections[0].CollectionData.ApiInterface}
+	txSender := &mockTxSender{conflicts: make(chan *conflicttypes.ResponseConflict, 10)}
+	rpccs := &RPCConsumerServer{
+		chainParser:           chainParser,
+		listenEndpoint:        listenEndpoint,
+		privKey:               privKey,
+		consumerTxSender:      txSender,
+		finalizationConsensus: lavaprotocol.NewFinalizationConsensus(spec.Index),
+		lavaChainID:           "lava",
+		consumerAddress:       consumer,
+		consumerConsistency:   NewConsumerConsistency(spec.Index),
+	}
+	return &consumerTestSetup{rpccs: rpccs, txSender: txSender, consumer: consumer}
+}
+
+// starts a provider for each handler and pairs the consumer with all the providers started so far
+func (cts *consumerTestSetup) addProviders(t *testing.T, handlers ...func(request *pairingtypes.RelayRequest) (string, error)) []*mockProvider {
+	added := make([]*mockProvider, len(handlers))
+	for i, handler := range handlers {
+		added[i] = &mockProvider{handler: handler}
+	}
+	cts.startProviders(t, added...)
+	return added
+}
+
+// starts the providers and pairs the consumer with all the providers started so far
+func (cts *consumerTestSetup) startProviders(t *testing.T, providers ...*mockProvider) {
+	for _, provider := range providers {
+		startMockProvider(t, cts.consumer, provider)
+	}
+	cts.providers = append(cts.providers, providers...)
+
+	lavasession.AllowInsecureConnectionToProviders = true
+	optimizer := provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, time.Second, common.AverageWorldLatency/2, 1)
+	cts.rpccs.consumerSessionManager = lavasession.NewConsumerSessionManager(cts.rpccs.listenEndpoint, optimizer, nil, nil)
+	pairing := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+	for idx, provider := range cts.providers {
+		endpoints := []*lavasession.Endpoint{{NetworkAddress: provider.listenAddress, Enabled: true}}
+		provider.pairing = lavasession.NewConsumerSessionWithProvider(provider.address, endpoints, 1000000, mockPairingEpoch, sdk.NewInt64Coin("ulava", 1000))
+		pairing[uint64(idx)] = provider.pairing
+	}
+	require.NoError(t, cts.rpccs.consumerSessionManager.UpdateAllProviders(mockPairingEpoch, pairing))
+}
+
+func (cts *consumerTestSetup) parseMsg(t *testing.T, request string) chainlib.ChainMessage {
+	chainMessage, err := cts.rpccs.chainParser.ParseMsg("", []byte(request), http.MethodPost, nil, extensionslib.ExtensionInfo{})
+	require.NoError(t, err)
+	return chainMessage
+}
+
+func (cts *consumerTestSetup) relayData(ctx context.Context, chainMessage chainlib.ChainMessage, request string) *pairingtypes.RelayPrivateData {
+	reqBlock, _ := chainMessage.RequestedBlock()
+	return lavaprotocol.NewRelayData(ctx, http.MethodPost, "", []byte(request), 0, reqBlock, cts.rpccs.listenEndpoint.ApiInterface, chainMessage.GetRPCMessage().GetHeaders(), chainlib.GetAddon(chainMessage), common.GetExtensionNames(chainMessage.GetExtensions()))
+}
@@ -0,0 +1,145 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	OptimizerIntrospectionPath    = "/optimizer"
+	OptimizerMetricsInterval      = 30 * time.Second
+	DefaultOptimizerDecisionLimit = 20
+)
+
+type introspectedEndpoint struct {
+	endpoint  *lavasession.RPCEndpoint
+	optimizer *provideroptimizer.ProviderOptimizer
+}
+
+// OptimizerEndpointReport is the introspection of a single endpoint's optimizer
+type OptimizerEndpointReport struct {
+	ChainID      string `json:"chain_id"`
+	ApiInterface string `json:"api_interface"`
+	provideroptimizer.OptimizerReport
+}
+
+// OptimizerIntrospection explains the provider choices of the optimizers of all endpoints
+type OptimizerIntrospection struct {
+	lock      sync.RWMutex
+	endpoints map[string]introspectedEndpoint // endpoint key -> optimizer
+}
+
+func NewOptimizerIntrospection() *OptimizerIntrospection {
+	return &OptimizerIntrospection{endpoints: map[string]introspectedEndpoint{}}
+}
+
+func (oi *OptimizerIntrospection) Register(endpoint *lavasession.RPCEndpoint, optimizer *provideroptimizer.ProviderOptimizer) {
+	oi.lock.Lock()
+	defer oi.lock.Unlock()
+	oi.endpoints[endpoint.Key()] = introspectedEndpoint{endpoint: endpoint, optimizer: optimizer}
+}
+
+// Reports returns the reports of the endpoints matching the chain id and api interface, empty filters match all endpoints
+func (oi *OptimizerIntrospection) Reports(chainID, apiInterface string, limit int) []OptimizerEndpointReport {
+	oi.lock.RLock()
+	endpoints := make([]introspectedEndpoint, 0, len(oi.endpoints))
+	for _, introspected := range oi.endpoints {
+		if chainID != "" && introspected.endpoint.ChainID != chainID {
+			continue
+		}
+		if apiInterface != "" && introspected.endpoint.ApiInterface != apiInterface {
+			continue
+		}
+		endpoints = append(endpoints, introspected)
+	}
+	oi.lock.RUnlock()
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].endpoint.Key() < endpoints[j].endpoint.Key()
+	})
+	reports := make([]OptimizerEndpointReport, 0, len(endpoints))
+	for _, introspected := range endpoints {
+		reports = append(reports, OptimizerEndpointReport{
+			ChainID:      introspected.endpoint.ChainID,
+			ApiInterface: introspected.endpoint.ApiInterface,
+			// optimizers are shared between the interfaces of a chain, so decisions are filtered by interface
+			OptimizerReport: introspected.optimizer.Report(introspected.endpoint.ApiInterface, limit),
+		})
+	}
+	return reports
+}
+
+// ServeHTTP returns the reports as json, filtered by the chain-id, api-interface and limit query parameters
+func (oi *OptimizerIntrospection) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit := DefaultOptimizerDecisionLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 0 {
+			http.Error(w, "invalid limit: "+limitStr, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+	reports := oi.Reports(query.Get("chain-id"), query.Get("api-interface"), limit)
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(reports)
+	if err != nil {
+		utils.LavaFormatWarning("failed writing optimizer introspection response", err)
+	}
+}
+
+// Serve listens for introspection requests until the context is done
+func (oi *OptimizerIntrospection) Serve(ctx context.Context, address string) {
+	mux := http.NewServeMux()
+	mux.Handle(OptimizerIntrospectionPath, oi)
+	server := &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+	utils.LavaFormatInfo("optimizer introspection endpoint listening", utils.Attribute{Key: "address", Value: address + OptimizerIntrospectionPath})
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		utils.LavaFormatError("optimizer introspection endpoint failed", err, utils.Attribute{Key: "address", Value: address})
+	}
+}
+
+// UpdateMetrics sets the optimizer score gauges of every paired provider each interval
+func (oi *OptimizerIntrospection) UpdateMetrics(ctx context.Context, consumerMetricsManager *metrics.ConsumerMetricsManager, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			consumerMetricsManager.ResetOptimizerScores()
+			for _, report := range oi.Reports("", "", 1) {
+				for _, provider := range report.Providers {
+					consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, provider.Address, "latency", provider.LatencyScore)
+					consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, provider.Address, "sync", provider.SyncScore)
+					consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, provider.Address, "availability", provider.Availability)
+					consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, provider.Address, "timeout_probability", 1-provider.Availability)
+					consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, provider.Address, "stake_ratio", provider.StakeRatio)
+					consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, provider.Address, "usage_ratio", provider.UsageRatio)
+				}
+				// the scores the latest selection chose by, including the perturbation
+				for _, decision := range report.Decisions {
+					for _, candidate := range decision.Candidates {
+						consumerMetricsManager.SetOptimizerScore(report.ChainID, report.ApiInterface, candidate.Address, "selection_score", candidate.Score)
+					}
+				}
+			}
+		}
+	}
+}
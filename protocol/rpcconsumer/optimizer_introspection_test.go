@@ -0,0 +1,64 @@
+package rpcconsumer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils/rand"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizerIntrospection(t *testing.T) {
+	rand.InitRandomSeed()
+	optimizer := provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 10*time.Second, 150*time.Millisecond, 1)
+	introspection := NewOptimizerIntrospection()
+	restEndpoint := &lavasession.RPCEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceRest}
+	grpcEndpoint := &lavasession.RPCEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceGrpc}
+	introspection.Register(restEndpoint, optimizer)
+	introspection.Register(grpcEndpoint, optimizer)
+	providers := []string{"lava@provider1", "lava@provider2"}
+	for i := 0; i < 3; i++ {
+		optimizer.ChooseProviderForRequest(provideroptimizer.SelectionRequest{ApiInterface: spectypes.APIInterfaceRest, Cu: 10, RequestedBlock: spectypes.LATEST_BLOCK}, providers, nil)
+	}
+
+	getReports := func(query string) (int, []OptimizerEndpointReport) {
+		recorder := httptest.NewRecorder()
+		introspection.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, OptimizerIntrospectionPath+query, nil))
+		reports := []OptimizerEndpointReport{}
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &reports))
+		}
+		return recorder.Code, reports
+	}
+
+	code, reports := getReports("")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, reports, 2)
+	for _, report := range reports {
+		if report.ApiInterface == spectypes.APIInterfaceRest {
+			require.Len(t, report.Decisions, 3)
+		} else {
+			// the optimizer is shared but the decisions are per interface
+			require.Empty(t, report.Decisions)
+		}
+	}
+
+	code, reports = getReports("?api-interface=rest&limit=1")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, reports, 1)
+	require.Len(t, reports[0].Decisions, 1)
+	require.Len(t, reports[0].Decisions[0].Candidates, len(providers))
+
+	code, reports = getReports("?chain-id=ETH1")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, reports)
+
+	code, _ = getReports("?limit=abc")
+	require.Equal(t, http.StatusBadRequest, code)
+}
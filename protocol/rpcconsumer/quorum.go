@@ -0,0 +1,245 @@
+package rpcconsumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	MaxQuorumProviders     = 10
+	DefaultQuorumProviders = 3
+	DefaultQuorumAgreement = 2
+)
+
+var QuorumNotReachedError = sdkerrors.New("QuorumNotReached Error", 686, "not enough providers agreed on the response")
+
+// quorumParams is how many providers a relay is sent to, and how many of them must agree on the response
+type quorumParams struct {
+	providers int
+	agreement int
+}
+
+func (qp quorumParams) validate() error {
+	if qp.agreement < 1 || qp.agreement > qp.providers || qp.providers > MaxQuorumProviders {
+		return utils.LavaFormatWarning("invalid quorum, agreement must be between 1 and the number of providers, which is at most "+strconv.Itoa(MaxQuorumProviders), nil, utils.LogAttr("providers", qp.providers), utils.LogAttr("agreement", qp.agreement))
+	}
+	return nil
+}
+
+// quorumConfig decides which relays are sent in quorum mode
+type quorumConfig struct {
+	params quorumParams
+	chains map[string]struct{} // all the apis of these chains are sent in quorum mode
+	apis   map[string]struct{} // these apis are sent in quorum mode on any chain
+}
+
+func newQuorumConfig(providers, agreement int, chains, apis []string) (quorumConfig, error) {
+	config := quorumConfig{params: quorumParams{providers: providers, agreement: agreement}, chains: map[string]struct{}{}, apis: map[string]struct{}{}}
+	for _, chain := range chains {
+		config.chains[chain] = struct{}{}
+	}
+	for _, api := range apis {
+		config.apis[api] = struct{}{}
+	}
+	if len(chains) == 0 && len(apis) == 0 {
+		// only the directive header enables it, validated per relay
+		return config, nil
+	}
+	return config, config.params.validate()
+}
+
+// returns the quorum of the relay, enabled is false if it isn't sent in quorum mode.
+// the quorum directive header enables it for a relay, its value can override the quorum as "agreement/providers"
+func (qc quorumConfig) forRelay(chainID string, chainMessage chainlib.ChainMessage, directiveHeaders map[string]string) (params quorumParams, enabled bool, err error) {
+	params = qc.params
+	headerValue, headerFound := directiveHeaders[common.QUORUM_HEADER_NAME]
+	if headerFound {
+		headerValue = strings.TrimSpace(headerValue)
+		switch headerValue {
+		case "", "true":
+		case "false":
+			return params, false, nil
+		default:
+			agreementStr, providersStr, found := strings.Cut(headerValue, "/")
+			agreement, errAgreement := strconv.Atoi(agreementStr)
+			providers, errProviders := strconv.Atoi(providersStr)
+			if !found || errAgreement != nil || errProviders != nil {
+				return params, false, utils.LavaFormatWarning("invalid "+common.QUORUM_HEADER_NAME+" header, expected true, false or agreement/providers", nil, utils.LogAttr("value", headerValue))
+			}
+			params = quorumParams{providers: providers, agreement: agreement}
+		}
+		return params, true, params.validate()
+	}
+	if _, ok := qc.chains[chainID]; ok {
+		return params, true, nil
+	}
+	if _, ok := qc.apis[chainMessage.GetApi().Name]; ok {
+		return params, true, nil
+	}
+	return params, false, nil
+}
+
+// canonical form of a response so equivalent json replies compare equal
+func normalizeQuorumResponse(data []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // keep big numbers exact
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil || decoder.More() {
+		return string(bytes.TrimSpace(data))
+	}
+	normalized, err := json.Marshal(parsed) // map keys are sorted
+	if err != nil {
+		return string(data)
+	}
+	return string(normalized)
+}
+
+// sends the relay to the providers of the quorum and returns the response once enough of them agree on it.
+// providers that disagree with an agreed response are reported through conflict detection
+func (rpccs *RPCConsumerServer) sendQuorumRelay(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	unwantedProviders map[string]struct{},
+	quorum quorumParams,
+) (*common.RelayResult, error) {
+	errorRelayResult := &common.RelayResult{}
+	reqBlock, _ := chainMessage.RequestedBlock()
+	if reqBlock == spectypes.LATEST_BLOCK {
+		// the providers must answer for the same block for their responses to be comparable
+		latestBlock := int64(rpccs.getLatestBlock())
+		if chainMessage.UpdateLatestBlockInMessage(latestBlock, false) {
+			relayRequestData.RequestBlock = latestBlock
+			reqBlock = latestBlock
+		}
+	}
+	if reqBlock == spectypes.LATEST_BLOCK && relayRequestData.SeenBlock != 0 {
+		reqBlock = relayRequestData.SeenBlock
+	}
+
+	// collect sessions until there are enough providers, the sessions manager returns up to the wanted concurrency each time
+	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	addon := chainlib.GetAddon(chainMessage)
+	extensions := chainMessage.GetExtensions()
+	sessions := lavasession.ConsumerSessionsMap{}
+	for len(sessions) < quorum.providers {
+		quorumUnwantedProviders := make(map[string]struct{}, len(unwantedProviders)+len(sessions))
+		for providerAddress := range unwantedProviders {
+			quorumUnwantedProviders[providerAddress] = struct{}{}
+		}
+		for providerAddress := range sessions {
+			quorumUnwantedProviders[providerAddress] = struct{}{}
+		}
+		newSessions, err := rpccs.consumerSessionManager.GetSessions(lavasession.WithDappID(ctx, dappID), chainlib.GetComputeUnits(chainMessage), quorumUnwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+		if err != nil {
+			utils.LavaFormatDebug("failed getting sessions for quorum", utils.LogAttr("GUID", ctx), utils.LogAttr("error", err), utils.LogAttr("sessions", len(sessions)))
+			break
+		}
+		for providerAddress, sessionInfo := range newSessions {
+			if len(sessions) < quorum.providers {
+				sessions[providerAddress] = sessionInfo
+				continue
+			}
+			errReport := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session)
+			if errReport != nil {
+				utils.LavaFormatError("failed returning an extra quorum session", errReport, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+			}
+		}
+	}
+	if len(sessions) < quorum.agreement {
+		for providerAddress, sessionInfo := range sessions {
+			errReport := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session)
+			if errReport != nil {
+				utils.LavaFormatError("failed returning a quorum session", errReport, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+			}
+		}
+		return errorRelayResult, utils.LavaFormatError("not enough providers for quorum", QuorumNotReachedError, utils.LogAttr("GUID", ctx), utils.LogAttr("providers", len(sessions)), utils.LogAttr("agreement", quorum.agreement))
+	}
+
+	var sharedStateId string
+	if rpccs.sharedState {
+		sharedStateId = rpccs.consumerConsistency.Key(dappID, consumerIp)
+	}
+	relayTimeout := chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, 0)
+	relaysCtx, cancelRelays := context.WithCancel(context.Background())
+	defer cancelRelays()
+	responses := make(chan *relayResponse, len(sessions))
+	for providerPublicAddress, sessionInfo := range sessions {
+		go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
+			relayResult, err := rpccs.relayToSession(ctx, relaysCtx, providerPublicAddress, sessionInfo, chainMessage, relayRequestData, dappID, consumerIp, sharedStateId, relayTimeout)
+			responses <- &relayResponse{relayResult: relayResult, err: err}
+		}(providerPublicAddress, sessionInfo)
+	}
+	// every relay is bounded by the relay timeout, so we wait for all of them to compare the responses
+	results := map[string][]*common.RelayResult{} // normalized response -> results
+	relayErrors := &RelayErrors{onFailureMergeAll: true}
+	for range sessions {
+		response := <-responses
+		if errorRelayResult.ProviderInfo.ProviderAddress != "" {
+			errorRelayResult.ProviderInfo.ProviderAddress += ","
+		}
+		errorRelayResult.ProviderInfo.ProviderAddress += response.relayResult.ProviderInfo.ProviderAddress
+		if response.err != nil {
+			relayErrors.relayErrors = append(relayErrors.relayErrors, RelayError{err: response.err, ProviderInfo: response.relayResult.ProviderInfo, response: response})
+			continue
+		}
+		normalized := normalizeQuorumResponse(response.relayResult.Reply.Data)
+		results[normalized] = append(results[normalized], response.relayResult)
+	}
+
+	var agreed []*common.RelayResult
+	var agreedResponse string
+	agreements := make([]int, 0, len(results))
+	for normalized, agreeingResults := range results {
+		agreements = append(agreements, len(agreeingResults))
+		if len(agreeingResults) > len(agreed) {
+			agreed = agreeingResults
+			agreedResponse = normalized
+		}
+	}
+	if len(agreed) < quorum.agreement {
+		attributes := []utils.Attribute{utils.LogAttr("GUID", ctx), utils.LogAttr("agreement", quorum.agreement), utils.LogAttr("providers", len(sessions)), utils.LogAttr("agreements", agreements), utils.LogAttr("chain_id", rpccs.listenEndpoint.ChainID)}
+		if len(relayErrors.relayErrors) > 0 {
+			attributes = append(attributes, utils.LogAttr("errors", len(relayErrors.relayErrors)), utils.LogAttr("error", relayErrors.GetBestErrorMessageForUser().err))
+		}
+		return errorRelayResult, utils.LavaFormatError("quorum not reached", QuorumNotReachedError, attributes...)
+	}
+
+	returnedResult := agreed[0]
+	for normalized, disagreeingResults := range results {
+		if normalized == agreedResponse {
+			continue
+		}
+		for _, disagreeingResult := range disagreeingResults {
+			if foundError, _ := chainMessage.CheckResponseError(disagreeingResult.Reply.Data, disagreeingResult.StatusCode); foundError {
+				continue // a node error isn't a conflicting response
+			}
+			if !chainMessage.GetApi().Category.Deterministic || !returnedResult.Finalized || !disagreeingResult.Finalized {
+				// same as data reliability, only finalized deterministic responses can be proven conflicting
+				continue
+			}
+			// new context as the user's context is cancelled when the relay returns
+			conflictCtx := context.Background()
+			if guid, found := utils.GetUniqueIdentifier(ctx); found {
+				conflictCtx = utils.WithUniqueIdentifier(conflictCtx, guid)
+			}
+			go rpccs.reportResponseConflict(conflictCtx, chainMessage, returnedResult, disagreeingResult)
+		}
+	}
+	rpccs.consumerConsistency.SetSeenBlock(returnedResult.Reply.LatestBlock, dappID, consumerIp)
+	utils.LavaFormatDebug("quorum reached", utils.LogAttr("GUID", ctx), utils.LogAttr("agreed", len(agreed)), utils.LogAttr("providers", len(sessions)))
+	return returnedResult, nil
+}
@@ -0,0 +1,121 @@
+package rpcconsumer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuorumConfig(t *testing.T) {
+	_, err := newQuorumConfig(2, 3, []string{"ETH1"}, nil)
+	require.Error(t, err)
+	_, err = newQuorumConfig(MaxQuorumProviders+1, 2, nil, []string{"eth_getBalance"})
+	require.Error(t, err)
+	// without chains or apis the defaults are only validated when a header asks for quorum
+	_, err = newQuorumConfig(0, 0, nil, nil)
+	require.NoError(t, err)
+
+	config, err := newQuorumConfig(3, 2, []string{"ETH1"}, []string{"eth_getBalance"})
+	require.NoError(t, err)
+	getBalance := &mockChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}}
+	blockNumber := &mockChainMessage{api: &spectypes.Api{Name: "eth_blockNumber"}}
+
+	params, enabled, err := config.forRelay("ETH1", blockNumber, map[string]string{})
+	require.NoError(t, err)
+	require.True(t, enabled)
+	require.Equal(t, quorumParams{providers: 3, agreement: 2}, params)
+	_, enabled, err = config.forRelay("LAV1", getBalance, map[string]string{})
+	require.NoError(t, err)
+	require.True(t, enabled)
+	_, enabled, err = config.forRelay("LAV1", blockNumber, map[string]string{})
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	// the directive header enables, disables or overrides the quorum
+	_, enabled, err = config.forRelay("LAV1", blockNumber, map[string]string{common.QUORUM_HEADER_NAME: "true"})
+	require.NoError(t, err)
+	require.True(t, enabled)
+	_, enabled, err = config.forRelay("ETH1", blockNumber, map[string]string{common.QUORUM_HEADER_NAME: "false"})
+	require.NoError(t, err)
+	require.False(t, enabled)
+	params, enabled, err = config.forRelay("LAV1", blockNumber, map[string]string{common.QUORUM_HEADER_NAME: "4/5"})
+	require.NoError(t, err)
+	require.True(t, enabled)
+	require.Equal(t, quorumParams{providers: 5, agreement: 4}, params)
+	for _, invalid := range []string{"5/4", "0/3", "abc", "2/", "2/50"} {
+		_, _, err = config.forRelay("LAV1", blockNumber, map[string]string{common.QUORUM_HEADER_NAME: invalid})
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestNormalizeQuorumResponse(t *testing.T) {
+	require.Equal(t,
+		normalizeQuorumResponse([]byte(`{"jsonrpc":"2.0","id":1,"result":{"b":2,"a":1}}`)),
+		normalizeQuorumResponse([]byte("{\n  \"result\": {\"a\": 1, \"b\": 2},\n  \"id\": 1,\n  \"jsonrpc\": \"2.0\"\n}\n")),
+	)
+	// big numbers are compared exactly
+	require.NotEqual(t,
+		normalizeQuorumResponse([]byte(`{"balance":123456789012345678901234567890}`)),
+		normalizeQuorumResponse([]byte(`{"balance":123456789012345678901234567891}`)),
+	)
+	require.NotEqual(t, normalizeQuorumResponse([]byte(`{"a":1}`)), normalizeQuorumResponse([]byte(`{"a":2}`)))
+	// non json responses are compared as is
+	require.Equal(t, "0x10", normalizeQuorumResponse([]byte(" 0x10\n")))
+}
+
+func quorumReply(result string) func(request *pairingtypes.RelayRequest) (string, error) {
+	return func(request *pairingtypes.RelayRequest) (string, error) {
+		return fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":%q}`, result), nil
+	}
+}
+
+func TestSendQuorumRelay(t *testing.T) {
+	// block 0x10 is finalized, the providers' latest block is 1000
+	const getBlock = `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x10",false]}`
+	quorum := quorumParams{providers: 3, agreement: 2}
+	playbook := []struct {
+		name      string
+		request   string
+		replies   []string
+		reached   bool
+		agreed    string
+		conflicts int
+	}{
+		{name: "all agree", request: getBlock, replies: []string{"0xa", "0xa", "0xa"}, reached: true, agreed: "0xa"},
+		{name: "minority disagrees", request: getBlock, replies: []string{"0xa", "0xa", "0xb"}, reached: true, agreed: "0xa", conflicts: 1},
+		{name: "no quorum", request: getBlock, replies: []string{"0xa", "0xb", "0xc"}},
+		// the responses are only comparable on chain for finalized blocks of deterministic apis
+		{name: "not finalized", request: `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x3e7",false]}`, replies: []string{"0xa", "0xa", "0xb"}, reached: true, agreed: "0xa"},
+		{name: "not deterministic", request: `{"jsonrpc":"2.0","id":1,"method":"eth_getCode","params":["0x1234","0x10"]}`, replies: []string{"0xa", "0xa", "0xb"}, reached: true, agreed: "0xa"},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			setup := newConsumerTestSetup(t)
+			handlers := []func(request *pairingtypes.RelayRequest) (string, error){}
+			for _, reply := range play.replies {
+				handlers = append(handlers, quorumReply(reply))
+			}
+			setup.addProviders(t, handlers...)
+			ctx := context.Background()
+			chainMessage := setup.parseMsg(t, play.request)
+			relayResult, err := setup.rpccs.sendQuorumRelay(ctx, chainMessage, setup.relayData(ctx, chainMessage, play.request), "dapp", "127.0.0.1", map[string]struct{}{}, quorum)
+			for _, provider := range setup.providers {
+				require.Equal(t, int64(1), provider.relays.Load())
+			}
+			if !play.reached {
+				require.True(t, QuorumNotReachedError.Is(err), err)
+			} else {
+				require.NoError(t, err)
+				expected, _ := quorumReply(play.agreed)(nil)
+				require.Equal(t, expected, string(relayResult.Reply.Data))
+			}
+			require.Equal(t, play.conflicts, setup.txSender.reportedConflicts(100*time.Millisecond))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package rpcconsumer
+
+import (
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+)
+
+// relayHedging decides when a relay is sent to another provider while the first ones are still expected to answer
+type relayHedging struct {
+	latencyPercentile float64             // fraction of the expected latency to wait before hedging, 0 disables hedging
+	maxCu             uint64              // apis costing more are not hedged, 0 for no cap
+	apis              map[string]struct{} // when set only these apis are hedged
+}
+
+func newRelayHedging(latencyPercentile float64, maxCu uint64, apis []string) relayHedging {
+	hedging := relayHedging{latencyPercentile: latencyPercentile, maxCu: maxCu}
+	if len(apis) > 0 {
+		hedging.apis = make(map[string]struct{}, len(apis))
+		for _, api := range apis {
+			hedging.apis[api] = struct{}{}
+		}
+	}
+	return hedging
+}
+
+// returns how long to wait for the sessions before hedging the relay, 0 if it shouldn't be hedged
+func (rh relayHedging) hedgeAfter(chainMessage chainlib.ChainMessage, sessions lavasession.ConsumerSessionsMap, relayTimeout time.Duration) time.Duration {
+	if rh.latencyPercentile <= 0 || len(sessions) == 0 {
+		return 0
+	}
+	api := chainMessage.GetApi()
+	if rh.maxCu > 0 && api.ComputeUnits > rh.maxCu {
+		return 0
+	}
+	if rh.apis != nil {
+		if _, ok := rh.apis[api.Name]; !ok {
+			return 0
+		}
+	}
+	if chainlib.IsSubscription(chainMessage) {
+		// a second provider would open a second subscription
+		return 0
+	}
+	if chainlib.GetStateful(chainMessage) == common.CONSISTENCY_SELECT_ALLPROVIDERS {
+		// already sent to all providers
+		return 0
+	}
+	// hedge once the fastest provider is late, the sessions are locked by us until the relays are sent
+	var expectedLatency time.Duration
+	for _, sessionInfo := range sessions {
+		sessionExpectedLatency := sessionInfo.Session.CalculateExpectedLatency(relayTimeout)
+		if expectedLatency == 0 || sessionExpectedLatency < expectedLatency {
+			expectedLatency = sessionExpectedLatency
+		}
+	}
+	return time.Duration(float64(expectedLatency) * rh.latencyPercentile)
+}
@@ -0,0 +1,88 @@
+package rpcconsumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockChainMessage struct {
+	chainlib.ChainMessage
+	api *spectypes.Api
+}
+
+func (mcm *mockChainMessage) GetApi() *spectypes.Api {
+	return mcm.api
+}
+
+func (mcm *mockChainMessage) CheckResponseError(data []byte, httpStatusCode int) (hasError bool, errorMessage string) {
+	return false, ""
+}
+
+func TestRelayHedgingHedgeAfter(t *testing.T) {
+	relayTimeout := 2 * time.Second
+	sessions := lavasession.ConsumerSessionsMap{"lava@provider1": {Session: &lavasession.SingleConsumerSession{}}}
+	chainMessage := &mockChainMessage{api: &spectypes.Api{Name: "eth_call", ComputeUnits: 20}}
+	expectedLatency := sessions["lava@provider1"].Session.CalculateExpectedLatency(relayTimeout)
+
+	require.Zero(t, newRelayHedging(0, 0, nil).hedgeAfter(chainMessage, sessions, relayTimeout))
+	require.Equal(t, expectedLatency/2, newRelayHedging(0.5, 0, nil).hedgeAfter(chainMessage, sessions, relayTimeout))
+	require.Zero(t, newRelayHedging(0.5, 0, nil).hedgeAfter(chainMessage, lavasession.ConsumerSessionsMap{}, relayTimeout))
+	// cu cap and api filter
+	require.Zero(t, newRelayHedging(0.5, 10, nil).hedgeAfter(chainMessage, sessions, relayTimeout))
+	require.Equal(t, expectedLatency/2, newRelayHedging(0.5, 20, nil).hedgeAfter(chainMessage, sessions, relayTimeout))
+	require.Zero(t, newRelayHedging(0.5, 0, []string{"eth_blockNumber"}).hedgeAfter(chainMessage, sessions, relayTimeout))
+	require.Equal(t, expectedLatency/2, newRelayHedging(0.5, 0, []string{"eth_blockNumber", "eth_call"}).hedgeAfter(chainMessage, sessions, relayTimeout))
+	// subscriptions aren't hedged
+	chainMessage.api.Category.Subscription = true
+	require.Zero(t, newRelayHedging(0.5, 0, nil).hedgeAfter(chainMessage, sessions, relayTimeout))
+	chainMessage.api.Category.Subscription = false
+	// relays sent to all providers aren't hedged
+	chainMessage.api.Category.Stateful = common.CONSISTENCY_SELECT_ALLPROVIDERS
+	require.Zero(t, newRelayHedging(0.5, 0, nil).hedgeAfter(chainMessage, sessions, relayTimeout))
+}
+
+func TestGetBestResultHedging(t *testing.T) {
+	rpccs := &RPCConsumerServer{}
+	chainMessage := &mockChainMessage{api: &spectypes.Api{Name: "eth_call", ComputeUnits: 20}}
+	newResponse := func(provider string) *relayResponse {
+		return &relayResponse{relayResult: &common.RelayResult{Reply: &pairingtypes.RelayReply{}, ProviderInfo: common.ProviderInfo{ProviderAddress: provider}}}
+	}
+
+	// the first provider doesn't answer in time, the hedged provider does
+	responses := make(chan *relayResponse, 2)
+	hedges := 0
+	hedge := func() int {
+		hedges++
+		responses <- newResponse("lava@hedged")
+		return 1
+	}
+	start := time.Now()
+	response := rpccs.getBestResult(time.Second, responses, 1, chainMessage, 10*time.Millisecond, hedge)
+	require.Equal(t, "lava@hedged", response.relayResult.ProviderInfo.ProviderAddress)
+	require.Equal(t, 1, hedges)
+	require.Less(t, time.Since(start), time.Second)
+
+	// the first provider answers before the hedge
+	responses = make(chan *relayResponse, 2)
+	hedges = 0
+	responses <- newResponse("lava@first")
+	response = rpccs.getBestResult(time.Second, responses, 1, chainMessage, 10*time.Millisecond, hedge)
+	require.Equal(t, "lava@first", response.relayResult.ProviderInfo.ProviderAddress)
+	require.Zero(t, hedges)
+
+	// no session for the hedge, keeps waiting for the first provider
+	responses = make(chan *relayResponse, 2)
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		responses <- newResponse("lava@first")
+	}()
+	response = rpccs.getBestResult(time.Second, responses, 1, chainMessage, 10*time.Millisecond, func() int { return 0 })
+	require.Equal(t, "lava@first", response.relayResult.ProviderInfo.ProviderAddress)
+}
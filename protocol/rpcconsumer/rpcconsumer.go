@@ -43,6 +43,7 @@ const (
 	refererBackendAddressFlagName = "referer-be-address"
 	refererMarkerFlagName         = "referer-marker"
 	reportsSendBEAddress          = "reports-be-address"
+	StrategiesConfigName          = "strategies"
 )
 
 var (
@@ -53,32 +54,37 @@ var (
 
 type strategyValue struct {
 	provideroptimizer.Strategy
-}
-
-var strategyNames = []string{
-	"balanced",
-	"latency",
-	"sync-freshness",
-	"cost",
-	"privacy",
-	"accuracy",
-	"distributed",
+	name string
 }
 
 var strategyFlag strategyValue = strategyValue{Strategy: provideroptimizer.STRATEGY_BALANCED}
 
 func (s *strategyValue) String() string {
-	return strategyNames[int(s.Strategy)]
+	if s.name != "" {
+		return s.name
+	}
+	return s.Strategy.String()
 }
 
+// Set accepts unknown names since custom strategies are registered only after the config file is read, they are validated in resolve
 func (s *strategyValue) Set(str string) error {
-	for i, name := range strategyNames {
-		if strings.EqualFold(str, name) {
-			s.Strategy = provideroptimizer.Strategy(i)
-			return nil
-		}
+	s.name = str
+	if strategy, err := provideroptimizer.ParseStrategy(str); err == nil {
+		s.Strategy = strategy
 	}
-	return fmt.Errorf("invalid strategy: %s", str)
+	return nil
+}
+
+func (s *strategyValue) resolve() error {
+	if s.name == "" {
+		return nil
+	}
+	strategy, err := provideroptimizer.ParseStrategy(s.name)
+	if err != nil {
+		return err
+	}
+	s.Strategy = strategy
+	return nil
 }
 
 func (s *strategyValue) Type() string {
@@ -170,6 +176,11 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 		chainMutexes[endpoint.ChainID] = &sync.Mutex{} // create a mutex per chain for shared resources
 	}
 	var optimizers sync.Map
+	var optimizersSnapshots *provideroptimizer.SnapshotStore
+	if options.cmdFlags.OptimizerSnapshotPath != "" {
+		optimizersSnapshots = provideroptimizer.NewSnapshotStore(options.cmdFlags.OptimizerSnapshotPath)
+	}
+	optimizerIntrospection := NewOptimizerIntrospection()
 	var consumerConsistencies sync.Map
 	var finalizationConsensuses sync.Map
 	var wg sync.WaitGroup
@@ -217,6 +228,17 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 				return err
 			}
 
+			strategy := options.strategy
+			if rpcEndpoint.Strategy != "" {
+				strategy, err = provideroptimizer.ParseStrategy(rpcEndpoint.Strategy)
+				if err != nil {
+					err = utils.LavaFormatError("failed parsing endpoint strategy", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
+					errCh <- err
+					return err
+				}
+			}
+			// endpoints of the same chain share an optimizer as long as they use the same strategy
+			optimizerKey := chainID + "-" + strategy.String()
 			_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
 			var optimizer *provideroptimizer.ProviderOptimizer
 			var consumerConsistency *ConsumerConsistency
@@ -225,12 +247,12 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 				// this is locked so we don't race optimizers creation
 				chainMutexes[chainID].Lock()
 				defer chainMutexes[chainID].Unlock()
-				value, exists := optimizers.Load(chainID)
+				value, exists := optimizers.Load(optimizerKey)
 				if !exists {
 					// doesn't exist for this chain create a new one
 					baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
-					optimizer = provideroptimizer.NewProviderOptimizer(options.strategy, averageBlockTime, baseLatency, options.maxConcurrentProviders)
-					optimizers.Store(chainID, optimizer)
+					optimizer = provideroptimizer.NewProviderOptimizer(strategy, averageBlockTime, baseLatency, options.maxConcurrentProviders)
+					optimizers.Store(optimizerKey, optimizer)
 				} else {
 					var ok bool
 					optimizer, ok = value.(*provideroptimizer.ProviderOptimizer)
@@ -280,6 +302,12 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 				return err
 			}
 
+			if optimizersSnapshots != nil {
+				// must be registered before the pairing updates so restored scores of paired providers are applied
+				optimizersSnapshots.Register(rpcEndpoint.Key(), optimizer)
+			}
+			optimizerIntrospection.Register(rpcEndpoint, optimizer)
+
 			// Register For Updates
 			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, consumerMetricsManager, consumerReportsManager)
 			rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
@@ -321,10 +349,25 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 	}
 
 	utils.LavaFormatInfo("RPCConsumer done setting up all endpoints, ready for requests")
+	if optimizersSnapshots != nil {
+		go optimizersSnapshots.Start(ctx, options.cmdFlags.OptimizerSnapshotInterval)
+	}
+	if options.cmdFlags.OptimizerDebugAddress != metrics.DisabledFlagOption {
+		go optimizerIntrospection.Serve(ctx, options.cmdFlags.OptimizerDebugAddress)
+	}
+	if consumerMetricsManager != nil {
+		go optimizerIntrospection.UpdateMetrics(ctx, consumerMetricsManager, OptimizerMetricsInterval)
+	}
 
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
 	<-signalChan
+	if optimizersSnapshots != nil {
+		err = optimizersSnapshots.Save()
+		if err != nil {
+			utils.LavaFormatWarning("failed saving optimizer snapshot on shutdown", err)
+		}
+	}
 	return nil
 }
 
@@ -342,6 +385,23 @@ func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints
 	return
 }
 
+// RegisterStrategies registers the custom provider selection strategies defined in the config, so endpoints and the strategy flag can use them
+func RegisterStrategies(viperConfig *viper.Viper) error {
+	var strategyConfigs []provideroptimizer.StrategyConfig
+	err := viperConfig.UnmarshalKey(StrategiesConfigName, &strategyConfigs)
+	if err != nil {
+		return err
+	}
+	for _, strategyConfig := range strategyConfigs {
+		_, err = provideroptimizer.RegisterStrategyFromConfig(strategyConfig)
+		if err != nil {
+			return err
+		}
+		utils.LavaFormatInfo("registered custom selection strategy", utils.Attribute{Key: "strategy", Value: strategyConfig.Name}, utils.Attribute{Key: "base", Value: strategyConfig.Base})
+	}
+	return nil
+}
+
 func CreateRPCConsumerCobraCommand() *cobra.Command {
 	cmdRPCConsumer := &cobra.Command{
 		Use:   "rpcconsumer [config-file] | { {listen-ip:listen-port spec-chain-id api-interface} ... }",
@@ -428,6 +488,22 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			if err != nil || len(rpcEndpoints) == 0 {
 				return utils.LavaFormatError("invalid endpoints definition", err)
 			}
+			err = RegisterStrategies(viper.GetViper())
+			if err != nil {
+				return utils.LavaFormatError("invalid strategies definition", err)
+			}
+			err = strategyFlag.resolve()
+			if err != nil {
+				return utils.LavaFormatError("invalid strategy flag", err)
+			}
+			for _, rpcEndpoint := range rpcEndpoints {
+				if rpcEndpoint.Strategy == "" {
+					continue
+				}
+				if _, err = provideroptimizer.ParseStrategy(rpcEndpoint.Strategy); err != nil {
+					return utils.LavaFormatError("invalid endpoint strategy", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint.String()})
+				}
+			}
 			// handle flags, pass necessary fields
 			ctx := context.Background()
 
@@ -527,6 +603,20 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 				RelaysHealthIntervalFlag:    viper.GetDuration(common.RelayHealthIntervalFlag),
 				DebugRelays:                 viper.GetBool(DebugRelaysFlagName),
 				DisableConflictTransactions: viper.GetBool(common.DisableConflictTransactionsFlag),
+				OptimizerSnapshotPath:       viper.GetString(common.OptimizerSnapshotPathFlag),
+				OptimizerSnapshotInterval:   viper.GetDuration(common.OptimizerSnapshotIntervalFlag),
+				OptimizerDebugAddress:       viper.GetString(common.OptimizerDebugAddressFlag),
+				HedgeLatencyPercentile:      viper.GetFloat64(common.HedgeLatencyPercentileFlag),
+				HedgeMaxCu:                  viper.GetUint64(common.HedgeMaxCuFlag),
+				HedgeApis:                   viper.GetStringSlice(common.HedgeApisFlag),
+				QuorumProviders:             viper.GetInt(common.QuorumProvidersFlag),
+				QuorumAgreement:             viper.GetInt(common.QuorumAgreementFlag),
+				QuorumChains:                viper.GetStringSlice(common.QuorumChainsFlag),
+				QuorumApis:                  viper.GetStringSlice(common.QuorumApisFlag),
+				SplitBatches:                viper.GetBool(common.SplitBatchesFlag),
+				BatchSplitSize:              viper.GetInt(common.BatchSplitSizeFlag),
+				BatchMaxItems:               viper.GetInt(common.BatchMaxItemsFlag),
+				BatchParallelRelays:         viper.GetInt(common.BatchParallelRelaysFlag),
 			}
 
 			rpcConsumerSharedState := viper.GetBool(common.SharedStateFlag)
@@ -546,7 +636,7 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().Bool(common.TestModeFlagName, false, "test mode causes rpcconsumer to send dummy data and print all of the metadata in it's listeners")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
-	cmdRPCConsumer.Flags().Var(&strategyFlag, "strategy", fmt.Sprintf("the strategy to use to pick providers (%s)", strings.Join(strategyNames, "|")))
+	cmdRPCConsumer.Flags().Var(&strategyFlag, "strategy", fmt.Sprintf("the strategy to use to pick providers (%s), or a custom strategy defined in the config under %s", strings.Join(provideroptimizer.StrategyNames(), "|"), StrategiesConfigName))
 	cmdRPCConsumer.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
 	cmdRPCConsumer.Flags().String(metrics.RelayServerFlagName, metrics.DisabledFlagOption, "the http address of the relay usage server api endpoint (example http://127.0.0.1:8080)")
 	cmdRPCConsumer.Flags().Bool(DebugRelaysFlagName, false, "adding debug information to relays")
@@ -560,6 +650,20 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	// Relays health check related flags
 	cmdRPCConsumer.Flags().Bool(common.RelaysHealthEnableFlag, RelaysHealthEnableFlagDefault, "enables relays health check")
 	cmdRPCConsumer.Flags().Duration(common.RelayHealthIntervalFlag, RelayHealthIntervalFlagDefault, "interval between relay health checks")
+	cmdRPCConsumer.Flags().String(common.OptimizerSnapshotPathFlag, "", "file to persist the provider optimizer scores in, they are restored on startup for the providers still in pairing (empty disables it)")
+	cmdRPCConsumer.Flags().Duration(common.OptimizerSnapshotIntervalFlag, provideroptimizer.DEFAULT_SNAPSHOT_INTERVAL, "interval between provider optimizer snapshots")
+	cmdRPCConsumer.Flags().Float64(common.HedgeLatencyPercentileFlag, 0, "send a relay to another provider as well if the first one didn't answer within this fraction of its expected latency, the first valid reply is used (0 disables hedging)")
+	cmdRPCConsumer.Flags().Uint64(common.HedgeMaxCuFlag, 0, "don't hedge relays of apis costing more than this cu (0 for no cap)")
+	cmdRPCConsumer.Flags().StringSlice(common.HedgeApisFlag, []string{}, "hedge only relays of these api names, comma separated (empty hedges all apis)")
+	cmdRPCConsumer.Flags().Int(common.QuorumProvidersFlag, DefaultQuorumProviders, "number of providers a quorum relay is sent to")
+	cmdRPCConsumer.Flags().Int(common.QuorumAgreementFlag, DefaultQuorumAgreement, "number of providers that must agree on the response of a quorum relay")
+	cmdRPCConsumer.Flags().StringSlice(common.QuorumChainsFlag, []string{}, "chain ids whose relays are all sent in quorum mode, comma separated (relays can also ask for it with the "+common.QUORUM_HEADER_NAME+" header)")
+	cmdRPCConsumer.Flags().StringSlice(common.QuorumApisFlag, []string{}, "api names that are sent in quorum mode on any chain, comma separated")
+	cmdRPCConsumer.Flags().Bool(common.SplitBatchesFlag, false, "relay the items of json-rpc batches separately, so they are served from the cache and by providers of their addon, and an item failing doesn't fail the batch (relays can also ask for it with the "+common.SPLIT_BATCH_HEADER_NAME+" header)")
+	cmdRPCConsumer.Flags().Int(common.BatchSplitSizeFlag, DefaultBatchSplitSize, "when splitting json-rpc batches, send the uncached items sharing an addon and extensions in sub-batches of up to this many items (1 relays every item on its own)")
+	cmdRPCConsumer.Flags().Int(common.BatchMaxItemsFlag, DefaultBatchMaxItems, "when splitting json-rpc batches, reject batches with more items than this")
+	cmdRPCConsumer.Flags().Int(common.BatchParallelRelaysFlag, DefaultBatchParallelRelays, "when splitting json-rpc batches, the max relays of a batch sent at the same time")
+	cmdRPCConsumer.Flags().String(common.OptimizerDebugAddressFlag, metrics.DisabledFlagOption, "the address to expose the provider optimizer introspection on, explaining the provider choices (such as localhost:7780)")
 	cmdRPCConsumer.Flags().String(refererBackendAddressFlagName, "", "address to send referer to")
 	cmdRPCConsumer.Flags().String(refererMarkerFlagName, "lava-referer-", "the string marker to identify referer")
 	cmdRPCConsumer.Flags().String(reportsSendBEAddress, "", "address to send reports to")
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -53,6 +55,9 @@ type RPCConsumerServer struct {
 	relaysMonitor          *metrics.RelaysMonitor
 	reporter               metrics.Reporter
 	debugRelays            bool
+	hedging                relayHedging
+	quorum                 quorumConfig
+	batchSplitting         batchSplitting
 }
 
 type relayResponse struct {
@@ -99,6 +104,12 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpccs.sharedState = sharedState
 	rpccs.reporter = reporter
 	rpccs.debugRelays = cmdFlags.DebugRelays
+	rpccs.hedging = newRelayHedging(cmdFlags.HedgeLatencyPercentile, cmdFlags.HedgeMaxCu, cmdFlags.HedgeApis)
+	rpccs.quorum, err = newQuorumConfig(cmdFlags.QuorumProviders, cmdFlags.QuorumAgreement, cmdFlags.QuorumChains, cmdFlags.QuorumApis)
+	if err != nil {
+		return err
+	}
+	rpccs.batchSplitting = newBatchSplitting(cmdFlags.SplitBatches, cmdFlags.BatchSplitSize, cmdFlags.BatchMaxItems, cmdFlags.BatchParallelRelays)
 	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, rpccs, rpcConsumerLogs, chainParser, refererData)
 	if err != nil {
 		return err
@@ -269,16 +280,29 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// remove lava directive headers
 	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
 	relaySentTime := time.Now()
+	if batchItems, ok := rpccs.batchSplitting.split(rpccs.listenEndpoint.ApiInterface, directiveHeaders, []byte(req)); ok {
+		return rpccs.sendSplitBatchRelay(ctx, url, batchItems, connectionType, dappID, consumerIp, analytics, metadata, directiveHeaders)
+	}
 	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType, metadata, rpccs.getExtensionsFromDirectiveHeaders(directiveHeaders))
 	if err != nil {
 		return nil, err
 	}
-	// temporarily disable subscriptions
-	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are not supported at the moment", nil)
-	}
+	return rpccs.sendParsedRelay(ctx, chainMessage, url, req, connectionType, dappID, consumerIp, analytics, directiveHeaders, relaySentTime)
+}
 
+// sendParsedRelay sends a relay of a request that was already parsed into a chain message
+func (rpccs *RPCConsumerServer) sendParsedRelay(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	url string,
+	req string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	directiveHeaders map[string]string,
+	relaySentTime time.Time,
+) (relayResult *common.RelayResult, errRet error) {
 	rpccs.HandleDirectiveHeadersForMessage(chainMessage, directiveHeaders)
 	// do this in a loop with retry attempts, configurable via a flag, limited by the number of providers in CSM
 	reqBlock, _ := chainMessage.RequestedBlock()
@@ -296,6 +320,38 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	timeouts := 0
 	unwantedProviders := rpccs.GetInitialUnwantedProviders(directiveHeaders)
 
+	if chainlib.IsSubscription(chainMessage) {
+		relayResult, err := rpccs.sendSubscriptionRelay(ctx, chainMessage, relayRequestData, dappID, unwantedProviders)
+		rpccs.appendHeadersToRelayResult(ctx, relayResult, 0)
+		if err != nil {
+			return relayResult, err
+		}
+		if analytics != nil {
+			analytics.Latency = time.Since(relaySentTime).Milliseconds()
+			analytics.ComputeUnits = chainMessage.GetApi().ComputeUnits
+		}
+		rpccs.relaysMonitor.LogRelay()
+		return relayResult, nil
+	}
+
+	quorum, quorumEnabled, err := rpccs.quorum.forRelay(rpccs.listenEndpoint.ChainID, chainMessage, directiveHeaders)
+	if err != nil {
+		return errorRelayResult, err
+	}
+	if quorumEnabled {
+		relayResult, err := rpccs.sendQuorumRelay(ctx, chainMessage, relayRequestData, dappID, consumerIp, unwantedProviders, quorum)
+		rpccs.appendHeadersToRelayResult(ctx, relayResult, 0)
+		if err != nil {
+			return relayResult, err
+		}
+		if analytics != nil {
+			analytics.Latency = time.Since(relaySentTime).Milliseconds()
+			analytics.ComputeUnits = chainMessage.GetApi().ComputeUnits
+		}
+		rpccs.relaysMonitor.LogRelay()
+		return relayResult, nil
+	}
+
 	for ; retries < MaxRelayRetries; retries++ {
 		// TODO: make this async between different providers
 		relayResult, err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, &unwantedProviders, timeouts)
@@ -353,6 +409,10 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	}
 
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
+	if enabled && rpccs.consumerSessionManager.CrossCheck() {
+		// the selection strategy wants every response verified against another provider
+		dataReliabilityThreshold = math.MaxUint32
+	}
 	if enabled {
 		for _, relayResult := range relayResults {
 			// new context is needed for data reliability as some clients cancel the context they provide when the relay returns
@@ -420,11 +480,9 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// handle QoS updates
 	// in case connection totally fails, update unresponsive providers in ConsumerSessionManager
 
-	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		// temporarily disable subscriptions
-		// TODO: fix subscription and disable this case.
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are disabled currently", nil)
+	if chainlib.IsSubscription(chainMessage) {
+		// subscriptions are held by a single provider and resumed through sendSubscriptionRelay
+		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("subscriptions can't be sent as a relay", nil, utils.LogAttr("GUID", ctx))
 	}
 
 	var sharedStateId string // defaults to "", if shared state is disabled then no shared state will be used.
@@ -432,23 +490,128 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 		sharedStateId = rpccs.consumerConsistency.Key(dappID, consumerIp) // use same key as we use for consistency, (for better consistency :-D)
 	}
 
-	privKey := rpccs.privKey
-	chainID := rpccs.listenEndpoint.ChainID
-	lavaChainID := rpccs.lavaChainID
-
 	// Get Session. we get session here so we can use the epoch in the callbacks
 	reqBlock, _ := chainMessage.RequestedBlock()
 
 	// try using cache before sending relay
-	var cacheError error
+	if cachedResult, found := rpccs.getCachedRelayResult(ctx, chainMessage, relayRequestData, dappID, consumerIp, sharedStateId); found {
+		return cachedResult, nil
+	}
+
+	if reqBlock == spectypes.LATEST_BLOCK && relayRequestData.SeenBlock != 0 {
+		// make optimizer select a provider that is likely to have the latest seen block
+		reqBlock = relayRequestData.SeenBlock
+	}
+	// consumerEmergencyTracker always use latest virtual epoch
+	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	addon := chainlib.GetAddon(chainMessage)
+	extensions := chainMessage.GetExtensions()
+
+	sessions, err := rpccs.consumerSessionManager.GetSessions(lavasession.WithDappID(ctx, dappID), chainlib.GetComputeUnits(chainMessage), *unwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+	if err != nil {
+		if lavasession.PairingListEmptyError.Is(err) && (addon != "" || len(extensions) > 0) {
+			// if we have no providers for a specific addon or extension, return an indicative error
+			err = utils.LavaFormatError("No Providers For Addon Or Extension", err, utils.LogAttr("addon", addon), utils.LogAttr("extensions", extensions))
+		}
+		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, err
+	}
+
+	// Make a channel for all providers to send responses, with room for a hedged session
+	responses := make(chan *relayResponse, len(sessions)+1)
+	// relays are cancelled once a hedged relay has a response so the slower providers give back their cu,
+	// without hedging all responses are used for the provider scores
+	hedged := false
+	relaysCtx, cancelRelays := context.WithCancel(context.Background())
+	var relaysWg sync.WaitGroup
+	defer func() {
+		if hedged {
+			cancelRelays()
+			return
+		}
+		// the remaining relays keep running for the scores, their context is released once they're all done
+		go func() {
+			relaysWg.Wait()
+			cancelRelays()
+		}()
+	}()
+
+	relayTimeout := chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, timeouts)
+	hedgeAfter := rpccs.hedging.hedgeAfter(chainMessage, sessions, relayTimeout)
+	sendToSessions := func(sessions lavasession.ConsumerSessionsMap) {
+		// Iterate over the sessions map
+		for providerPublicAddress, sessionInfo := range sessions {
+			// Launch a separate goroutine for each session
+			relaysWg.Add(1)
+			go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
+				defer relaysWg.Done()
+				relayResult, err := rpccs.relayToSession(ctx, relaysCtx, providerPublicAddress, sessionInfo, chainMessage, relayRequestData, dappID, consumerIp, sharedStateId, relayTimeout)
+				responses <- &relayResponse{relayResult: relayResult, err: err}
+			}(providerPublicAddress, sessionInfo)
+		}
+	}
+	sendToSessions(sessions)
+	// sends the relay to the next optimizer choice when none of the providers answered in time, returns the number of sessions added
+	hedge := func() int {
+		hedgeUnwantedProviders := make(map[string]struct{}, len(*unwantedProviders)+len(sessions))
+		for providerAddress := range *unwantedProviders {
+			hedgeUnwantedProviders[providerAddress] = struct{}{}
+		}
+		for providerAddress := range sessions {
+			hedgeUnwantedProviders[providerAddress] = struct{}{}
+		}
+		hedgeSessions, err := rpccs.consumerSessionManager.GetSessions(lavasession.WithDappID(ctx, dappID), chainlib.GetComputeUnits(chainMessage), hedgeUnwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+		if err != nil {
+			utils.LavaFormatDebug("failed getting a session for a hedged relay", utils.LogAttr("GUID", ctx), utils.LogAttr("error", err))
+			return 0
+		}
+		// a single session is hedged, the rest are returned
+		hedgeSession := lavasession.ConsumerSessionsMap{}
+		for providerAddress, sessionInfo := range hedgeSessions {
+			if len(hedgeSession) == 0 {
+				hedgeSession[providerAddress] = sessionInfo
+				continue
+			}
+			errReport := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session)
+			if errReport != nil {
+				utils.LavaFormatError("failed returning an extra hedged session", errReport, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+			}
+		}
+		utils.LavaFormatDebug("hedging relay", utils.LogAttr("GUID", ctx), utils.LogAttr("after", hedgeAfter), utils.LogAttr("sessions", len(sessions)))
+		hedged = true
+		sendToSessions(hedgeSession)
+		return len(hedgeSession)
+	}
+
+	// Getting the best result from the providers,
+	// if there was an error we wait for the next result util timeout or a valid response
+	// priority order {valid response -> error response -> relay error}
+	// if there were multiple error responses picking the majority
+	response := rpccs.getBestResult(relayTimeout, responses, len(sessions), chainMessage, hedgeAfter, hedge)
+
+	if response == nil {
+		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Received unexpected nil response from getBestResult", nil, utils.LogAttr("sessions", sessions), utils.LogAttr("chainMessage", chainMessage))
+	}
+
+	if response.err == nil && response.relayResult != nil && response.relayResult.Reply != nil {
+		// no error, update the seen block
+		blockSeen := response.relayResult.Reply.LatestBlock
+		rpccs.consumerConsistency.SetSeenBlock(blockSeen, dappID, consumerIp)
+	}
+
+	return response.relayResult, response.err
+}
+
+// getCachedRelayResult returns the cached reply of the relay, found is false on a miss or when the relay can't use the cache
+func (rpccs *RPCConsumerServer) getCachedRelayResult(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, dappID string, consumerIp string, sharedStateId string) (relayResult *common.RelayResult, found bool) {
+	chainID := rpccs.listenEndpoint.ChainID
+	reqBlock, _ := chainMessage.RequestedBlock()
 	if reqBlock != spectypes.NOT_APPLICABLE || !chainMessage.GetForceCacheRefresh() {
-		var cacheReply *pairingtypes.CacheRelayReply
 		hashKey, outputFormatter, err := chainlib.HashCacheRequest(relayRequestData, chainID)
 		if err != nil {
 			utils.LavaFormatError("sendRelayToProvider Failed getting Hash for cache request", err)
 		} else {
 			cacheCtx, cancel := context.WithTimeout(ctx, common.CacheTimeout)
-			cacheReply, cacheError = rpccs.cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{
+			cacheReply, cacheError := rpccs.cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{
 				RequestHash:    hashKey,
 				RequestedBlock: relayRequestData.RequestBlock,
 				ChainId:        chainID,
@@ -476,7 +639,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 				// Info was fetched from cache, so we don't need to change the state
 				// so we can return here, no need to update anything and calculate as this info was fetched from the cache
 				reply.Data = outputFormatter(reply.Data)
-				relayResult = &common.RelayResult{
+				relayResult := &common.RelayResult{
 					Reply: reply,
 					Request: &pairingtypes.RelayRequest{
 						RelayData: relayRequestData,
@@ -484,7 +647,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 					Finalized:    false, // set false to skip data reliability
 					ProviderInfo: common.ProviderInfo{ProviderAddress: ""},
 				}
-				return relayResult, nil
+				return relayResult, true
 			}
 			// cache failed, move on to regular relay
 			if performance.NotConnectedError.Is(cacheError) {
@@ -494,199 +657,169 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	} else {
 		utils.LavaFormatDebug("skipping cache due to requested block being NOT_APPLICABLE", utils.Attribute{Key: "api name", Value: chainMessage.GetApi().Name})
 	}
+	return nil, false
+}
 
-	if reqBlock == spectypes.LATEST_BLOCK && relayRequestData.SeenBlock != 0 {
-		// make optimizer select a provider that is likely to have the latest seen block
-		reqBlock = relayRequestData.SeenBlock
+// relays to the provider of a session and releases the session, relaysCtx is cancelled when the relay is no longer needed
+func (rpccs *RPCConsumerServer) relayToSession(
+	ctx context.Context,
+	relaysCtx context.Context,
+	providerPublicAddress string,
+	sessionInfo *lavasession.SessionInfo,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	sharedStateId string,
+	relayTimeout time.Duration,
+) (localRelayResult *common.RelayResult, errResponse error) {
+	privKey := rpccs.privKey
+	chainID := rpccs.listenEndpoint.ChainID
+	lavaChainID := rpccs.lavaChainID
+	localRelayResult = &common.RelayResult{
+		ProviderInfo: common.ProviderInfo{ProviderAddress: providerPublicAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
+		Finalized:    false,
+		// setting the single consumer session as the conflict handler.
+		//  to be able to validate if we need to report this provider or not.
+		// holding the pointer is ok because the session is locked atm,
+		// and later after its unlocked we only atomic read / write to it.
+		ConflictHandler: sessionInfo.Session.Parent,
 	}
-	// consumerEmergencyTracker always use latest virtual epoch
-	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
-	addon := chainlib.GetAddon(chainMessage)
-	extensions := chainMessage.GetExtensions()
-
-	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), *unwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
-	if err != nil {
-		if lavasession.PairingListEmptyError.Is(err) && (addon != "" || len(extensions) > 0) {
-			// if we have no providers for a specific addon or extension, return an indicative error
-			err = utils.LavaFormatError("No Providers For Addon Or Extension", err, utils.LogAttr("addon", addon), utils.LogAttr("extensions", extensions))
-		}
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, err
+	goroutineCtx, goroutineCtxCancel := context.WithCancel(relaysCtx)
+	guid, found := utils.GetUniqueIdentifier(ctx)
+	if found {
+		goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
 	}
+	defer goroutineCtxCancel()
 
-	// Make a channel for all providers to send responses
-	responses := make(chan *relayResponse, len(sessions))
-
-	relayTimeout := chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, timeouts)
-	// Iterate over the sessions map
-	for providerPublicAddress, sessionInfo := range sessions {
-		// Launch a separate goroutine for each session
-		go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
-			localRelayResult := &common.RelayResult{
-				ProviderInfo: common.ProviderInfo{ProviderAddress: providerPublicAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
-				Finalized:    false,
-				// setting the single consumer session as the conflict handler.
-				//  to be able to validate if we need to report this provider or not.
-				// holding the pointer is ok because the session is locked atm,
-				// and later after its unlocked we only atomic read / write to it.
-				ConflictHandler: sessionInfo.Session.Parent,
-			}
-			var errResponse error
-			goroutineCtx, goroutineCtxCancel := context.WithCancel(context.Background())
-			guid, found := utils.GetUniqueIdentifier(ctx)
-			if found {
-				goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
-			}
-			defer func() {
-				// Return response
-				responses <- &relayResponse{
-					relayResult: localRelayResult,
-					err:         errResponse,
-				}
-				// Close context
-				goroutineCtxCancel()
-			}()
-
-			localRelayRequestData := *relayRequestData
+	localRelayRequestData := *relayRequestData
 
-			// Extract fields from the sessionInfo
-			singleConsumerSession := sessionInfo.Session
-			epoch := sessionInfo.Epoch
-			reportedProviders := sessionInfo.ReportedProviders
+	// Extract fields from the sessionInfo
+	singleConsumerSession := sessionInfo.Session
+	epoch := sessionInfo.Epoch
+	reportedProviders := sessionInfo.ReportedProviders
 
-			relayRequest, errResponse := lavaprotocol.ConstructRelayRequest(goroutineCtx, privKey, lavaChainID, chainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
-			if errResponse != nil {
-				utils.LavaFormatError("Failed ConstructRelayRequest", errResponse, utils.LogAttr("Request data", localRelayRequestData))
-				return
+	relayRequest, errResponse := lavaprotocol.ConstructRelayRequest(goroutineCtx, privKey, lavaChainID, chainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
+	if errResponse != nil {
+		utils.LavaFormatError("Failed ConstructRelayRequest", errResponse, utils.LogAttr("Request data", localRelayRequestData))
+		return
+	}
+	localRelayResult.Request = relayRequest
+
+	// unique per dappId and ip
+	consumerToken := common.GetUniqueToken(dappID, consumerIp)
+	relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, relayTimeout, chainMessage, consumerToken)
+	if errResponse != nil && relaysCtx.Err() != nil {
+		// the relay is no longer needed (e.g. another provider won the hedge), this provider isn't at fault so the session is returned unused
+		errReport := rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
+		if errReport != nil {
+			utils.LavaFormatError("failed relay onSessionUnUsed errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "original error", Value: errResponse.Error()})
+		}
+		return
+	}
+	if errResponse != nil {
+		failRelaySession := func(origErr error, backoff_ bool) {
+			backOffDuration := 0 * time.Second
+			if backoff_ {
+				backOffDuration = lavasession.BACKOFF_TIME_ON_FAILURE
 			}
-			localRelayResult.Request = relayRequest
-			endpointClient := *singleConsumerSession.Endpoint.Client
-
-			if isSubscription {
-				errResponse = rpccs.relaySubscriptionInner(goroutineCtx, endpointClient, singleConsumerSession, localRelayResult)
-				if errResponse != nil {
-					utils.LavaFormatError("Failed relaySubscriptionInner", errResponse, utils.LogAttr("Request data", localRelayRequestData))
-					return
-				}
+			time.Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
+			// relay failed need to fail the session advancement
+			errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, origErr)
+			if errReport != nil {
+				utils.LavaFormatError("failed relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "original error", Value: origErr.Error()})
 			}
+		}
+		go failRelaySession(errResponse, backoff)
+		return
+	}
 
-			// unique per dappId and ip
-			consumerToken := common.GetUniqueToken(dappID, consumerIp)
-			relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, relayTimeout, chainMessage, consumerToken)
-			if errResponse != nil {
-				failRelaySession := func(origErr error, backoff_ bool) {
-					backOffDuration := 0 * time.Second
-					if backoff_ {
-						backOffDuration = lavasession.BACKOFF_TIME_ON_FAILURE
-					}
-					time.Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
-					// relay failed need to fail the session advancement
-					errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, origErr)
-					if errReport != nil {
-						utils.LavaFormatError("failed relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "original error", Value: origErr.Error()})
-					}
-				}
-				go failRelaySession(errResponse, backoff)
-				return
-			}
+	// get here only if performed a regular relay successfully
+	expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
+	pairingAddressesLen := rpccs.consumerSessionManager.GetAtomicPairingAddressesLength()
+	latestBlock := localRelayResult.Reply.LatestBlock
+	if expectedBH-latestBlock > 1000 {
+		utils.LavaFormatWarning("identified block gap", nil,
+			utils.Attribute{Key: "expectedBH", Value: expectedBH},
+			utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
+			utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
+			utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
+			utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
+			utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
+		)
+	}
+	if rpccs.debugRelays && singleConsumerSession.QoSInfo.LastQoSReport != nil &&
+		singleConsumerSession.QoSInfo.LastQoSReport.Sync.BigInt() != nil &&
+		singleConsumerSession.QoSInfo.LastQoSReport.Sync.LT(sdk.MustNewDecFromStr("0.9")) {
+		utils.LavaFormatDebug("identified QoS mismatch",
+			utils.Attribute{Key: "expectedBH", Value: expectedBH},
+			utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
+			utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
+			utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
+			utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
+			utils.Attribute{Key: "singleConsumerSession.QoSInfo", Value: singleConsumerSession.QoSInfo},
+			utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
+		)
+	}
+	errResponse = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, latestBlock, chainlib.GetComputeUnits(chainMessage), relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, pairingAddressesLen, chainMessage.GetApi().Category.HangingApi) // session done successfully
 
-			// get here only if performed a regular relay successfully
-			expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
-			pairingAddressesLen := rpccs.consumerSessionManager.GetAtomicPairingAddressesLength()
-			latestBlock := localRelayResult.Reply.LatestBlock
-			if expectedBH-latestBlock > 1000 {
-				utils.LavaFormatWarning("identified block gap", nil,
-					utils.Attribute{Key: "expectedBH", Value: expectedBH},
-					utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
-					utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
-					utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
-					utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
-					utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
-				)
-			}
-			if rpccs.debugRelays && singleConsumerSession.QoSInfo.LastQoSReport != nil &&
-				singleConsumerSession.QoSInfo.LastQoSReport.Sync.BigInt() != nil &&
-				singleConsumerSession.QoSInfo.LastQoSReport.Sync.LT(sdk.MustNewDecFromStr("0.9")) {
-				utils.LavaFormatDebug("identified QoS mismatch",
-					utils.Attribute{Key: "expectedBH", Value: expectedBH},
-					utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
-					utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
-					utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
-					utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
-					utils.Attribute{Key: "singleConsumerSession.QoSInfo", Value: singleConsumerSession.QoSInfo},
-					utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
-				)
-			}
-			errResponse = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, latestBlock, chainlib.GetComputeUnits(chainMessage), relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, pairingAddressesLen, chainMessage.GetApi().Category.HangingApi) // session done successfully
-
-			if rpccs.cache.CacheActive() {
-				// copy reply data so if it changes it doesn't panic mid async send
-				copyReply := &pairingtypes.RelayReply{}
-				copyReplyErr := protocopy.DeepCopyProtoObject(localRelayResult.Reply, copyReply)
-				// set cache in a non blocking call
-
-				requestedBlock := localRelayResult.Request.RelayData.RequestBlock                             // get requested block before removing it from the data
-				seenBlock := localRelayResult.Request.RelayData.SeenBlock                                     // get seen block before removing it from the data
-				hashKey, _, hashErr := chainlib.HashCacheRequest(localRelayResult.Request.RelayData, chainID) // get the hash (this changes the data)
-
-				go func() {
-					// deal with copying error.
-					if copyReplyErr != nil || hashErr != nil {
-						utils.LavaFormatError("Failed copying relay private data sendRelayToProvider", nil,
-							utils.LogAttr("copyReplyErr", copyReplyErr),
-							utils.LogAttr("hashErr", hashErr),
-						)
-						return
-					}
-					chainMessageRequestedBlock, _ := chainMessage.RequestedBlock()
-					if chainMessageRequestedBlock == spectypes.NOT_APPLICABLE {
-						return
-					}
-
-					new_ctx := context.Background()
-					new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
-					defer cancel()
-					_, averageBlockTime, _, _ := rpccs.chainParser.ChainBlockStats()
-
-					err2 := rpccs.cache.SetEntry(new_ctx, &pairingtypes.RelayCacheSet{
-						RequestHash:      hashKey,
-						ChainId:          chainID,
-						RequestedBlock:   requestedBlock,
-						SeenBlock:        seenBlock,
-						BlockHash:        nil, // consumer cache doesn't care about block hashes
-						Response:         copyReply,
-						Finalized:        localRelayResult.Finalized,
-						OptionalMetadata: nil,
-						SharedStateId:    sharedStateId,
-						AverageBlockTime: int64(averageBlockTime), // by using average block time we can set longer TTL
-					})
-					if err2 != nil {
-						utils.LavaFormatWarning("error updating cache with new entry", err2)
-					}
-				}()
-			}
-		}(providerPublicAddress, sessionInfo)
+	if rpccs.cache.CacheActive() {
+		rpccs.setCachedRelayResult(chainMessage, localRelayResult.Request.RelayData, localRelayResult.Reply, localRelayResult.Finalized, sharedStateId)
 	}
+	return localRelayResult, errResponse
+}
 
-	// Getting the best result from the providers,
-	// if there was an error we wait for the next result util timeout or a valid response
-	// priority order {valid response -> error response -> relay error}
-	// if there were multiple error responses picking the majority
-	response := rpccs.getBestResult(relayTimeout, responses, len(sessions), chainMessage)
+// setCachedRelayResult saves the reply of the relay in the cache without blocking, the relay data is modified by the hashing
+func (rpccs *RPCConsumerServer) setCachedRelayResult(chainMessage chainlib.ChainMessage, relayData *pairingtypes.RelayPrivateData, reply *pairingtypes.RelayReply, finalized bool, sharedStateId string) {
+	chainID := rpccs.listenEndpoint.ChainID
+	// copy reply data so if it changes it doesn't panic mid async send
+	copyReply := &pairingtypes.RelayReply{}
+	copyReplyErr := protocopy.DeepCopyProtoObject(reply, copyReply)
+	// set cache in a non blocking call
 
-	if response == nil {
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Received unexpected nil response from getBestResult", nil, utils.LogAttr("sessions", sessions), utils.LogAttr("chainMessage", chainMessage))
-	}
+	requestedBlock := relayData.RequestBlock                             // get requested block before removing it from the data
+	seenBlock := relayData.SeenBlock                                     // get seen block before removing it from the data
+	hashKey, _, hashErr := chainlib.HashCacheRequest(relayData, chainID) // get the hash (this changes the data)
 
-	if response.err == nil && response.relayResult != nil && response.relayResult.Reply != nil {
-		// no error, update the seen block
-		blockSeen := response.relayResult.Reply.LatestBlock
-		rpccs.consumerConsistency.SetSeenBlock(blockSeen, dappID, consumerIp)
-	}
+	go func() {
+		// deal with copying error.
+		if copyReplyErr != nil || hashErr != nil {
+			utils.LavaFormatError("Failed copying relay private data sendRelayToProvider", nil,
+				utils.LogAttr("copyReplyErr", copyReplyErr),
+				utils.LogAttr("hashErr", hashErr),
+			)
+			return
+		}
+		chainMessageRequestedBlock, _ := chainMessage.RequestedBlock()
+		if chainMessageRequestedBlock == spectypes.NOT_APPLICABLE {
+			return
+		}
 
-	return response.relayResult, response.err
+		new_ctx := context.Background()
+		new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
+		defer cancel()
+		_, averageBlockTime, _, _ := rpccs.chainParser.ChainBlockStats()
+
+		err2 := rpccs.cache.SetEntry(new_ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:      hashKey,
+			ChainId:          chainID,
+			RequestedBlock:   requestedBlock,
+			SeenBlock:        seenBlock,
+			BlockHash:        nil, // consumer cache doesn't care about block hashes
+			Response:         copyReply,
+			Finalized:        finalized,
+			OptionalMetadata: nil,
+			SharedStateId:    sharedStateId,
+			AverageBlockTime: int64(averageBlockTime), // by using average block time we can set longer TTL
+		})
+		if err2 != nil {
+			utils.LavaFormatWarning("error updating cache with new entry", err2)
+		}
+	}()
 }
 
-func (rpccs *RPCConsumerServer) getBestResult(timeout time.Duration, responses chan *relayResponse, numberOfSessions int, chainMessage chainlib.ChainMessage) *relayResponse {
+// hedge is called once if no valid response arrived after hedgeAfter, it returns the number of sessions it added. hedgeAfter 0 disables it
+func (rpccs *RPCConsumerServer) getBestResult(timeout time.Duration, responses chan *relayResponse, numberOfSessions int, chainMessage chainlib.ChainMessage, hedgeAfter time.Duration, hedge func() int) *relayResponse {
 	responsesReceived := 0
 	nodeResponseErrors := &RelayErrors{relayErrors: []RelayError{}}
 	protocolResponseErrors := &RelayErrors{relayErrors: []RelayError{}, onFailureMergeAll: true}
@@ -703,8 +836,15 @@ func (rpccs *RPCConsumerServer) getBestResult(timeout time.Duration, responses c
 		return nil, fmt.Errorf("failed getting best response")
 	}
 	startTime := time.Now()
+	var hedgeTimer <-chan time.Time // nil channels block forever
+	if hedgeAfter > 0 {
+		hedgeTimer = time.After(hedgeAfter)
+	}
 	for {
 		select {
+		case <-hedgeTimer:
+			hedgeTimer = nil
+			numberOfSessions += hedge()
 		case response := <-responses:
 			// increase responses received
 			responsesReceived++
@@ -767,6 +907,9 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 		relaySentTime := time.Now()
 		connectCtx, connectCtxCancel := context.WithTimeout(ctx, relayTimeout)
 		metadataAdd := metadata.New(map[string]string{common.IP_FORWARDING_HEADER_NAME: consumerToken})
+		if chainMessage.GetForceCacheRefresh() {
+			metadataAdd.Set(common.FORCE_CACHE_REFRESH_HEADER_NAME, "true")
+		}
 		connectCtx = metadata.NewOutgoingContext(connectCtx, metadataAdd)
 		defer connectCtxCancel()
 		var trailer metadata.MD
@@ -855,9 +998,16 @@ func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endp
 		}
 		return err
 	}
-	// TODO: need to check that if provider fails and returns error, this is reflected here and we run onSessionDone
-	// my thoughts are that this fails if the grpc fails not if the provider fails, and if the provider returns an error this is reflected by the Recv function on the chainListener calling us here
-	// and this is too late
+	// the provider's first reply confirms the subscription, it's only charged for once it did
+	confirmation, err := replyServer.Recv()
+	if err != nil {
+		errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, err)
+		if errReport != nil {
+			return utils.LavaFormatError("subscribe relay confirmation failed onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "original error", Value: err.Error()})
+		}
+		return err
+	}
+	relayResult.Reply = confirmation
 	relayResult.ReplyServer = &replyServer
 	err = rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
 	return err
@@ -903,25 +1053,32 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 		utils.LavaFormatInfo("skipping data reliability check since response from second provider was not finalized", utils.Attribute{Key: "providerAddress", Value: relayResultDataReliability.ProviderInfo.ProviderAddress})
 		return nil
 	}
-	conflict := lavaprotocol.VerifyReliabilityResults(ctx, relayResult, relayResultDataReliability, chainMessage.GetApiCollection(), rpccs.chainParser)
-	if conflict != nil {
-		// TODO: remove this check when we fix the missing extensions information on conflict detection transaction
-		if relayRequestData.Extensions == nil || len(relayRequestData.Extensions) == 0 {
-			err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil, relayResultDataReliability.ConflictHandler)
-			if err != nil {
-				utils.LavaFormatError("could not send detection Transaction", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "conflict", Value: conflict})
-			}
-			if rpccs.reporter != nil {
-				utils.LavaFormatDebug("sending conflict report to BE", utils.LogAttr("conflicting api", chainMessage.GetApi().Name))
-				rpccs.reporter.AppendConflict(metrics.NewConflictRequest(relayResult.Request, relayResult.Reply, relayResultDataReliability.Request, relayResultDataReliability.Reply))
-			}
-		}
-	} else {
+	if !rpccs.reportResponseConflict(ctx, chainMessage, relayResult, relayResultDataReliability) {
 		utils.LavaFormatDebug("[+] verified relay successfully with data reliability", utils.LogAttr("api", chainMessage.GetApi().Name))
 	}
 	return nil
 }
 
+// compares the responses of two providers and sends a conflict detection transaction if they mismatch, returns true on a conflict
+func (rpccs *RPCConsumerServer) reportResponseConflict(ctx context.Context, chainMessage chainlib.ChainMessage, relayResult *common.RelayResult, conflictingRelayResult *common.RelayResult) bool {
+	conflict := lavaprotocol.VerifyReliabilityResults(ctx, relayResult, conflictingRelayResult, chainMessage.GetApiCollection(), rpccs.chainParser)
+	if conflict == nil {
+		return false
+	}
+	// TODO: remove this check when we fix the missing extensions information on conflict detection transaction
+	if len(conflictingRelayResult.Request.RelayData.Extensions) == 0 {
+		err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil, conflictingRelayResult.ConflictHandler)
+		if err != nil {
+			utils.LavaFormatError("could not send detection Transaction", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "conflict", Value: conflict})
+		}
+		if rpccs.reporter != nil {
+			utils.LavaFormatDebug("sending conflict report to BE", utils.LogAttr("conflicting api", chainMessage.GetApi().Name))
+			rpccs.reporter.AppendConflict(metrics.NewConflictRequest(relayResult.Request, relayResult.Reply, conflictingRelayResult.Request, conflictingRelayResult.Reply))
+		}
+	}
+	return true
+}
+
 func (rpccs *RPCConsumerServer) LavaDirectiveHeaders(metadata []pairingtypes.Metadata) ([]pairingtypes.Metadata, map[string]string) {
 	metadataRet := []pairingtypes.Metadata{}
 	headerDirectives := map[string]string{}
@@ -936,6 +1093,10 @@ func (rpccs *RPCConsumerServer) LavaDirectiveHeaders(metadata []pairingtypes.Met
 			headerDirectives[name] = metaElement.Value
 		case common.FORCE_CACHE_REFRESH_HEADER_NAME:
 			headerDirectives[name] = metaElement.Value
+		case common.QUORUM_HEADER_NAME:
+			headerDirectives[name] = metaElement.Value
+		case common.SPLIT_BATCH_HEADER_NAME:
+			headerDirectives[name] = metaElement.Value
 		default:
 			metadataRet = append(metadataRet, metaElement)
 		}
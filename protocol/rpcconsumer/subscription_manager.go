@@ -0,0 +1,341 @@
+package rpcconsumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	MaxSubscriptionResumeAttempts = 3   // consecutive providers that failed to take over a subscription before it ends
+	MaxSubscriptionSeenEvents     = 256 // events remembered for de-duplication across a handover
+)
+
+// subscribes to a provider that isn't unwanted, the relay result holds the provider's reply stream
+type subscribeFunc func(ctx context.Context, unwantedProviders map[string]struct{}) (*common.RelayResult, error)
+
+// resumableSubscription is the reply stream of a subscription that moves to another provider when the provider's stream ends,
+// as happens on epoch rollover or when the provider fails.
+// the confirmation of the first provider is returned first, the confirmations of the providers it moves to aren't returned.
+// events delivered by both providers around the handover are returned once, and the first event after it is marked as resumed
+type resumableSubscription struct {
+	ctx       context.Context
+	subscribe subscribeFunc
+
+	lock                   sync.Mutex
+	stream                 pairingtypes.Relayer_RelaySubscribeClient
+	providerAddress        string
+	confirmation           *pairingtypes.RelayReply // nil once returned
+	subscriptionID         string                   // the id the client knows the subscription by
+	providerSubscriptionID string                   // the id of the current provider's subscription
+	resumed                bool
+	seenEvents             map[string]struct{}
+	seenEventsOrder        []string
+}
+
+// the relay result holds the confirmation the provider replied with and its stream of events
+func newResumableSubscription(ctx context.Context, relayResult *common.RelayResult, subscribe subscribeFunc) *resumableSubscription {
+	subscriptionID := subscriptionIDFromConfirmation(relayResult.GetReply().GetData())
+	return &resumableSubscription{
+		ctx:                    ctx,
+		subscribe:              subscribe,
+		stream:                 *relayResult.ReplyServer,
+		providerAddress:        relayResult.GetProvider(),
+		confirmation:           relayResult.GetReply(),
+		subscriptionID:         subscriptionID,
+		providerSubscriptionID: subscriptionID,
+		seenEvents:             map[string]struct{}{},
+	}
+}
+
+func (rs *resumableSubscription) Recv() (*pairingtypes.RelayReply, error) {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	if rs.confirmation != nil {
+		confirmation := rs.confirmation
+		rs.confirmation = nil
+		return confirmation, nil
+	}
+	for {
+		reply, err := rs.stream.Recv()
+		if err != nil {
+			if rs.ctx.Err() != nil {
+				return nil, err
+			}
+			utils.LavaFormatInfo("subscription stream ended, resuming with another provider", utils.LogAttr("GUID", rs.ctx), utils.LogAttr("provider", rs.providerAddress), utils.LogAttr("error", err))
+			if errResume := rs.resume(); errResume != nil {
+				return nil, errResume
+			}
+			continue
+		}
+		if rs.providerSubscriptionID != rs.subscriptionID {
+			reply.Data = replaceSubscriptionID(reply.Data, rs.providerSubscriptionID, rs.subscriptionID)
+		}
+		if !rs.firstSeen(reply.Data) {
+			continue
+		}
+		if rs.resumed {
+			rs.resumed = false
+			reply.Metadata = append(reply.Metadata,
+				pairingtypes.Metadata{Name: common.SUBSCRIPTION_RESUMED_HEADER_NAME, Value: "true"},
+				pairingtypes.Metadata{Name: common.PROVIDER_ADDRESS_HEADER_NAME, Value: rs.providerAddress},
+			)
+		}
+		return reply, nil
+	}
+}
+
+// subscribes with another provider in place of the current one, must be called with the lock held
+func (rs *resumableSubscription) resume() error {
+	unwantedProviders := map[string]struct{}{rs.providerAddress: {}}
+	var err error
+	for attempt := 0; attempt < MaxSubscriptionResumeAttempts; attempt++ {
+		var relayResult *common.RelayResult
+		relayResult, err = rs.subscribe(rs.ctx, unwantedProviders)
+		if relayResult != nil && relayResult.GetProvider() != "" {
+			unwantedProviders[relayResult.GetProvider()] = struct{}{}
+		}
+		if err != nil {
+			if rs.ctx.Err() != nil {
+				return err
+			}
+			continue
+		}
+		utils.LavaFormatInfo("subscription resumed", utils.LogAttr("GUID", rs.ctx), utils.LogAttr("previous_provider", rs.providerAddress), utils.LogAttr("provider", relayResult.GetProvider()))
+		rs.stream = *relayResult.ReplyServer
+		rs.providerAddress = relayResult.GetProvider()
+		rs.providerSubscriptionID = subscriptionIDFromConfirmation(relayResult.GetReply().GetData())
+		rs.resumed = true
+		return nil
+	}
+	return utils.LavaFormatWarning("failed resuming subscription", err, utils.LogAttr("GUID", rs.ctx), utils.LogAttr("attempts", MaxSubscriptionResumeAttempts))
+}
+
+// returns false if an event of the same block was already returned
+func (rs *resumableSubscription) firstSeen(data []byte) bool {
+	key := subscriptionEventKey(data)
+	if key == "" {
+		// not a block bound event, can't tell duplicates apart
+		return true
+	}
+	if _, ok := rs.seenEvents[key]; ok {
+		return false
+	}
+	if len(rs.seenEventsOrder) >= MaxSubscriptionSeenEvents {
+		delete(rs.seenEvents, rs.seenEventsOrder[0])
+		rs.seenEventsOrder = rs.seenEventsOrder[1:]
+	}
+	rs.seenEvents[key] = struct{}{}
+	rs.seenEventsOrder = append(rs.seenEventsOrder, key)
+	return true
+}
+
+func (rs *resumableSubscription) RecvMsg(m interface{}) error {
+	reply, err := rs.Recv()
+	if err != nil {
+		return err
+	}
+	relayReply, ok := m.(*pairingtypes.RelayReply)
+	if !ok {
+		return utils.LavaFormatError("invalid subscription message type", nil, utils.LogAttr("type", m))
+	}
+	*relayReply = *reply
+	return nil
+}
+
+func (rs *resumableSubscription) currentStream() pairingtypes.Relayer_RelaySubscribeClient {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	return rs.stream
+}
+
+func (rs *resumableSubscription) Header() (metadata.MD, error) {
+	return rs.currentStream().Header()
+}
+
+func (rs *resumableSubscription) Trailer() metadata.MD {
+	return rs.currentStream().Trailer()
+}
+
+func (rs *resumableSubscription) CloseSend() error {
+	return rs.currentStream().CloseSend()
+}
+
+func (rs *resumableSubscription) Context() context.Context {
+	return rs.ctx
+}
+
+func (rs *resumableSubscription) SendMsg(m interface{}) error {
+	return rs.currentStream().SendMsg(m)
+}
+
+// the subscription id a jsonrpc provider returned, tendermint confirmations have none
+func subscriptionIDFromConfirmation(data []byte) string {
+	var confirmation struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &confirmation); err != nil {
+		return ""
+	}
+	var subscriptionID string
+	if err := json.Unmarshal(confirmation.Result, &subscriptionID); err != nil {
+		return ""
+	}
+	return subscriptionID
+}
+
+// events of a resumed subscription carry the id of the new provider's subscription, the client knows the original one
+func replaceSubscriptionID(data []byte, providerSubscriptionID, subscriptionID string) []byte {
+	if providerSubscriptionID == "" || subscriptionID == "" {
+		return data
+	}
+	providerID, err := json.Marshal(providerSubscriptionID)
+	if err != nil {
+		return data
+	}
+	clientID, err := json.Marshal(subscriptionID)
+	if err != nil {
+		return data
+	}
+	return bytes.Replace(data, providerID, clientID, 1)
+}
+
+var (
+	subscriptionEventBlockKeys    = []string{"number", "height", "blockNumber"}
+	subscriptionEventIdentityKeys = []string{"hash", "blockHash", "transactionHash", "logIndex"}
+)
+
+// identifies an event by its block number and hash, and for logs by their transaction and index.
+// returns "" for events that aren't bound to a block
+func subscriptionEventKey(data []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var event interface{}
+	if err := decoder.Decode(&event); err != nil {
+		return ""
+	}
+	found := map[string]string{}
+	// breadth first so the fields of the event's block take precedence over nested ones
+	queue := []interface{}{event}
+	for len(queue) > 0 {
+		object, ok := queue[0].(map[string]interface{})
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		for key, value := range object {
+			switch typed := value.(type) {
+			case map[string]interface{}:
+				queue = append(queue, typed)
+			case string:
+				if _, ok := found[key]; !ok {
+					found[key] = typed
+				}
+			case json.Number:
+				if _, ok := found[key]; !ok {
+					found[key] = typed.String()
+				}
+			}
+		}
+	}
+	var block string
+	for _, key := range subscriptionEventBlockKeys {
+		if value, ok := found[key]; ok {
+			block = value
+			break
+		}
+	}
+	identity := []string{block}
+	for _, key := range subscriptionEventIdentityKeys {
+		identity = append(identity, found[key])
+	}
+	if block == "" && found["hash"] == "" && found["blockHash"] == "" {
+		return ""
+	}
+	return strings.Join(identity, "/")
+}
+
+// subscribes to a single provider, the subscription outlives the relay so it's bound to the client's context.
+// the provider is only charged for once it confirmed the subscription, so a failed handover costs nothing
+func (rpccs *RPCConsumerServer) subscribeToProvider(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	unwantedProviders map[string]struct{},
+) (*common.RelayResult, error) {
+	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	sessions, err := rpccs.consumerSessionManager.GetSessions(lavasession.WithDappID(ctx, dappID), chainlib.GetComputeUnits(chainMessage), unwantedProviders, spectypes.LATEST_BLOCK, chainlib.GetAddon(chainMessage), chainMessage.GetExtensions(), chainlib.GetStateful(chainMessage), virtualEpoch)
+	if err != nil {
+		return &common.RelayResult{}, err
+	}
+	// a subscription is held by a single provider, the rest of the sessions are returned
+	var providerPublicAddress string
+	var sessionInfo *lavasession.SessionInfo
+	for providerAddress, info := range sessions {
+		if sessionInfo == nil {
+			providerPublicAddress, sessionInfo = providerAddress, info
+			continue
+		}
+		errReport := rpccs.consumerSessionManager.OnSessionUnUsed(info.Session)
+		if errReport != nil {
+			utils.LavaFormatError("failed returning an extra subscription session", errReport, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+		}
+	}
+	relayResult := &common.RelayResult{
+		ProviderInfo: common.ProviderInfo{ProviderAddress: providerPublicAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
+	}
+	localRelayRequestData := *relayRequestData
+	relayResult.Request, err = lavaprotocol.ConstructRelayRequest(ctx, rpccs.privKey, rpccs.lavaChainID, rpccs.listenEndpoint.ChainID, &localRelayRequestData, providerPublicAddress, sessionInfo.Session, int64(sessionInfo.Epoch), sessionInfo.ReportedProviders)
+	if err != nil {
+		errReport := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session)
+		if errReport != nil {
+			utils.LavaFormatError("failed returning a subscription session", errReport, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerPublicAddress))
+		}
+		return relayResult, utils.LavaFormatError("Failed ConstructRelayRequest", err, utils.LogAttr("GUID", ctx), utils.LogAttr("Request data", localRelayRequestData))
+	}
+	err = rpccs.relaySubscriptionInner(ctx, *sessionInfo.Session.Endpoint.Client, sessionInfo.Session, relayResult)
+	return relayResult, err
+}
+
+// subscribes to a provider and resumes the subscription with another provider whenever the provider's stream ends
+func (rpccs *RPCConsumerServer) sendSubscriptionRelay(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	unwantedProviders map[string]struct{},
+) (*common.RelayResult, error) {
+	subscribe := func(ctx context.Context, resumeUnwantedProviders map[string]struct{}) (*common.RelayResult, error) {
+		providers := make(map[string]struct{}, len(unwantedProviders)+len(resumeUnwantedProviders))
+		for providerAddress := range unwantedProviders {
+			providers[providerAddress] = struct{}{}
+		}
+		for providerAddress := range resumeUnwantedProviders {
+			providers[providerAddress] = struct{}{}
+		}
+		return rpccs.subscribeToProvider(ctx, chainMessage, relayRequestData, dappID, providers)
+	}
+	if rpccs.listenEndpoint.ApiInterface == spectypes.APIInterfaceGrpc {
+		// providers can't subscribe on grpc nodes, server streaming methods aren't relayed
+		return &common.RelayResult{}, utils.LavaFormatWarning("subscriptions are not supported on grpc", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("api", chainMessage.GetApi().Name))
+	}
+	relayResult, err := subscribe(ctx, nil)
+	if err != nil {
+		return relayResult, err
+	}
+	var replyServer pairingtypes.Relayer_RelaySubscribeClient = newResumableSubscription(ctx, relayResult, subscribe)
+	relayResult.ReplyServer = &replyServer
+	return relayResult, nil
+}
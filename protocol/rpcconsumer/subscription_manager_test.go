@@ -0,0 +1,177 @@
+package rpcconsumer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type mockSubscribeStream struct {
+	grpc.ClientStream
+	replies []string
+}
+
+func (mss *mockSubscribeStream) Recv() (*pairingtypes.RelayReply, error) {
+	if len(mss.replies) == 0 {
+		return nil, io.EOF
+	}
+	reply := &pairingtypes.RelayReply{Data: []byte(mss.replies[0])}
+	mss.replies = mss.replies[1:]
+	return reply, nil
+}
+
+// the confirmation was already received when subscribing, the stream holds the events
+func mockSubscriptionRelayResult(provider string, confirmation string, events ...string) *common.RelayResult {
+	var stream pairingtypes.Relayer_RelaySubscribeClient = &mockSubscribeStream{replies: events}
+	return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: provider}, Reply: &pairingtypes.RelayReply{Data: []byte(confirmation)}, ReplyServer: &stream}
+}
+
+func newHeadsEvent(subscriptionID string, number int) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"%s","result":{"number":"0x%x","hash":"0xhash%d","parentHash":"0xhash%d"}}}`, subscriptionID, number, number, number-1)
+}
+
+func TestResumableSubscription(t *testing.T) {
+	first := mockSubscriptionRelayResult("lava@first", `{"jsonrpc":"2.0","id":1,"result":"0xa"}`, newHeadsEvent("0xa", 1), newHeadsEvent("0xa", 2))
+	resumes := []*common.RelayResult{
+		nil, // the second provider fails to subscribe
+		mockSubscriptionRelayResult("lava@third", `{"jsonrpc":"2.0","id":1,"result":"0xb"}`, newHeadsEvent("0xb", 2), newHeadsEvent("0xb", 3)),
+	}
+	unwanted := []map[string]struct{}{}
+	subscribe := func(ctx context.Context, unwantedProviders map[string]struct{}) (*common.RelayResult, error) {
+		copied := map[string]struct{}{}
+		for provider := range unwantedProviders {
+			copied[provider] = struct{}{}
+		}
+		unwanted = append(unwanted, copied)
+		var relayResult *common.RelayResult
+		if len(resumes) > 0 {
+			relayResult = resumes[0]
+			resumes = resumes[1:]
+		}
+		if relayResult == nil {
+			return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: "lava@second"}}, fmt.Errorf("subscribe failed")
+		}
+		return relayResult, nil
+	}
+	subscription := newResumableSubscription(context.Background(), first, subscribe)
+
+	received := []*pairingtypes.RelayReply{}
+	for {
+		var reply pairingtypes.RelayReply
+		err := subscription.RecvMsg(&reply)
+		if err != nil {
+			break
+		}
+		received = append(received, &reply)
+	}
+	require.Len(t, received, 4)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0xa"}`, string(received[0].Data))
+	require.Equal(t, newHeadsEvent("0xa", 1), string(received[1].Data))
+	require.Equal(t, newHeadsEvent("0xa", 2), string(received[2].Data))
+	// the duplicate of block 2 is dropped and block 3 is returned with the client's subscription id
+	require.Equal(t, newHeadsEvent("0xa", 3), string(received[3].Data))
+	require.Contains(t, received[3].Metadata, pairingtypes.Metadata{Name: common.SUBSCRIPTION_RESUMED_HEADER_NAME, Value: "true"})
+	require.Contains(t, received[3].Metadata, pairingtypes.Metadata{Name: common.PROVIDER_ADDRESS_HEADER_NAME, Value: "lava@third"})
+	require.Empty(t, received[2].Metadata)
+
+	// providers that failed during a handover aren't retried in it, and each handover starts over
+	require.Equal(t, []map[string]struct{}{
+		{"lava@first": {}},
+		{"lava@first": {}, "lava@second": {}},
+		{"lava@third": {}},
+		{"lava@third": {}, "lava@second": {}},
+		{"lava@third": {}, "lava@second": {}},
+	}, unwanted)
+}
+
+func TestSubscriptionEventKey(t *testing.T) {
+	log := func(index int) string {
+		return fmt.Sprintf(`{"params":{"subscription":"0xa","result":{"blockNumber":"0x10","blockHash":"0xbb","transactionHash":"0xtt","logIndex":"0x%x"}}}`, index)
+	}
+	tendermintBlock := `{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock","value":{"block":{"header":{"height":"100"}}}}}}`
+	playbook := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{name: "new heads", data: newHeadsEvent("0xa", 16), expected: "0x10/0xhash16///"},
+		{name: "log", data: log(1), expected: "0x10//0xbb/0xtt/0x1"},
+		{name: "tendermint block", data: tendermintBlock, expected: "100////"},
+		{name: "not a block event", data: `{"params":{"subscription":"0xa","result":"0xtransaction"}}`, expected: ""},
+		{name: "not json", data: `not json`, expected: ""},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			require.Equal(t, play.expected, subscriptionEventKey([]byte(play.data)))
+		})
+	}
+	require.NotEqual(t, subscriptionEventKey([]byte(log(1))), subscriptionEventKey([]byte(log(2))))
+}
+
+func TestSubscriptionRelayResumes(t *testing.T) {
+	const subscribeRequest = `{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`
+	setup := newConsumerTestSetup(t)
+	// every subscription streams two blocks and ends, the next one starts from the last block it streamed.
+	// the fourth subscription fails before confirming it
+	var subscriptions atomic.Int64
+	subscribe := func(request *pairingtypes.RelayRequest, send func(data string) error) error {
+		count := int(subscriptions.Add(1))
+		if count > 3 {
+			return fmt.Errorf("subscription failed")
+		}
+		subscriptionID := fmt.Sprintf("0x%x", count)
+		err := send(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":"%s"}`, subscriptionID))
+		for block := count; block <= count+1 && err == nil; block++ {
+			err = send(newHeadsEvent(subscriptionID, block))
+		}
+		return err
+	}
+	providers := []*mockProvider{{subscribe: subscribe}, {subscribe: subscribe}}
+	setup.startProviders(t, providers...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	relayResult, err := setup.rpccs.SendRelay(ctx, "", subscribeRequest, http.MethodPost, "dapp", "127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, relayResult.ReplyServer)
+	replyServer := *relayResult.ReplyServer
+
+	received := []*pairingtypes.RelayReply{}
+	for {
+		var reply pairingtypes.RelayReply
+		err = replyServer.RecvMsg(&reply)
+		if err != nil {
+			break
+		}
+		received = append(received, &reply)
+	}
+	require.Len(t, received, 5)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, string(received[0].Data))
+	for block := 1; block <= 4; block++ {
+		// every block is streamed once, with the id of the client's subscription
+		require.Equal(t, newHeadsEvent("0x1", block), string(received[block].Data))
+	}
+	require.NotContains(t, received[2].Metadata, pairingtypes.Metadata{Name: common.SUBSCRIPTION_RESUMED_HEADER_NAME, Value: "true"})
+	require.Contains(t, received[3].Metadata, pairingtypes.Metadata{Name: common.SUBSCRIPTION_RESUMED_HEADER_NAME, Value: "true"})
+	require.Contains(t, received[4].Metadata, pairingtypes.Metadata{Name: common.SUBSCRIPTION_RESUMED_HEADER_NAME, Value: "true"})
+
+	// the confirmed subscriptions are charged, the one that failed isn't
+	computeUnits := setup.parseMsg(t, subscribeRequest).GetApi().ComputeUnits
+	require.Equal(t, 3*computeUnits, providers[0].usedComputeUnits()+providers[1].usedComputeUnits())
+}
+
+func TestSubscriptionRelayGrpcNotSupported(t *testing.T) {
+	setup := newConsumerTestSetup(t)
+	setup.rpccs.listenEndpoint.ApiInterface = spectypes.APIInterfaceGrpc
+	_, err := setup.rpccs.sendSubscriptionRelay(context.Background(), &mockChainMessage{api: &spectypes.Api{Name: "Subscribe"}}, &pairingtypes.RelayPrivateData{}, "dapp", map[string]struct{}{})
+	require.Error(t, err)
+}
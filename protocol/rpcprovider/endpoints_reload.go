@@ -0,0 +1,395 @@
+package rpcprovider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	ConfigWatchIntervalFlagName  = "config-watch-interval"
+	EndpointDrainTimeoutFlagName = "endpoint-drain-timeout"
+)
+
+var (
+	// how often the config file is checked for changes, 0 disables watching, a SIGHUP always triggers a reload
+	ConfigWatchInterval = 10 * time.Second
+	// how long in flight relays of removed endpoints and replaced chain routers get to finish
+	EndpointDrainTimeout = 30 * time.Second
+)
+
+// reloadableChainRouter lets the node urls of a running endpoint be replaced,
+// the chain fetcher, reliability manager and provider server all hold it instead of the router itself
+type reloadableChainRouter struct {
+	lock   sync.RWMutex
+	router chainlib.ChainRouter
+	cancel context.CancelFunc // closes the connections of the current router
+}
+
+func newReloadableChainRouter(router chainlib.ChainRouter, cancel context.CancelFunc) *reloadableChainRouter {
+	return &reloadableChainRouter{router: router, cancel: cancel}
+}
+
+func (rcr *reloadableChainRouter) current() chainlib.ChainRouter {
+	rcr.lock.RLock()
+	defer rcr.lock.RUnlock()
+	return rcr.router
+}
+
+func (rcr *reloadableChainRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
+	return rcr.current().SendNodeMsg(ctx, ch, chainMessage, extensions)
+}
+
+func (rcr *reloadableChainRouter) ExtensionsSupported(extensions []string) bool {
+	return rcr.current().ExtensionsSupported(extensions)
+}
+
+// replaces the router, the connections of the previous one are closed once its in flight relays had time to finish
+func (rcr *reloadableChainRouter) swap(router chainlib.ChainRouter, cancel context.CancelFunc) {
+	rcr.lock.Lock()
+	previousCancel := rcr.cancel
+	rcr.router, rcr.cancel = router, cancel
+	rcr.lock.Unlock()
+	time.AfterFunc(EndpointDrainTimeout, previousCancel)
+}
+
+func (rcr *reloadableChainRouter) close() {
+	rcr.lock.RLock()
+	defer rcr.lock.RUnlock()
+	rcr.cancel()
+}
+
+// everything needed to reload or tear down an endpoint that was set up
+type activeEndpoint struct {
+	endpoint         *lavasession.RPCProviderEndpoint
+	server           *RPCProviderServer
+	sessionManager   *lavasession.ProviderSessionManager
+	chainParser      chainlib.ChainParser
+	chainRouter      *reloadableChainRouter
+	chainFetcher     *chainlib.ChainFetcherIf
+	listener         *ProviderListener
+	ownsChainTracker bool // the shared chain tracker of the chain polls through this endpoint's router, guarded by the chain mutex
+	cancel           context.CancelFunc
+}
+
+// endpoints are identified by their listen address and chain api, the same chain api can be served on several addresses
+func endpointReloadKey(endpoint *lavasession.RPCProviderEndpoint) string {
+	return endpoint.NetworkAddress.Address + "_" + endpoint.Key()
+}
+
+// endpoints without an address share the listener of the previous endpoint
+func fillSharedNetworkAddresses(endpoints []*lavasession.RPCProviderEndpoint) {
+	for idx, endpoint := range endpoints {
+		if idx > 0 && endpoint.NetworkAddress.Address == "" {
+			endpoint.NetworkAddress = endpoints[idx-1].NetworkAddress
+		}
+	}
+}
+
+// returns true when the endpoints only differ in how their node urls are reached, which only requires a new chain router
+func onlyNodeUrlsChanged(current, updated *lavasession.RPCProviderEndpoint) bool {
+	currentCopy, updatedCopy := *current, *updated
+	currentCopy.NodeUrls, updatedCopy.NodeUrls = nil, nil
+	if !reflect.DeepEqual(currentCopy, updatedCopy) {
+		return false
+	}
+	// the chain parser policy is built from the addons of the node urls, changing them requires a new chain parser
+	addons := func(nodeUrls []common.NodeUrl) []string {
+		all := map[string]struct{}{}
+		for _, nodeUrl := range nodeUrls {
+			for _, addon := range nodeUrl.Addons {
+				all[addon] = struct{}{}
+			}
+		}
+		list := make([]string, 0, len(all))
+		for addon := range all {
+			list = append(list, addon)
+		}
+		sort.Strings(list)
+		return list
+	}
+	return reflect.DeepEqual(addons(current.NodeUrls), addons(updated.NodeUrls))
+}
+
+// diffEndpoints compares the configured endpoints with an updated configuration,
+// endpoints that changed beyond their node urls are removed and added again
+func diffEndpoints(current map[string]*lavasession.RPCProviderEndpoint, updated []*lavasession.RPCProviderEndpoint) (added, removed, nodeUrlsChanged []*lavasession.RPCProviderEndpoint) {
+	updatedKeys := map[string]struct{}{}
+	for _, endpoint := range updated {
+		key := endpointReloadKey(endpoint)
+		updatedKeys[key] = struct{}{}
+		existing, ok := current[key]
+		switch {
+		case !ok:
+			added = append(added, endpoint)
+		case reflect.DeepEqual(existing, endpoint):
+		case onlyNodeUrlsChanged(existing, endpoint):
+			nodeUrlsChanged = append(nodeUrlsChanged, endpoint)
+		default:
+			removed = append(removed, existing)
+			added = append(added, endpoint)
+		}
+	}
+	for key, endpoint := range current {
+		if _, ok := updatedKeys[key]; !ok {
+			removed = append(removed, endpoint)
+		}
+	}
+	return added, removed, nodeUrlsChanged
+}
+
+// ReloadEndpoints applies an updated endpoints configuration: new endpoints are set up, removed endpoints are drained
+// and unregistered, and endpoints whose node urls changed get a new chain router. untouched endpoints keep running as is
+func (rpcp *RPCProvider) ReloadEndpoints(endpoints []*lavasession.RPCProviderEndpoint) {
+	rpcp.reloadLock.Lock()
+	defer rpcp.reloadLock.Unlock()
+	fillSharedNetworkAddresses(endpoints)
+	for _, endpoint := range endpoints {
+		err := endpoint.Validate()
+		if err != nil {
+			utils.LavaFormatError("invalid endpoint in reloaded configuration, keeping the current endpoints", err, utils.LogAttr("endpoint", endpoint.String()))
+			return
+		}
+	}
+
+	rpcp.lock.Lock()
+	current := make(map[string]*lavasession.RPCProviderEndpoint, len(rpcp.configuredEndpoints))
+	for key, endpoint := range rpcp.configuredEndpoints {
+		current[key] = endpoint
+	}
+	rpcp.lock.Unlock()
+	added, removed, nodeUrlsChanged := diffEndpoints(current, endpoints)
+	if len(added)+len(removed)+len(nodeUrlsChanged) == 0 {
+		utils.LavaFormatInfo("endpoints configuration reloaded, nothing changed")
+		return
+	}
+	utils.LavaFormatInfo("reloading endpoints configuration",
+		utils.LogAttr("added", len(added)),
+		utils.LogAttr("removed", len(removed)),
+		utils.LogAttr("nodeUrlsChanged", len(nodeUrlsChanged)),
+	)
+
+	// removing first frees the listener keys of endpoints that are added again
+	var wg sync.WaitGroup
+	for _, endpoint := range removed {
+		rpcp.setConfiguredEndpoint(endpoint, false)
+		active := rpcp.popActiveEndpoint(endpoint)
+		if active == nil {
+			// never got set up, dropping it from the configuration stops its setup retries
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rpcp.removeEndpoint(active)
+		}()
+	}
+	wg.Wait()
+
+	for _, endpoint := range nodeUrlsChanged {
+		active := rpcp.getActiveEndpoint(endpoint)
+		if active == nil {
+			// the previous node urls didn't work, set it up from scratch with the new ones
+			added = append(added, endpoint)
+			continue
+		}
+		err := rpcp.rebuildChainRouter(active, endpoint)
+		if err != nil {
+			utils.LavaFormatError("failed rebuilding chain router, keeping the previous node urls", err, utils.LogAttr("endpoint", endpoint.String()))
+			continue
+		}
+		rpcp.setConfiguredEndpoint(endpoint, true)
+		utils.LavaFormatInfo("[+] replaced node urls of endpoint", utils.LogAttr("endpoint", endpoint.String()))
+	}
+
+	if len(added) == 0 {
+		return
+	}
+	for _, endpoint := range added {
+		rpcp.setConfiguredEndpoint(endpoint, true)
+	}
+	disabledEndpoints := rpcp.SetupProviderEndpoints(added, rpcp.specValidator, true)
+	if len(disabledEndpoints) > 0 {
+		utils.LavaFormatError(utils.FormatStringerList("[-] failed setting up reloaded endpoints:", disabledEndpoints, "[-]"), nil)
+		go rpcp.RetryDisabledEndpoints(disabledEndpoints, rpcp.specValidator, 1)
+	}
+}
+
+func (rpcp *RPCProvider) setConfiguredEndpoint(endpoint *lavasession.RPCProviderEndpoint, configured bool) {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	if configured {
+		rpcp.configuredEndpoints[endpointReloadKey(endpoint)] = endpoint
+	} else {
+		delete(rpcp.configuredEndpoints, endpointReloadKey(endpoint))
+	}
+}
+
+// returns false for endpoints that were removed or replaced by a reload since they were read
+func (rpcp *RPCProvider) isConfiguredEndpoint(endpoint *lavasession.RPCProviderEndpoint) bool {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	return rpcp.configuredEndpoints[endpointReloadKey(endpoint)] == endpoint
+}
+
+func (rpcp *RPCProvider) getActiveEndpoint(endpoint *lavasession.RPCProviderEndpoint) *activeEndpoint {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	return rpcp.activeEndpoints[endpointReloadKey(endpoint)]
+}
+
+// returns a set up endpoint of the chain, nil if there is none
+func (rpcp *RPCProvider) getActiveEndpointOfChain(chainID string) *activeEndpoint {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	for _, active := range rpcp.activeEndpoints {
+		if active.endpoint.ChainID == chainID {
+			return active
+		}
+	}
+	return nil
+}
+
+func (rpcp *RPCProvider) popActiveEndpoint(endpoint *lavasession.RPCProviderEndpoint) *activeEndpoint {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	key := endpointReloadKey(endpoint)
+	active := rpcp.activeEndpoints[key]
+	delete(rpcp.activeEndpoints, key)
+	return active
+}
+
+// stops routing relays to the endpoint, waits for its in flight relays and releases its resources.
+// proofs of relays it served stay in the reward server and are claimed as usual
+func (rpcp *RPCProvider) removeEndpoint(active *activeEndpoint) {
+	endpoint := active.endpoint
+	active.listener.UnregisterReceiver(endpoint)
+	rpcp.handOverChainTracker(active)
+	rpcp.specValidator.RemoveChainFetcher(active.chainFetcher, endpoint.ChainID)
+	rpcp.relaysMonitorAggregator.UnregisterRelaysMonitor(endpoint.Key())
+	rpcp.providerMetricsManager.SetDisabledChain(endpoint.ChainID, endpoint.ApiInterface)
+	if !active.server.drain(EndpointDrainTimeout) {
+		utils.LavaFormatWarning("removed endpoint still had relays in flight after the drain timeout", nil, utils.LogAttr("endpoint", endpoint.String()), utils.LogAttr("timeout", EndpointDrainTimeout))
+	}
+	active.chainRouter.close()
+	active.cancel()
+	utils.LavaFormatInfo("[-] removed endpoint", utils.LogAttr("endpoint", endpoint.String()))
+}
+
+// the chain tracker is shared by the endpoints of the chain and polls through the one that set it up,
+// when that endpoint is removed the tracker moves to another endpoint of the chain, or is paused until one is set up
+func (rpcp *RPCProvider) handOverChainTracker(removed *activeEndpoint) {
+	chainID := removed.endpoint.ChainID
+	chainMutex := rpcp.getChainMutex(chainID)
+	chainMutex.Lock()
+	defer chainMutex.Unlock()
+	if !removed.ownsChainTracker {
+		return
+	}
+	removed.ownsChainTracker = false
+	chainTracker, found := rpcp.chainTrackers.GetTrackerPerChain(chainID)
+	if !found {
+		return
+	}
+	successor := rpcp.getActiveEndpointOfChain(chainID)
+	if successor == nil {
+		chainTracker.SetChainFetcher(nil)
+		utils.LavaFormatInfo("[-] paused the chain tracker until an endpoint of the chain is set up", utils.LogAttr("chainID", chainID))
+		return
+	}
+	chainTracker.SetChainFetcher(*successor.chainFetcher)
+	successor.ownsChainTracker = true
+	utils.LavaFormatInfo("[+] moved the chain tracker to another endpoint of the chain", utils.LogAttr("from", removed.endpoint.String()), utils.LogAttr("to", successor.endpoint.String()))
+}
+
+// connects to the updated node urls and swaps them into the running endpoint, sessions and the chain parser are kept
+func (rpcp *RPCProvider) rebuildChainRouter(active *activeEndpoint, endpoint *lavasession.RPCProviderEndpoint) error {
+	routerCtx, routerCancel := context.WithCancel(context.Background())
+	chainRouter, err := chainlib.GetChainRouter(routerCtx, rpcp.parallelConnections, endpoint, active.chainParser, rpcp.providerMetricsManager)
+	if err != nil {
+		routerCancel()
+		return err
+	}
+	err = chainlib.NewVerificationsOnlyChainFetcher(routerCtx, chainRouter, active.chainParser, endpoint).Validate(routerCtx)
+	if err != nil {
+		routerCancel()
+		return err
+	}
+	active.chainRouter.swap(chainRouter, routerCancel)
+	rpcp.lock.Lock()
+	active.endpoint = endpoint
+	rpcp.lock.Unlock()
+	return nil
+}
+
+// ReloadEndpointsConfig reads the config file again and applies its endpoints
+func (rpcp *RPCProvider) ReloadEndpointsConfig() error {
+	if rpcp.loadEndpoints == nil {
+		return utils.LavaFormatWarning("endpoints were set by arguments, there is no config file to reload", nil)
+	}
+	endpoints, err := rpcp.loadEndpoints()
+	if err != nil {
+		return utils.LavaFormatError("failed loading endpoints configuration, keeping the current endpoints", err, utils.LogAttr("file", rpcp.configFile))
+	}
+	rpcp.ReloadEndpoints(endpoints)
+	return nil
+}
+
+// waits for a SIGHUP or a change in the config file and reloads the endpoints from it
+func (rpcp *RPCProvider) watchEndpointsConfig(ctx context.Context, reloadSignal <-chan os.Signal) {
+	var lastModTime time.Time
+	var lastSize int64
+	if info, err := os.Stat(rpcp.configFile); err == nil {
+		lastModTime, lastSize = info.ModTime(), info.Size()
+	}
+	var watchTicker <-chan time.Time
+	if rpcp.loadEndpoints != nil && ConfigWatchInterval > 0 {
+		ticker := time.NewTicker(ConfigWatchInterval)
+		defer ticker.Stop()
+		watchTicker = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-reloadSignal:
+			utils.LavaFormatInfo("received SIGHUP, reloading endpoints configuration", utils.LogAttr("file", rpcp.configFile))
+			rpcp.ReloadEndpointsConfig()
+		case <-watchTicker:
+			info, err := os.Stat(rpcp.configFile)
+			if err != nil || (info.ModTime().Equal(lastModTime) && info.Size() == lastSize) {
+				continue
+			}
+			lastModTime, lastSize = info.ModTime(), info.Size()
+			utils.LavaFormatInfo("config file changed, reloading endpoints configuration", utils.LogAttr("file", rpcp.configFile))
+			rpcp.ReloadEndpointsConfig()
+		}
+	}
+}
+
+func (rpcps *RPCProviderServer) inflightRelaysCount() int64 {
+	return atomic.LoadInt64(&rpcps.inflightRelays)
+}
+
+// returns false if relays are still in flight when the timeout passes
+func (rpcps *RPCProviderServer) drain(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for rpcps.inflightRelaysCount() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package rpcprovider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffEndpoints(t *testing.T) {
+	endpoint := func(address, chainID string, urls ...string) *lavasession.RPCProviderEndpoint {
+		nodeUrls := []common.NodeUrl{}
+		for _, url := range urls {
+			nodeUrls = append(nodeUrls, common.NodeUrl{Url: url})
+		}
+		return &lavasession.RPCProviderEndpoint{NetworkAddress: lavasession.NetworkAddressData{Address: address}, ChainID: chainID, ApiInterface: "jsonrpc", NodeUrls: nodeUrls}
+	}
+	current := map[string]*lavasession.RPCProviderEndpoint{}
+	for _, existing := range []*lavasession.RPCProviderEndpoint{
+		endpoint("127.0.0.1:2220", "ETH1", "ws://eth"),
+		endpoint("127.0.0.1:2220", "LAV1", "http://lava"),
+		endpoint("127.0.0.1:2220", "BSC", "http://bsc"),
+		endpoint("127.0.0.1:2220", "POLYGON1", "http://polygon"),
+	} {
+		current[endpointReloadKey(existing)] = existing
+	}
+
+	moved := endpoint("127.0.0.1:2221", "POLYGON1", "http://polygon")
+	withAddon := endpoint("127.0.0.1:2220", "BSC", "http://bsc")
+	withAddon.NodeUrls[0].Addons = []string{"archive"}
+	updated := []*lavasession.RPCProviderEndpoint{
+		endpoint("127.0.0.1:2220", "ETH1", "ws://eth"),                    // untouched
+		endpoint("127.0.0.1:2220", "LAV1", "http://lava", "http://lava2"), // node urls changed
+		withAddon,                             // addons change the chain parser policy
+		moved,                                 // a new address is a different endpoint
+		endpoint("", "COS3", "http://cosmos"), // new, shares the previous address
+	}
+	fillSharedNetworkAddresses(updated)
+	require.Equal(t, "127.0.0.1:2221", updated[4].NetworkAddress.Address)
+
+	added, removed, nodeUrlsChanged := diffEndpoints(current, updated)
+	require.ElementsMatch(t, []*lavasession.RPCProviderEndpoint{withAddon, moved, updated[4]}, added)
+	require.ElementsMatch(t, []*lavasession.RPCProviderEndpoint{current[endpointReloadKey(withAddon)], current["127.0.0.1:2220_POLYGON1jsonrpc"]}, removed)
+	require.Equal(t, []*lavasession.RPCProviderEndpoint{updated[1]}, nodeUrlsChanged)
+}
+
+type mockChainRouter struct {
+	name string
+}
+
+func (mcr *mockChainRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
+	return &pairingtypes.RelayReply{Data: []byte(mcr.name)}, "", nil, common.NodeUrl{}, "", nil
+}
+
+func (mcr *mockChainRouter) ExtensionsSupported(extensions []string) bool {
+	return true
+}
+
+func TestReloadableChainRouter(t *testing.T) {
+	EndpointDrainTimeout = 10 * time.Millisecond
+	defer func() { EndpointDrainTimeout = 30 * time.Second }()
+	firstCtx, firstCancel := context.WithCancel(context.Background())
+	router := newReloadableChainRouter(&mockChainRouter{name: "first"}, firstCancel)
+	reply, _, _, _, _, err := router.SendNodeMsg(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "first", string(reply.Data))
+
+	secondCtx, secondCancel := context.WithCancel(context.Background())
+	router.swap(&mockChainRouter{name: "second"}, secondCancel)
+	reply, _, _, _, _, err = router.SendNodeMsg(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(reply.Data))
+	// the previous connections are closed after the drain timeout
+	require.NoError(t, firstCtx.Err())
+	require.Eventually(t, func() bool { return firstCtx.Err() != nil }, time.Second, time.Millisecond)
+
+	router.close()
+	require.Error(t, secondCtx.Err())
+}
+
+func TestProviderServerDrain(t *testing.T) {
+	server := &RPCProviderServer{}
+	require.True(t, server.drain(time.Millisecond))
+	atomic.AddInt64(&server.inflightRelays, 1)
+	require.False(t, server.drain(time.Millisecond))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt64(&server.inflightRelays, -1)
+	}()
+	require.True(t, server.drain(time.Second))
+}
+
+type mockChainFetcher struct {
+	endpoint *lavasession.RPCProviderEndpoint
+	fetches  atomic.Int64
+}
+
+func (mcf *mockChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error) {
+	mcf.fetches.Add(1)
+	return 100, nil
+}
+
+func (mcf *mockChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
+	return fmt.Sprintf("hash-%d", blockNum), nil
+}
+
+func (mcf *mockChainFetcher) FetchEndpoint() lavasession.RPCProviderEndpoint {
+	return *mcf.endpoint
+}
+
+func (mcf *mockChainFetcher) Validate(ctx context.Context) error {
+	return nil
+}
+
+func TestHandOverChainTracker(t *testing.T) {
+	rand.InitRandomSeed()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	newActiveEndpoint := func(apiInterface string) (*activeEndpoint, *mockChainFetcher) {
+		endpoint := &lavasession.RPCProviderEndpoint{NetworkAddress: lavasession.NetworkAddressData{Address: "127.0.0.1:2220"}, ChainID: "LAV1", ApiInterface: apiInterface}
+		fetcher := &mockChainFetcher{endpoint: endpoint}
+		var chainFetcher chainlib.ChainFetcherIf = fetcher
+		return &activeEndpoint{endpoint: endpoint, chainFetcher: &chainFetcher}, fetcher
+	}
+	owner, ownerFetcher := newActiveEndpoint("tendermintrpc")
+	other, otherFetcher := newActiveEndpoint("rest")
+	owner.ownsChainTracker = true
+	chainTracker, err := chaintracker.NewChainTracker(ctx, ownerFetcher, chaintracker.ChainTrackerConfig{BlocksToSave: 1, AverageBlockTime: 10 * time.Millisecond})
+	require.NoError(t, err)
+	rpcp := &RPCProvider{
+		chainTrackers:   &ChainTrackers{},
+		chainMutexes:    map[string]*sync.Mutex{},
+		activeEndpoints: map[string]*activeEndpoint{endpointReloadKey(other.endpoint): other},
+	}
+	rpcp.chainTrackers.SetTrackerForChain("LAV1", chainTracker)
+
+	// the owner is removed, the tracker polls through the chain's other endpoint
+	rpcp.handOverChainTracker(owner)
+	require.False(t, owner.ownsChainTracker)
+	require.True(t, other.ownsChainTracker)
+	fetches := otherFetcher.fetches.Load()
+	require.Eventually(t, func() bool { return otherFetcher.fetches.Load() > fetches }, time.Second, time.Millisecond)
+	ownerFetches := ownerFetcher.fetches.Load()
+
+	// the last endpoint of the chain is removed, the tracker is paused until an endpoint is set up
+	rpcp.popActiveEndpoint(other.endpoint)
+	rpcp.handOverChainTracker(other)
+	require.False(t, other.ownsChainTracker)
+	require.True(t, chainTracker.IsPaused())
+	time.Sleep(20 * time.Millisecond)
+	fetches = otherFetcher.fetches.Load()
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, fetches, otherFetcher.fetches.Load())
+	require.Equal(t, ownerFetches, ownerFetcher.fetches.Load())
+}
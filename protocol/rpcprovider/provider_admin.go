@@ -0,0 +1,276 @@
+package rpcprovider
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	AdminListenAddressFlagName = "admin-listen-address"
+	AdminTokenFlagName         = "admin-token"
+	AdminTokenEnv              = "LAVA_PROVIDER_ADMIN_TOKEN"
+	AdminStatusPath            = "/status"
+	AdminReloadPath            = "/reload"
+	adminRequestTimeout        = 2 * time.Minute // reloads wait for removed endpoints to drain
+)
+
+// EndpointStatus describes a configured endpoint, its chain tracker and the sessions of its consumers
+type EndpointStatus struct {
+	ChainID         string                              `json:"chain_id"`
+	ApiInterface    string                              `json:"api_interface"`
+	NetworkAddress  string                              `json:"network_address"`
+	NodeUrls        []string                            `json:"node_urls"`
+	Active          bool                                `json:"active"`  // false while the endpoint failed setting up and is retried
+	Enabled         bool                                `json:"enabled"` // false while spec verifications fail
+	InflightRelays  int64                               `json:"inflight_relays"`
+	LatestBlock     int64                               `json:"latest_block"`
+	LatestBlockTime time.Time                           `json:"latest_block_time"`
+	Sessions        *lavasession.ProviderSessionsReport `json:"sessions,omitempty"`
+}
+
+// ProviderStatus is what the admin api returns for the status path
+type ProviderStatus struct {
+	Provider  string                     `json:"provider"`
+	Endpoints []EndpointStatus           `json:"endpoints"`
+	Rewards   rewardserver.RewardsReport `json:"rewards"`
+}
+
+type providerAdminSource interface {
+	Status(chainID, apiInterface, consumer string) ProviderStatus
+	ReloadEndpointsConfig() error
+}
+
+// ProviderAdmin serves the provider's live state to its operator, every request must carry the admin token
+type ProviderAdmin struct {
+	source providerAdminSource
+	token  string // may reference a secret, resolved on every request so it can be rotated
+}
+
+func NewProviderAdmin(source providerAdminSource, token string) *ProviderAdmin {
+	return &ProviderAdmin{source: source, token: token}
+}
+
+func (pa *ProviderAdmin) authorized(r *http.Request) bool {
+	token, err := common.ResolveSecret(pa.token)
+	if err != nil || token == "" {
+		return false
+	}
+	requestToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(requestToken), []byte(token)) == 1
+}
+
+// ServeHTTP returns the status filtered by the spec, api-interface and consumer query parameters, or reloads the endpoints config
+func (pa *ProviderAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !pa.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch r.URL.Path {
+	case AdminStatusPath:
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		status := pa.source.Status(query.Get("spec"), query.Get("api-interface"), query.Get("consumer"))
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(status)
+		if err != nil {
+			utils.LavaFormatWarning("failed writing provider admin response", err)
+		}
+	case AdminReloadPath:
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		err := pa.source.ReloadEndpointsConfig()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Serve listens for admin requests until the context is done
+func (pa *ProviderAdmin) Serve(ctx context.Context, address string) {
+	server := &http.Server{Addr: address, Handler: pa, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+	utils.LavaFormatInfo("provider admin api listening", utils.Attribute{Key: "address", Value: address})
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		utils.LavaFormatError("provider admin api failed", err, utils.Attribute{Key: "address", Value: address})
+	}
+}
+
+// Status returns the endpoints matching the spec and api interface, empty filters match all endpoints.
+// sessions and rewards are filtered by consumer address when it isn't empty
+func (rpcp *RPCProvider) Status(chainID, apiInterface, consumer string) ProviderStatus {
+	rpcp.lock.Lock()
+	configured := make([]*lavasession.RPCProviderEndpoint, 0, len(rpcp.configuredEndpoints))
+	for _, endpoint := range rpcp.configuredEndpoints {
+		configured = append(configured, endpoint)
+	}
+	actives := make(map[string]*activeEndpoint, len(rpcp.activeEndpoints))
+	for key, active := range rpcp.activeEndpoints {
+		actives[key] = active
+	}
+	rpcp.lock.Unlock()
+
+	status := ProviderStatus{Provider: rpcp.addr.String(), Endpoints: []EndpointStatus{}}
+	for _, endpoint := range configured {
+		if (chainID != "" && endpoint.ChainID != chainID) || (apiInterface != "" && endpoint.ApiInterface != apiInterface) {
+			continue
+		}
+		endpointStatus := EndpointStatus{ChainID: endpoint.ChainID, ApiInterface: endpoint.ApiInterface, NetworkAddress: endpoint.NetworkAddress.Address, NodeUrls: []string{}}
+		for _, nodeUrl := range endpoint.NodeUrls {
+			endpointStatus.NodeUrls = append(endpointStatus.NodeUrls, nodeUrl.String())
+		}
+		if chainTracker, found := rpcp.chainTrackers.GetTrackerPerChain(endpoint.ChainID); found {
+			endpointStatus.LatestBlock, endpointStatus.LatestBlockTime = chainTracker.GetLatestBlockNum()
+		}
+		if active, ok := actives[endpointReloadKey(endpoint)]; ok {
+			endpointStatus.Active = true
+			endpointStatus.Enabled = active.listener.receiverEnabled(endpoint)
+			endpointStatus.InflightRelays = active.server.inflightRelaysCount()
+			sessions := active.sessionManager.Report(consumer)
+			endpointStatus.Sessions = &sessions
+		}
+		status.Endpoints = append(status.Endpoints, endpointStatus)
+	}
+	sort.Slice(status.Endpoints, func(i, j int) bool {
+		return status.Endpoints[i].NetworkAddress+status.Endpoints[i].ChainID+status.Endpoints[i].ApiInterface <
+			status.Endpoints[j].NetworkAddress+status.Endpoints[j].ChainID+status.Endpoints[j].ApiInterface
+	})
+	status.Rewards = rpcp.rewardServer.Report(consumer)
+	return status
+}
+
+func adminRequest(method, address, path string, query url.Values, token string) ([]byte, error) {
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), adminRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, address+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("provider admin api returned status %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+func adminTokenFromFlags(cmd *cobra.Command) (string, error) {
+	token, err := cmd.Flags().GetString(AdminTokenFlagName)
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		token = os.Getenv(AdminTokenEnv)
+	}
+	return common.ResolveSecret(token)
+}
+
+func CreateProviderStatusCobraCommand() *cobra.Command {
+	cmdProviderStatus := &cobra.Command{
+		Use:   `status admin-address [--spec chain-id] [--api-interface api-interface] [--consumer consumer-address]`,
+		Short: `query the admin api of a running rpcprovider for its endpoints, sessions and rewards`,
+		Long: `query the admin api of a running rpcprovider (started with --` + AdminListenAddressFlagName + `) and print its status as json:
+		the endpoints with their chain tracker status, the sessions and compute units of the paired consumers per epoch, their active subscriptions
+		and the pending and claimed rewards per consumer and epoch. the admin token is read from --` + AdminTokenFlagName + ` or ` + AdminTokenEnv,
+		Example: `lavap provider status 127.0.0.1:7780
+lavap provider status 127.0.0.1:7780 --spec ETH1 --consumer lava@1abc...`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := adminTokenFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			query := url.Values{}
+			for _, filter := range []string{"spec", "api-interface", "consumer"} {
+				value, err := cmd.Flags().GetString(filter)
+				if err != nil {
+					return err
+				}
+				if value != "" {
+					query.Set(filter, value)
+				}
+			}
+			body, err := adminRequest(http.MethodGet, args[0], AdminStatusPath, query, token)
+			if err != nil {
+				return err
+			}
+			var status ProviderStatus
+			err = json.Unmarshal(body, &status)
+			if err != nil {
+				return err
+			}
+			output, err := json.MarshalIndent(status, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(output))
+			return nil
+		},
+	}
+	cmdProviderStatus.Flags().String(AdminTokenFlagName, "", "the admin api token, can reference a secret such as env://NAME or file:///path")
+	cmdProviderStatus.Flags().String("spec", "", "only show endpoints of this chain id")
+	cmdProviderStatus.Flags().String("api-interface", "", "only show endpoints of this api interface")
+	cmdProviderStatus.Flags().String("consumer", "", "only show sessions and rewards of this consumer address")
+	return cmdProviderStatus
+}
+
+func CreateProviderReloadCobraCommand() *cobra.Command {
+	cmdProviderReload := &cobra.Command{
+		Use:     `reload admin-address`,
+		Short:   `make a running rpcprovider reload the endpoints of its config file`,
+		Example: `lavap provider reload 127.0.0.1:7780`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			token, err := adminTokenFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = adminRequest(http.MethodPost, args[0], AdminReloadPath, url.Values{}, token)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "endpoints configuration reloaded")
+			return nil
+		},
+	}
+	cmdProviderReload.Flags().String(AdminTokenFlagName, "", "the admin api token, can reference a secret such as env://NAME or file:///path")
+	return cmdProviderReload
+}
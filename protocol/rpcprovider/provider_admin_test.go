@@ -0,0 +1,63 @@
+package rpcprovider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
+	"github.com/stretchr/testify/require"
+)
+
+type adminSourceMock struct {
+	queries []string
+	reloads int
+}
+
+func (asm *adminSourceMock) Status(chainID, apiInterface, consumer string) ProviderStatus {
+	asm.queries = append(asm.queries, chainID+"|"+apiInterface+"|"+consumer)
+	return ProviderStatus{
+		Provider:  "lava@provider",
+		Endpoints: []EndpointStatus{{ChainID: "ETH1", ApiInterface: "jsonrpc", Active: true, Enabled: true, LatestBlock: 100}},
+		Rewards:   rewardserver.RewardsReport{Pending: []rewardserver.ConsumerRewardsReport{{Epoch: 20, Consumer: consumer, ChainID: "ETH1", Proofs: 1, CU: 10}}},
+	}
+}
+
+func (asm *adminSourceMock) ReloadEndpointsConfig() error {
+	asm.reloads++
+	return nil
+}
+
+func TestProviderAdmin(t *testing.T) {
+	t.Setenv("LAVA_TEST_ADMIN_TOKEN", "secret-token")
+	source := &adminSourceMock{}
+	server := httptest.NewServer(NewProviderAdmin(source, "env://LAVA_TEST_ADMIN_TOKEN"))
+	defer server.Close()
+
+	// requests without the token are rejected
+	_, err := adminRequest(http.MethodGet, server.URL, AdminStatusPath, url.Values{}, "wrong-token")
+	require.ErrorContains(t, err, "401")
+	require.Empty(t, source.queries)
+
+	body, err := adminRequest(http.MethodGet, server.URL, AdminStatusPath, url.Values{"spec": {"ETH1"}, "consumer": {"lava@consumer"}}, "secret-token")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ETH1||lava@consumer"}, source.queries)
+	var status ProviderStatus
+	require.NoError(t, json.Unmarshal(body, &status))
+	require.Equal(t, source.Status("ETH1", "", "lava@consumer"), status)
+
+	// reloads must be posted
+	_, err = adminRequest(http.MethodGet, server.URL, AdminReloadPath, url.Values{}, "secret-token")
+	require.ErrorContains(t, err, "405")
+	_, err = adminRequest(http.MethodPost, server.URL, AdminReloadPath, url.Values{}, "secret-token")
+	require.NoError(t, err)
+	require.Equal(t, 1, source.reloads)
+
+	// an unset token disables access instead of allowing everyone
+	unset := httptest.NewServer(NewProviderAdmin(source, "env://LAVA_TEST_ADMIN_TOKEN_UNSET"))
+	defer unset.Close()
+	_, err = adminRequest(http.MethodGet, unset.URL, AdminStatusPath, url.Values{}, "")
+	require.ErrorContains(t, err, "401")
+}
@@ -49,6 +49,23 @@ func (pl *ProviderListener) RegisterReceiver(existingReceiver RelayReceiver, end
 	return nil
 }
 
+// new relays to an unregistered endpoint fail as unhandled so consumers move to other providers, relays in flight are unaffected
+func (pl *ProviderListener) UnregisterReceiver(endpoint *lavasession.RPCProviderEndpoint) {
+	listen_endpoint := lavasession.RPCEndpoint{ChainID: endpoint.ChainID, ApiInterface: endpoint.ApiInterface}
+	pl.relayServer.lock.Lock()
+	defer pl.relayServer.lock.Unlock()
+	delete(pl.relayServer.relayReceivers, listen_endpoint.Key())
+	utils.LavaFormatInfo("[--] Provider stopped listening on Address", utils.Attribute{Key: "chainID", Value: endpoint.ChainID}, utils.Attribute{Key: "apiInterface", Value: endpoint.ApiInterface}, utils.Attribute{Key: "Address", Value: endpoint.NetworkAddress})
+}
+
+func (pl *ProviderListener) receiverEnabled(endpoint *lavasession.RPCProviderEndpoint) bool {
+	listen_endpoint := lavasession.RPCEndpoint{ChainID: endpoint.ChainID, ApiInterface: endpoint.ApiInterface}
+	pl.relayServer.lock.RLock()
+	defer pl.relayServer.lock.RUnlock()
+	relayReceiver, ok := pl.relayServer.relayReceivers[listen_endpoint.Key()]
+	return ok && relayReceiver.enabled
+}
+
 func (pl *ProviderListener) Shutdown(shutdownCtx context.Context) error {
 	if err := pl.httpServer.Shutdown(shutdownCtx); err != nil {
 		utils.LavaFormatFatal("Provider failed to shutdown", err)
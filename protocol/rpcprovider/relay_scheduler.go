@@ -0,0 +1,227 @@
+package rpcprovider
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/lavasession"
+)
+
+const (
+	ConsumerRateLimitFlagName      = "consumer-rate-limit"
+	ConsumerRateLimitBurstFlagName = "consumer-rate-limit-burst"
+	RelayQueueSizeFlagName         = "relay-queue-size"
+
+	DefaultRelayQueueSize       = 100
+	relaySchedulerPruneInterval = time.Minute
+)
+
+var (
+	ConsumerRateLimit      float64 = 0 // compute units per second allowed for each project, 0 disables rate limiting
+	ConsumerRateLimitBurst float64 = 0 // compute units a project can use at once, defaults to a second worth of its rate
+	RelayQueueSize         uint    = DefaultRelayQueueSize
+)
+
+// projectSchedule holds the scheduling state of a single project (consumer)
+type projectSchedule struct {
+	tokens     float64 // token bucket of compute units
+	lastRefill time.Time
+	lastFinish float64 // virtual finish time of the last relay queued by this project
+	inflight   int
+	queue      []*queuedRelay
+}
+
+func (ps *projectSchedule) refill(now time.Time, rate, burst float64) {
+	ps.tokens = math.Min(burst, ps.tokens+now.Sub(ps.lastRefill).Seconds()*rate)
+	ps.lastRefill = now
+}
+
+type queuedRelay struct {
+	project *projectSchedule
+	cu      uint64
+	start   float64
+	finish  float64
+	granted bool
+	ready   chan struct{}
+}
+
+// RelayScheduler sits in front of the node connections of an endpoint, it limits the compute units every project can use per second
+// and shares the node connections between projects in proportion to their epoch compute units when they are contended (weighted fair queueing)
+type RelayScheduler struct {
+	lock        sync.Mutex
+	capacity    int
+	inflight    int
+	queued      int
+	virtualTime float64
+	projects    map[string]*projectSchedule
+	rateLimit   float64
+	burst       float64
+	queueSize   int
+	lastPrune   time.Time
+}
+
+func NewRelayScheduler(capacity uint, rateLimit, burst float64, queueSize uint) *RelayScheduler {
+	if capacity == 0 {
+		capacity = 1
+	}
+	if burst < rateLimit {
+		burst = rateLimit
+	}
+	return &RelayScheduler{
+		capacity:  int(capacity),
+		projects:  map[string]*projectSchedule{},
+		rateLimit: rateLimit,
+		burst:     burst,
+		queueSize: int(queueSize),
+		lastPrune: time.Now(),
+	}
+}
+
+// Admit blocks until the relay can be sent to the node or fails with ProviderOverloadedError, release must be called once the relay is done
+func (rs *RelayScheduler) Admit(ctx context.Context, projectID string, cu, weight uint64) (release func(), err error) {
+	if rs == nil {
+		return func() {}, nil
+	}
+	now := time.Now()
+	rs.lock.Lock()
+	rs.prune(now)
+	project, ok := rs.projects[projectID]
+	if !ok {
+		project = &projectSchedule{tokens: rs.burst, lastRefill: now}
+		rs.projects[projectID] = project
+	}
+	if rs.rateLimit > 0 {
+		project.refill(now, rs.rateLimit, rs.burst)
+		if project.tokens < float64(cu) {
+			rs.lock.Unlock()
+			return nil, sdkerrors.Wrapf(lavasession.ProviderOverloadedError, "project %s exceeded the rate limit of %v compute units per second", projectID, rs.rateLimit)
+		}
+	}
+	if rs.queued == 0 && rs.inflight < rs.capacity {
+		rs.consumeTokens(project, cu)
+		rs.inflight++
+		project.inflight++
+		rs.lock.Unlock()
+		return rs.releaser(project), nil
+	}
+	if len(project.queue) >= rs.queueSize {
+		rs.lock.Unlock()
+		return nil, sdkerrors.Wrapf(lavasession.ProviderOverloadedError, "relay queue of project %s is full", projectID)
+	}
+	rs.consumeTokens(project, cu)
+	if weight == 0 {
+		weight = 1
+	}
+	start := math.Max(rs.virtualTime, project.lastFinish)
+	relay := &queuedRelay{project: project, cu: cu, start: start, finish: start + float64(cu)/float64(weight), ready: make(chan struct{})}
+	project.lastFinish = relay.finish
+	project.queue = append(project.queue, relay)
+	rs.queued++
+	rs.lock.Unlock()
+
+	select {
+	case <-relay.ready:
+		return rs.releaser(project), nil
+	case <-ctx.Done():
+	}
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	if relay.granted {
+		// granted right as the context ended, hand the slot to the next relay
+		rs.releaseLocked(project)
+	} else {
+		rs.removeQueued(relay)
+	}
+	// the relay never reached the node, so it doesn't count against the project
+	rs.returnTokens(relay)
+	return nil, sdkerrors.Wrapf(lavasession.ProviderOverloadedError, "relay timed out in queue: %s", ctx.Err())
+}
+
+func (rs *RelayScheduler) consumeTokens(project *projectSchedule, cu uint64) {
+	if rs.rateLimit > 0 {
+		project.tokens -= float64(cu)
+	}
+}
+
+// must be called while locked
+func (rs *RelayScheduler) returnTokens(relay *queuedRelay) {
+	project := relay.project
+	if rs.rateLimit > 0 {
+		project.tokens = math.Min(rs.burst, project.tokens+float64(relay.cu))
+	}
+	if project.lastFinish == relay.finish {
+		project.lastFinish = relay.start
+	}
+}
+
+func (rs *RelayScheduler) releaser(project *projectSchedule) func() {
+	once := sync.Once{}
+	return func() {
+		once.Do(func() {
+			rs.lock.Lock()
+			defer rs.lock.Unlock()
+			rs.releaseLocked(project)
+		})
+	}
+}
+
+// must be called while locked
+func (rs *RelayScheduler) releaseLocked(project *projectSchedule) {
+	rs.inflight--
+	project.inflight--
+	rs.dispatch()
+}
+
+// grants free slots to the queued relays with the earliest virtual finish time, must be called while locked
+func (rs *RelayScheduler) dispatch() {
+	for rs.inflight < rs.capacity && rs.queued > 0 {
+		var next *queuedRelay
+		for _, project := range rs.projects {
+			if len(project.queue) > 0 && (next == nil || project.queue[0].finish < next.finish) {
+				next = project.queue[0]
+			}
+		}
+		next.project.queue = next.project.queue[1:]
+		rs.queued--
+		rs.virtualTime = math.Max(rs.virtualTime, next.start)
+		rs.inflight++
+		next.project.inflight++
+		next.granted = true
+		close(next.ready)
+	}
+}
+
+// must be called while locked
+func (rs *RelayScheduler) removeQueued(relay *queuedRelay) {
+	queue := relay.project.queue
+	for idx := range queue {
+		if queue[idx] == relay {
+			relay.project.queue = append(queue[:idx], queue[idx+1:]...)
+			rs.queued--
+			return
+		}
+	}
+}
+
+// forgets idle projects whose bucket is full again, must be called while locked
+func (rs *RelayScheduler) prune(now time.Time) {
+	if now.Sub(rs.lastPrune) < relaySchedulerPruneInterval {
+		return
+	}
+	rs.lastPrune = now
+	for projectID, project := range rs.projects {
+		if project.inflight > 0 || len(project.queue) > 0 || (rs.queued > 0 && project.lastFinish > rs.virtualTime) {
+			continue
+		}
+		if rs.rateLimit > 0 {
+			project.refill(now, rs.rateLimit, rs.burst)
+			if project.tokens < rs.burst {
+				continue
+			}
+		}
+		delete(rs.projects, projectID)
+	}
+}
@@ -0,0 +1,131 @@
+package rpcprovider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelaySchedulerRateLimit(t *testing.T) {
+	scheduler := NewRelayScheduler(10, 100, 200, DefaultRelayQueueSize)
+	for i := 0; i < 20; i++ {
+		release, err := scheduler.Admit(context.Background(), "noisy", 10, 1)
+		require.NoError(t, err)
+		release()
+	}
+	// the burst is used up
+	_, err := scheduler.Admit(context.Background(), "noisy", 10, 1)
+	require.True(t, lavasession.ProviderOverloadedError.Is(err))
+	// other projects have their own bucket
+	release, err := scheduler.Admit(context.Background(), "quiet", 10, 1)
+	require.NoError(t, err)
+	release()
+
+	// the bucket refills over time
+	scheduler.projects["noisy"].lastRefill = time.Now().Add(-time.Second)
+	release, err = scheduler.Admit(context.Background(), "noisy", 10, 1)
+	require.NoError(t, err)
+	release()
+}
+
+func TestRelaySchedulerFairQueue(t *testing.T) {
+	scheduler := NewRelayScheduler(1, 0, 0, 5)
+	// occupy the only connection so the following relays queue
+	release, err := scheduler.Admit(context.Background(), "noisy", 10, 1)
+	require.NoError(t, err)
+
+	order := make(chan string, 20)
+	admit := func(projectID string, weight uint64) {
+		release, err := scheduler.Admit(context.Background(), projectID, 10, weight)
+		if err != nil {
+			order <- "rejected " + projectID
+			return
+		}
+		order <- projectID
+		release()
+	}
+	waitQueued := func(queued int) {
+		require.Eventually(t, func() bool {
+			scheduler.lock.Lock()
+			defer scheduler.lock.Unlock()
+			return scheduler.queued == queued
+		}, time.Second, time.Millisecond)
+	}
+	for i := 0; i < 5; i++ {
+		go admit("noisy", 1)
+		waitQueued(i + 1)
+	}
+	// the queue of a project is bounded
+	admit("noisy", 1)
+	require.Equal(t, "rejected noisy", <-order)
+
+	go admit("quiet", 1)
+	waitQueued(6)
+	release()
+	// the quiet project overtakes the noisy one's backlog, it ties with the noisy project's first queued relay
+	served := []string{}
+	for i := 0; i < 6; i++ {
+		served = append(served, <-order)
+	}
+	require.Contains(t, served[:2], "quiet")
+	require.NotContains(t, served[2:], "quiet")
+}
+
+func TestRelaySchedulerQueueTimeout(t *testing.T) {
+	scheduler := NewRelayScheduler(1, 0, 0, DefaultRelayQueueSize)
+	release, err := scheduler.Admit(context.Background(), "project", 10, 1)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = scheduler.Admit(ctx, "project", 10, 1)
+	require.True(t, lavasession.ProviderOverloadedError.Is(err))
+	require.Zero(t, scheduler.queued)
+	release()
+
+	// the slot is free again
+	release, err = scheduler.Admit(context.Background(), "project", 10, 1)
+	require.NoError(t, err)
+	release()
+
+	// nil scheduler admits everything
+	var disabled *RelayScheduler
+	release, err = disabled.Admit(context.Background(), "project", 10, 1)
+	require.NoError(t, err)
+	release()
+}
+
+func TestRelaySchedulerQueueTimeoutReturnsTokens(t *testing.T) {
+	scheduler := NewRelayScheduler(1, 100, 100, DefaultRelayQueueSize)
+	release, err := scheduler.Admit(context.Background(), "busy", 10, 1)
+	require.NoError(t, err)
+	// a burst of relays that time out in the queue never reached the node
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		_, err = scheduler.Admit(ctx, "project", 10, 1)
+		cancel()
+		require.True(t, lavasession.ProviderOverloadedError.Is(err))
+	}
+	release()
+	scheduler.lock.Lock()
+	require.InDelta(t, 100, scheduler.projects["project"].tokens, 1)
+	scheduler.lock.Unlock()
+	release, err = scheduler.Admit(context.Background(), "project", 10, 1)
+	require.NoError(t, err)
+	release()
+}
+
+func TestRelayFailureKeepsOverloadedCode(t *testing.T) {
+	rpcps := &RPCProviderServer{}
+	// a session failure that errors doesn't hide the overloaded code from the consumer
+	overloaded := sdkerrors.Wrapf(lavasession.ProviderOverloadedError, "relay queue of project %s is full", "project")
+	err := rpcps.handleRelayErrorStatus(wrapRelayFailureError(overloaded, lavasession.SessionOutOfSyncError))
+	require.True(t, lavasession.IsProviderOverloaded(err))
+
+	err = rpcps.handleRelayErrorStatus(wrapRelayFailureError(fmt.Errorf("node error"), lavasession.SessionOutOfSyncError))
+	require.True(t, lavasession.IsSessionSyncLoss(err))
+}
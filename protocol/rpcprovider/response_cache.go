@@ -0,0 +1,117 @@
+package rpcprovider
+
+import (
+	"container/list"
+	"strconv"
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/protocopy"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	ResponseCacheSizeFlagName  = "response-cache-size-mb"
+	responseCacheEntryOverhead = 256 // rough bytes held by an entry besides its data
+)
+
+// memory of the in process response cache of every chain in megabytes, 0 disables it
+var ResponseCacheSizeMB uint64 = 0
+
+type responseCacheEntry struct {
+	key              string
+	reply            *pairingtypes.RelayReply
+	optionalMetadata []pairingtypes.Metadata
+	size             uint64
+}
+
+// ResponseCache keeps the latest used finalized responses of a chain in memory in front of the remote relay cache,
+// finalized data never changes so entries are only evicted to keep the cache within its memory bound
+type ResponseCache struct {
+	lock    sync.Mutex
+	maxSize uint64
+	size    uint64
+	entries map[string]*list.Element
+	order   *list.List // front is the most recently used
+}
+
+// returns nil when maxSize is 0, a nil cache never hits
+func NewResponseCache(maxSize uint64) *ResponseCache {
+	if maxSize == 0 {
+		return nil
+	}
+	return &ResponseCache{maxSize: maxSize, entries: map[string]*list.Element{}, order: list.New()}
+}
+
+func responseCacheKey(requestHash []byte, requestedBlock int64) string {
+	return string(requestHash) + "_" + strconv.FormatInt(requestedBlock, 10)
+}
+
+// returns a copy of the cached reply, the caller may modify it
+func (rc *ResponseCache) Get(requestHash []byte, requestedBlock int64) (reply *pairingtypes.RelayReply, optionalMetadata []pairingtypes.Metadata, found bool) {
+	if rc == nil {
+		return nil, nil, false
+	}
+	rc.lock.Lock()
+	element, ok := rc.entries[responseCacheKey(requestHash, requestedBlock)]
+	if !ok {
+		rc.lock.Unlock()
+		return nil, nil, false
+	}
+	rc.order.MoveToFront(element)
+	entry := element.Value.(*responseCacheEntry)
+	rc.lock.Unlock()
+
+	// entries are never modified once stored so they can be copied without the lock
+	reply = &pairingtypes.RelayReply{}
+	err := protocopy.DeepCopyProtoObject(entry.reply, reply)
+	if err != nil {
+		utils.LavaFormatError("failed copying cached reply", err)
+		return nil, nil, false
+	}
+	return reply, append([]pairingtypes.Metadata{}, entry.optionalMetadata...), true
+}
+
+// stores a copy of the reply, evicting the least recently used entries if the cache is full
+func (rc *ResponseCache) Set(requestHash []byte, requestedBlock int64, reply *pairingtypes.RelayReply, optionalMetadata []pairingtypes.Metadata) {
+	if rc == nil || reply == nil {
+		return
+	}
+	key := responseCacheKey(requestHash, requestedBlock)
+	size := uint64(len(key)+len(reply.Data)+responseCacheEntryOverhead) + metadataSize(reply.Metadata) + metadataSize(optionalMetadata)
+	if size > rc.maxSize {
+		return
+	}
+	copyReply := &pairingtypes.RelayReply{}
+	err := protocopy.DeepCopyProtoObject(reply, copyReply)
+	if err != nil {
+		utils.LavaFormatError("failed copying reply for the response cache", err)
+		return
+	}
+	entry := &responseCacheEntry{key: key, reply: copyReply, optionalMetadata: append([]pairingtypes.Metadata{}, optionalMetadata...), size: size}
+
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	if element, ok := rc.entries[key]; ok {
+		rc.remove(element)
+	}
+	for rc.size+size > rc.maxSize {
+		rc.remove(rc.order.Back())
+	}
+	rc.entries[key] = rc.order.PushFront(entry)
+	rc.size += size
+}
+
+// must be called while locked
+func (rc *ResponseCache) remove(element *list.Element) {
+	entry := rc.order.Remove(element).(*responseCacheEntry)
+	delete(rc.entries, entry.key)
+	rc.size -= entry.size
+}
+
+func metadataSize(metadata []pairingtypes.Metadata) (size uint64) {
+	for _, meta := range metadata {
+		size += uint64(len(meta.Name) + len(meta.Value))
+	}
+	return size
+}
@@ -0,0 +1,52 @@
+package rpcprovider
+
+import (
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	reply := func(data string) *pairingtypes.RelayReply {
+		return &pairingtypes.RelayReply{Data: []byte(data), Metadata: []pairingtypes.Metadata{{Name: "header", Value: "value"}}}
+	}
+	entrySize := uint64(len(responseCacheKey([]byte("hash1"), 100))+len("data1")+responseCacheEntryOverhead) + metadataSize(reply("").Metadata)
+	responseCache := NewResponseCache(entrySize * 2)
+
+	responseCache.Set([]byte("hash1"), 100, reply("data1"), nil)
+	responseCache.Set([]byte("hash2"), 100, reply("data2"), []pairingtypes.Metadata{})
+	cached, _, found := responseCache.Get([]byte("hash1"), 100)
+	require.True(t, found)
+	require.Equal(t, reply("data1"), cached)
+	// entries are keyed by the requested block as well
+	_, _, found = responseCache.Get([]byte("hash1"), 101)
+	require.False(t, found)
+
+	// the returned reply is a copy
+	cached.Data = []byte("modified")
+	cached, _, _ = responseCache.Get([]byte("hash1"), 100)
+	require.Equal(t, "data1", string(cached.Data))
+
+	// hash2 is the least recently used and is evicted to stay within the memory bound
+	responseCache.Set([]byte("hash3"), 100, reply("data3"), nil)
+	_, _, found = responseCache.Get([]byte("hash2"), 100)
+	require.False(t, found)
+	_, _, found = responseCache.Get([]byte("hash1"), 100)
+	require.True(t, found)
+	_, _, found = responseCache.Get([]byte("hash3"), 100)
+	require.True(t, found)
+	require.LessOrEqual(t, responseCache.size, responseCache.maxSize)
+
+	// entries larger than the whole cache aren't stored
+	responseCache.Set([]byte("hash4"), 100, reply(string(make([]byte, entrySize*2))), nil)
+	_, _, found = responseCache.Get([]byte("hash4"), 100)
+	require.False(t, found)
+
+	// a disabled cache never hits
+	disabled := NewResponseCache(0)
+	require.Nil(t, disabled)
+	disabled.Set([]byte("hash1"), 100, reply("data1"), nil)
+	_, _, found = disabled.Get([]byte("hash1"), 100)
+	require.False(t, found)
+}
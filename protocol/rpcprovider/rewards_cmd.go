@@ -0,0 +1,385 @@
+package rpcprovider
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
+	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	RewardsFromEpochFlagName     = "from-epoch"
+	RewardsToEpochFlagName       = "to-epoch"
+	RewardsSpecFlagName          = "spec"
+	RewardsEarliestEpochFlagName = "earliest-epoch"
+	RewardsBatchSizeFlagName     = "batch-size"
+	RewardsClaimDescription      = "rewards-claim"
+)
+
+// the reward commands work on the reward dbs directly, badger locks them so the provider using them must be stopped
+func addRewardsStorageFlags(cmd *cobra.Command) {
+	cmd.Flags().String(rewardserver.RewardServerStorageFlagName, rewardserver.DefaultRewardServerStorage, "the path the provider stores reward server data in")
+	cmd.Flags().Uint(ShardIDFlagName, DefaultShardID, "the shard id of the provider")
+	cmd.Flags().StringSlice(RewardsSpecFlagName, []string{}, "only use the rewards of these chain ids, all chains when empty")
+}
+
+func addRewardsEpochFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(RewardsFromEpochFlagName, 0, "only use rewards from this epoch on")
+	cmd.Flags().Uint64(RewardsToEpochFlagName, 0, "only use rewards up to this epoch, all epochs when 0")
+}
+
+func addRewardsEarliestEpochFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(RewardsEarliestEpochFlagName, 0, "the earliest epoch that can still be claimed, queried from the node when 0")
+}
+
+type rewardsStorageOptions struct {
+	storagePath string
+	shard       uint
+	specs       []string
+}
+
+func rewardsStorageOptionsFromFlags(cmd *cobra.Command) (rewardsStorageOptions, error) {
+	storagePath, err := cmd.Flags().GetString(rewardserver.RewardServerStorageFlagName)
+	if err != nil {
+		return rewardsStorageOptions{}, err
+	}
+	shard, err := cmd.Flags().GetUint(ShardIDFlagName)
+	if err != nil {
+		return rewardsStorageOptions{}, err
+	}
+	specs, err := cmd.Flags().GetStringSlice(RewardsSpecFlagName)
+	if err != nil {
+		return rewardsStorageOptions{}, err
+	}
+	return rewardsStorageOptions{storagePath: storagePath, shard: shard, specs: specs}, nil
+}
+
+func rewardsEpochRangeFromFlags(cmd *cobra.Command) (fromEpoch, toEpoch uint64, err error) {
+	fromEpoch, err = cmd.Flags().GetUint64(RewardsFromEpochFlagName)
+	if err != nil {
+		return 0, 0, err
+	}
+	toEpoch, err = cmd.Flags().GetUint64(RewardsToEpochFlagName)
+	if err != nil {
+		return 0, 0, err
+	}
+	if toEpoch != 0 && toEpoch < fromEpoch {
+		return 0, 0, fmt.Errorf("--%s %d is before --%s %d", RewardsToEpochFlagName, toEpoch, RewardsFromEpochFlagName, fromEpoch)
+	}
+	return fromEpoch, toEpoch, nil
+}
+
+// openProviderRewardDB opens the reward dbs of the requested specs, or of all the specs the provider stored rewards for
+func openProviderRewardDB(options rewardsStorageOptions, providerAddr string, ttl time.Duration) (*rewardserver.RewardDB, error) {
+	specs := options.specs
+	if len(specs) == 0 {
+		var err error
+		specs, err = rewardserver.LocalDBSpecs(options.storagePath, providerAddr, options.shard)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rewardserver.OpenLocalRewardDB(options.storagePath, providerAddr, options.shard, specs, ttl)
+}
+
+func loadProviderRewards(cmd *cobra.Command, providerAddr string) ([]*rewardserver.RewardEntity, error) {
+	rewardDB, rewards, err := openProviderRewards(cmd, providerAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer rewardDB.Close()
+	return rewards, nil
+}
+
+// openProviderRewards opens the provider's reward db and returns it with the rewards chosen by the flags, the caller closes the db
+func openProviderRewards(cmd *cobra.Command, providerAddr string) (*rewardserver.RewardDB, []*rewardserver.RewardEntity, error) {
+	options, err := rewardsStorageOptionsFromFlags(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromEpoch, toEpoch, err := rewardsEpochRangeFromFlags(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	rewardDB, err := openProviderRewardDB(options, providerAddr, rewardserver.DefaultRewardTTL)
+	if err != nil {
+		return nil, nil, err
+	}
+	rewards, err := rewardDB.FindAllEntities()
+	if err != nil {
+		rewardDB.Close()
+		return nil, nil, err
+	}
+	return rewardDB, rewardserver.FilterRewardEntities(rewards, fromEpoch, toEpoch, options.specs), nil
+}
+
+func earliestClaimableEpoch(cmd *cobra.Command, clientCtx client.Context) (uint64, error) {
+	earliestEpoch, err := cmd.Flags().GetUint64(RewardsEarliestEpochFlagName)
+	if err != nil || earliestEpoch != 0 {
+		return earliestEpoch, err
+	}
+	if clientCtx.Offline {
+		return 0, fmt.Errorf("--%s must be set in offline mode", RewardsEarliestEpochFlagName)
+	}
+	res, err := epochstoragetypes.NewQueryClient(clientCtx).EpochDetails(cmd.Context(), &epochstoragetypes.QueryGetEpochDetailsRequest{})
+	if err != nil {
+		return 0, utils.LavaFormatError("failed querying the earliest epoch in memory, set --"+RewardsEarliestEpochFlagName+" to skip the query", err)
+	}
+	return res.EpochDetails.EarliestStart, nil
+}
+
+// validateRewards returns the rewards that pass validation, invalid rewards are reported and skipped
+func validateRewards(cmd *cobra.Command, rewards []*rewardserver.RewardEntity, providerAddr string, earliestEpoch uint64) []*rewardserver.RewardEntity {
+	valid := []*rewardserver.RewardEntity{}
+	for _, reward := range rewards {
+		err := rewardserver.ValidateRewardEntity(reward, providerAddr, earliestEpoch)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "skipping reward of epoch %d consumer %s session %d: %s\n", reward.Epoch, reward.ConsumerAddr, reward.SessionId, err)
+			continue
+		}
+		valid = append(valid, reward)
+	}
+	return valid
+}
+
+func CreateRewardsListCobraCommand() *cobra.Command {
+	cmdRewardsList := &cobra.Command{
+		Use:   `list provider-address [--spec chain-id] [--from-epoch epoch] [--to-epoch epoch]`,
+		Short: `list the unclaimed proofs stored by a provider`,
+		Long: `list the unclaimed proofs stored in the reward server storage of a provider, per epoch, chain, consumer and session.
+		the provider must be stopped while its storage is read`,
+		Example: `lavap rewards list lava@1abc... --reward-server-storage .storage/rewardserver --spec ETH1`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rewards, err := loadProviderRewards(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(writer, "EPOCH\tCHAIN\tCONSUMER\tSESSION\tCU\tRELAYS")
+			totalCU := uint64(0)
+			for _, reward := range rewards {
+				fmt.Fprintf(writer, "%d\t%s\t%s\t%d\t%d\t%d\n", reward.Epoch, reward.Proof.SpecId, reward.ConsumerAddr, reward.SessionId, reward.Proof.CuSum, reward.Proof.RelayNum)
+				totalCU += reward.Proof.CuSum
+			}
+			err = writer.Flush()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%d proofs, %d cu\n", len(rewards), totalCU)
+			return nil
+		},
+	}
+	addRewardsStorageFlags(cmdRewardsList)
+	addRewardsEpochFlags(cmdRewardsList)
+	return cmdRewardsList
+}
+
+func CreateRewardsExportCobraCommand() *cobra.Command {
+	cmdRewardsExport := &cobra.Command{
+		Use:   `export provider-address output-file [--spec chain-id] [--from-epoch epoch] [--to-epoch epoch]`,
+		Short: `export the unclaimed proofs stored by a provider to a portable file`,
+		Long: `export the unclaimed proofs stored in the reward server storage of a provider to a json file that can be imported
+		on another host, shard or storage path. the provider must be stopped while its storage is read`,
+		Example: `lavap rewards export lava@1abc... rewards.json --reward-server-storage .storage/rewardserver`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			providerAddr := args[0]
+			rewards, err := loadProviderRewards(cmd, providerAddr)
+			if err != nil {
+				return err
+			}
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			err = rewardserver.WriteRewardsExport(file, &rewardserver.RewardsExport{Version: rewardserver.RewardsExportVersion, Provider: providerAddr, Rewards: rewards})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "exported %d proofs to %s\n", len(rewards), args[1])
+			return nil
+		},
+	}
+	addRewardsStorageFlags(cmdRewardsExport)
+	addRewardsEpochFlags(cmdRewardsExport)
+	return cmdRewardsExport
+}
+
+func CreateRewardsImportCobraCommand() *cobra.Command {
+	cmdRewardsImport := &cobra.Command{
+		Use:   `import input-file [--spec chain-id] [--from-epoch epoch] [--to-epoch epoch]`,
+		Short: `import unclaimed proofs from an export file into a provider's reward storage`,
+		Long: `import the proofs of an export file into the reward server storage of the provider that exported them.
+		proofs must be signed by their consumer for the provider and their epoch must still be claimable, invalid proofs are skipped.
+		a proof replaces a stored proof of the same session only when it carries more compute units.
+		the provider must be stopped while its storage is written, it claims the imported proofs once it starts`,
+		Example: `lavap rewards import rewards.json --reward-server-storage .storage/rewardserver --node tcp://127.0.0.1:26657
+lavap rewards import rewards.json --earliest-epoch 1200`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options, err := rewardsStorageOptionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			fromEpoch, toEpoch, err := rewardsEpochRangeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			ttl, err := cmd.Flags().GetDuration(rewardserver.RewardTTLFlagName)
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			export, err := rewardserver.ReadRewardsExport(file)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			earliestEpoch, err := earliestClaimableEpoch(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			rewards := rewardserver.FilterRewardEntities(validateRewards(cmd, export.Rewards, export.Provider, earliestEpoch), fromEpoch, toEpoch, options.specs)
+			if len(rewards) == 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "no rewards to import")
+				return nil
+			}
+			specs := map[string]struct{}{}
+			for _, reward := range rewards {
+				specs[reward.Proof.SpecId] = struct{}{}
+			}
+			options.specs = []string{}
+			for spec := range specs {
+				options.specs = append(options.specs, spec)
+			}
+			rewardDB, err := openProviderRewardDB(options, export.Provider, ttl)
+			if err != nil {
+				return err
+			}
+			defer rewardDB.Close()
+			imported, err := rewardDB.ImportRewards(rewards)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "imported %d of %d proofs of provider %s\n", len(imported), len(export.Rewards), export.Provider)
+			return nil
+		},
+	}
+	addRewardsStorageFlags(cmdRewardsImport)
+	addRewardsEpochFlags(cmdRewardsImport)
+	addRewardsEarliestEpochFlag(cmdRewardsImport)
+	cmdRewardsImport.Flags().Duration(rewardserver.RewardTTLFlagName, rewardserver.DefaultRewardTTL, "reward time to live of the imported proofs")
+	flags.AddQueryFlagsToCmd(cmdRewardsImport)
+	return cmdRewardsImport
+}
+
+func CreateRewardsClaimCobraCommand() *cobra.Command {
+	cmdRewardsClaim := &cobra.Command{
+		Use:   `claim --from provider-key [--spec chain-id] [--from-epoch epoch] [--to-epoch epoch]`,
+		Short: `claim the stored proofs of a provider by sending relay payment transactions`,
+		Long: `build relay payment transactions for the stored proofs of the --from provider in the chosen epoch range and submit them.
+		proofs are validated first and sent in batches of --` + RewardsBatchSizeFlagName + `, one transaction per batch.
+		transactions are broadcast without a confirmation prompt and each one is awaited until it is committed, the proofs
+		it claimed are then removed from the storage so they are not claimed again. the first failing batch stops the claim,
+		its proofs and the following ones stay stored.
+		with --generate-only the unsigned transactions are printed instead, to be signed and broadcast offline
+		(set --` + RewardsEarliestEpochFlagName + ` together with --offline, --account-number and --sequence), the proofs are kept.
+		the provider must be stopped while its storage is used`,
+		Example: `lavap rewards claim --from provider --from-epoch 1200 --to-epoch 1260 --gas-prices 0.1ulava --gas-adjustment 1.5 --gas auto
+lavap rewards claim --from lava@1abc... --generate-only --offline --account-number 7 --sequence 42 --earliest-epoch 1200 > unsigned.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			providerAddr := clientCtx.GetFromAddress().String()
+			batchSize, err := cmd.Flags().GetInt(RewardsBatchSizeFlagName)
+			if err != nil {
+				return err
+			}
+			rewardDB, rewards, err := openProviderRewards(cmd, providerAddr)
+			if err != nil {
+				return err
+			}
+			defer rewardDB.Close()
+			earliestEpoch, err := earliestClaimableEpoch(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			rewards = validateRewards(cmd, rewards, providerAddr, earliestEpoch)
+			if len(rewards) == 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "no rewards to claim")
+				return nil
+			}
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+			batches := rewardserver.BatchRewardEntities(rewards, batchSize)
+			sequence := txf.Sequence()
+			if clientCtx.GenerateOnly {
+				// the generated transactions are signed later, so the sequence is advanced locally
+				for idx, batch := range batches {
+					msg := pairingtypes.NewMsgRelayPayment(providerAddr, rewardserver.RewardProofs(batch), RewardsClaimDescription, nil)
+					err = tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithSequence(sequence+uint64(idx)), msg)
+					if err != nil {
+						return utils.LavaFormatError("failed generating relay payment batch", err, utils.Attribute{Key: "batch", Value: idx}, utils.Attribute{Key: "proofs", Value: len(batch)})
+					}
+				}
+				return nil
+			}
+			txSender, err := statetracker.NewTxSender(cmd.Context(), clientCtx, txf)
+			if err != nil {
+				return err
+			}
+			claimed := 0
+			for idx, batch := range batches {
+				msg := pairingtypes.NewMsgRelayPayment(providerAddr, rewardserver.RewardProofs(batch), RewardsClaimDescription, nil)
+				// a batch is committed before the next one is sent, so a failure leaves the sequence of the next batch unused
+				_, err = txSender.SendTxAndVerifyCommit(txf.WithSequence(sequence+uint64(idx)), msg)
+				if err != nil {
+					return utils.LavaFormatError("failed sending relay payment batch", err, utils.Attribute{Key: "batch", Value: idx}, utils.Attribute{Key: "proofs", Value: len(batch)}, utils.Attribute{Key: "claimed", Value: claimed})
+				}
+				// the proofs are paid, claiming them again would fail
+				err = rewardDB.DeleteRewards(batch)
+				if err != nil {
+					return utils.LavaFormatError("failed removing claimed proofs from the storage", err, utils.Attribute{Key: "batch", Value: idx})
+				}
+				claimed += len(batch)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "claimed %d proofs in %d transactions\n", claimed, len(batches))
+			return nil
+		},
+	}
+	addRewardsStorageFlags(cmdRewardsClaim)
+	addRewardsEpochFlags(cmdRewardsClaim)
+	addRewardsEarliestEpochFlag(cmdRewardsClaim)
+	cmdRewardsClaim.Flags().Int(RewardsBatchSizeFlagName, rewardserver.RelayPaymentMaxProofs, "the max proofs in a single relay payment transaction")
+	flags.AddTxFlagsToCmd(cmdRewardsClaim)
+	cmdRewardsClaim.MarkFlagRequired(flags.FlagFrom)
+	return cmdRewardsClaim
+}
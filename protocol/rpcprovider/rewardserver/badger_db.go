@@ -196,6 +196,14 @@ func NewMemoryDB(specId string) DB {
 }
 
 func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) DB {
+	db, err := OpenLocalDB(storagePath, providerAddr, specId, shard)
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+func OpenLocalDB(storagePath, providerAddr string, specId string, shard uint) (DB, error) {
 	shardString := strconv.FormatUint(uint64(shard), 10)
 	path := filepath.Join(storagePath, providerAddr, specId, shardString)
 	Options := badger.DefaultOptions(path)
@@ -203,7 +211,7 @@ func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) DB
 	Options.Logger = nil
 	db, err := badger.Open(Options)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	return &BadgerDB{
@@ -212,7 +220,7 @@ func NewLocalDB(storagePath, providerAddr string, specId string, shard uint) DB
 		shardString:  shardString,
 		rewards:      make(map[string]*entryWithTtl),
 		db:           db,
-	}
+	}, nil
 }
 
 type entryWithTtl struct {
@@ -0,0 +1,116 @@
+package rewardserver
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	RelayPaymentMaxProofsFlagName = "relay-payment-max-proofs"
+	RelayPaymentMaxGasFlagName    = "relay-payment-max-gas"
+
+	relayPaymentBatchSent     = "sent"
+	relayPaymentBatchFailed   = "failed"
+	relayPaymentBatchSplit    = "split"
+	relayPaymentBatchRejected = "rejected"
+)
+
+var (
+	RelayPaymentMaxProofs        = 100
+	RelayPaymentMaxGas    uint64 = 10_000_000 // simulated gas, before the gas adjustment
+)
+
+// prioritizeProofs orders proofs by expiry, the oldest epochs are claimed first and the most valuable proofs first within an epoch
+func prioritizeProofs(proofs []*pairingtypes.RelaySession) {
+	sort.SliceStable(proofs, func(i, j int) bool {
+		if proofs[i].Epoch != proofs[j].Epoch {
+			return proofs[i].Epoch < proofs[j].Epoch
+		}
+		return proofs[i].CuSum > proofs[j].CuSum
+	})
+}
+
+// relayPaymentBatchSize returns how many proofs to pack in a batch, learned from the gas of the previous batches
+func (rws *RewardServer) relayPaymentBatchSize() int {
+	batchSize := RelayPaymentMaxProofs
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	gasPerProof := atomic.LoadUint64(&rws.relayPaymentGasPerProof)
+	if gasPerProof > 0 && RelayPaymentMaxGas > 0 {
+		if byGas := int(RelayPaymentMaxGas / gasPerProof); byGas < batchSize {
+			batchSize = byGas
+		}
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return batchSize
+}
+
+// sendRelayPayments claims the proofs in batches bounded by proofs and estimated gas, proofs closest to expiry are sent first.
+// a batch failing simulation is split until the rejected proofs are isolated, so they don't fail the rest of the batch.
+// returns the proofs that weren't paid, they are retried on the next epochs
+func (rws *RewardServer) sendRelayPayments(ctx context.Context, proofs []*pairingtypes.RelaySession) (failed []*pairingtypes.RelaySession) {
+	prioritizeProofs(proofs)
+	for len(proofs) > 0 {
+		batchSize := rws.relayPaymentBatchSize()
+		if batchSize > len(proofs) {
+			batchSize = len(proofs)
+		}
+		failed = append(failed, rws.sendRelayPaymentBatch(ctx, proofs[:batchSize])...)
+		proofs = proofs[batchSize:]
+	}
+	return failed
+}
+
+func (rws *RewardServer) sendRelayPaymentBatch(ctx context.Context, batch []*pairingtypes.RelaySession) (failed []*pairingtypes.RelaySession) {
+	specs := map[string]struct{}{}
+	for _, proof := range batch {
+		specs[proof.SpecId] = struct{}{}
+	}
+	description := strconv.FormatUint(rws.serverID, 10)
+	latestBlocks := rws.latestBlockReports(specs)
+	gas, err := rws.rewardsTxSender.EstimateRelayPaymentGas(ctx, batch, description, latestBlocks)
+	if err != nil {
+		if len(batch) == 1 {
+			utils.LavaFormatWarning("relay payment proof rejected by simulation", err, utils.LogAttr("sessionId", batch[0].SessionId), utils.LogAttr("epoch", batch[0].Epoch), utils.LogAttr("spec", batch[0].SpecId))
+			rws.providerMetrics.AddRelayPaymentBatch(relayPaymentBatchRejected, len(batch))
+			rws.updatePaymentRequestAttempt(batch, false)
+			return batch
+		}
+		utils.LavaFormatDebug("relay payment batch failed simulation, splitting it", utils.LogAttr("proofs", len(batch)), utils.LogAttr("error", err))
+		return rws.splitRelayPaymentBatch(ctx, batch)
+	}
+	if RelayPaymentMaxGas > 0 && gas > RelayPaymentMaxGas && len(batch) > 1 {
+		atomic.StoreUint64(&rws.relayPaymentGasPerProof, gas/uint64(len(batch)))
+		utils.LavaFormatDebug("relay payment batch exceeds the gas limit, splitting it", utils.LogAttr("proofs", len(batch)), utils.LogAttr("gas", gas))
+		return rws.splitRelayPaymentBatch(ctx, batch)
+	}
+	atomic.StoreUint64(&rws.relayPaymentGasPerProof, gas/uint64(len(batch)))
+
+	err = rws.rewardsTxSender.TxRelayPayment(ctx, batch, description, latestBlocks)
+	if err != nil {
+		// the batch passed simulation so the failure isn't caused by its proofs, it is retried as a whole
+		utils.LavaFormatError("failed sending relay payment batch", err, utils.LogAttr("proofs", len(batch)), utils.LogAttr("gas", gas))
+		rws.providerMetrics.AddRelayPaymentBatch(relayPaymentBatchFailed, len(batch))
+		rws.updatePaymentRequestAttempt(batch, false)
+		return batch
+	}
+	utils.LavaFormatDebug("Sent relay payment batch", utils.LogAttr("proofs", len(batch)), utils.LogAttr("gas", gas))
+	rws.providerMetrics.AddRelayPaymentBatch(relayPaymentBatchSent, len(batch))
+	rws.updatePaymentRequestAttempt(batch, true)
+	return nil
+}
+
+func (rws *RewardServer) splitRelayPaymentBatch(ctx context.Context, batch []*pairingtypes.RelaySession) (failed []*pairingtypes.RelaySession) {
+	rws.providerMetrics.AddRelayPaymentBatch(relayPaymentBatchSplit, len(batch))
+	half := len(batch) / 2
+	failed = rws.sendRelayPaymentBatch(ctx, batch[:half])
+	return append(failed, rws.sendRelayPaymentBatch(ctx, batch[half:])...)
+}
@@ -1,6 +1,7 @@
 package rewardserver_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
@@ -216,3 +217,115 @@ func TestRewardsWithTTL(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(rewards))
 }
+
+func signedRewardEntity(t *testing.T, provider string, spec string, epoch int64, sessionId uint64, cu uint64) *rewardserver.RewardEntity {
+	privKey, addr := sigs.GenerateFloatingKey()
+	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	proof := common.BuildRelayRequestWithSession(ctx, provider, []byte{}, sessionId, cu, spec, nil)
+	proof.Epoch = epoch
+
+	sig, err := sigs.Sign(privKey, *proof)
+	require.NoError(t, err)
+	proof.Sig = sig
+	return &rewardserver.RewardEntity{
+		Epoch:        uint64(proof.Epoch),
+		ConsumerAddr: addr.String(),
+		ConsumerKey:  spec + addr.String(),
+		SessionId:    proof.SessionId,
+		Proof:        proof,
+	}
+}
+
+func TestValidateRewardEntity(t *testing.T) {
+	reward := signedRewardEntity(t, "provider", "spec", 20, 1, 10)
+	require.NoError(t, rewardserver.ValidateRewardEntity(reward, "provider", 10))
+	require.Error(t, rewardserver.ValidateRewardEntity(reward, "otherProvider", 10))
+	require.Error(t, rewardserver.ValidateRewardEntity(reward, "provider", 30))
+
+	wrongEpoch := *reward
+	wrongEpoch.Epoch = 21
+	require.Error(t, rewardserver.ValidateRewardEntity(&wrongEpoch, "provider", 10))
+
+	otherConsumer := signedRewardEntity(t, "provider", "spec", 20, 1, 10)
+	wrongConsumer := *reward
+	wrongConsumer.ConsumerAddr = otherConsumer.ConsumerAddr
+	wrongConsumer.ConsumerKey = otherConsumer.ConsumerKey
+	require.Error(t, rewardserver.ValidateRewardEntity(&wrongConsumer, "provider", 10))
+
+	// a proof changed after signing doesn't recover its consumer
+	tampered := signedRewardEntity(t, "provider", "spec", 20, 1, 10)
+	tampered.Proof.CuSum = 1000
+	require.Error(t, rewardserver.ValidateRewardEntity(tampered, "provider", 10))
+}
+
+func TestRewardsExportImport(t *testing.T) {
+	source := rewardserver.NewRewardDB()
+	require.NoError(t, source.AddDB(rewardserver.NewMemoryDB("spec1")))
+	require.NoError(t, source.AddDB(rewardserver.NewMemoryDB("spec2")))
+	rewards := []*rewardserver.RewardEntity{
+		signedRewardEntity(t, "provider", "spec1", 20, 1, 10),
+		signedRewardEntity(t, "provider", "spec1", 30, 2, 10),
+		signedRewardEntity(t, "provider", "spec2", 30, 3, 10),
+	}
+	require.NoError(t, source.BatchSave(rewards))
+	entities, err := source.FindAllEntities()
+	require.NoError(t, err)
+	require.Len(t, entities, 3)
+	require.Equal(t, uint64(20), entities[0].Epoch)
+	require.Len(t, rewardserver.FilterRewardEntities(entities, 30, 0, nil), 2)
+	require.Len(t, rewardserver.FilterRewardEntities(entities, 0, 30, []string{"spec2"}), 1)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, rewardserver.WriteRewardsExport(buf, &rewardserver.RewardsExport{Version: rewardserver.RewardsExportVersion, Provider: "provider", Rewards: entities}))
+	export, err := rewardserver.ReadRewardsExport(buf)
+	require.NoError(t, err)
+	require.Equal(t, "provider", export.Provider)
+	for _, reward := range export.Rewards {
+		require.NoError(t, rewardserver.ValidateRewardEntity(reward, "provider", 10))
+	}
+
+	storagePath := t.TempDir()
+	target, err := rewardserver.OpenLocalRewardDB(storagePath, "provider", 1, []string{"spec1", "spec2"}, time.Hour)
+	require.NoError(t, err)
+	// a stored proof with more cu is kept, a stored proof with less is replaced
+	higher := *export.Rewards[0]
+	higherProof := *higher.Proof
+	higherProof.CuSum = 100
+	higher.Proof = &higherProof
+	lower := *export.Rewards[1]
+	lowerProof := *lower.Proof
+	lowerProof.CuSum = 1
+	lower.Proof = &lowerProof
+	require.NoError(t, target.BatchSave([]*rewardserver.RewardEntity{&higher, &lower}))
+	imported, err := target.ImportRewards(export.Rewards)
+	require.NoError(t, err)
+	require.Len(t, imported, 2)
+	stored, err := target.FindAllEntities()
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+	require.Equal(t, uint64(100), stored[0].Proof.CuSum)
+	require.Equal(t, uint64(10), stored[1].Proof.CuSum)
+	require.NoError(t, target.Close())
+
+	specs, err := rewardserver.LocalDBSpecs(storagePath, "provider", 1)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"spec1", "spec2"}, specs)
+	reopened, err := rewardserver.OpenLocalRewardDB(storagePath, "provider", 1, specs, time.Hour)
+	require.NoError(t, err)
+	defer reopened.Close()
+	stored, err = reopened.FindAllEntities()
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+
+	batches := rewardserver.BatchRewardEntities(stored, 2)
+	require.Len(t, batches, 2)
+	require.Len(t, batches[1], 1)
+	require.Len(t, rewardserver.RewardProofs(batches[0]), 2)
+
+	// claimed rewards are removed, the rest stay stored
+	require.NoError(t, reopened.DeleteRewards(batches[0]))
+	stored, err = reopened.FindAllEntities()
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, batches[1][0].SessionId, stored[0].SessionId)
+}
@@ -0,0 +1,235 @@
+package rewardserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const RewardsExportVersion = 1
+
+// RewardsExport is the portable format of a provider's unclaimed proofs, it is independent of the storage layout
+// so proofs can be moved between hosts, shards and storage paths
+type RewardsExport struct {
+	Version  int             `json:"version"`
+	Provider string          `json:"provider"`
+	Rewards  []*RewardEntity `json:"rewards"`
+}
+
+func WriteRewardsExport(writer io.Writer, export *RewardsExport) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(export)
+}
+
+func ReadRewardsExport(reader io.Reader) (*RewardsExport, error) {
+	export := &RewardsExport{}
+	err := json.NewDecoder(reader).Decode(export)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed decoding rewards export", err)
+	}
+	if export.Version != RewardsExportVersion {
+		return nil, utils.LavaFormatError("unsupported rewards export version", nil, utils.Attribute{Key: "version", Value: export.Version}, utils.Attribute{Key: "supported", Value: RewardsExportVersion})
+	}
+	return export, nil
+}
+
+// FindAllEntities returns the stored rewards as they are saved, sorted by epoch, consumer and session
+func (rs *RewardDB) FindAllEntities() ([]*RewardEntity, error) {
+	rawRewards := make(map[string]*RewardEntity)
+	for _, db := range rs.dbs {
+		err := rs.retrieveAndProcessRewardsFromDB(&db, &rawRewards)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entities := make([]*RewardEntity, 0, len(rawRewards))
+	for _, entity := range rawRewards {
+		if entity.Proof == nil {
+			continue
+		}
+		entities = append(entities, entity)
+	}
+	SortRewardEntities(entities)
+	return entities, nil
+}
+
+// ImportRewards saves the rewards that are not stored yet or carry more compute units than the stored proof of their session
+func (rs *RewardDB) ImportRewards(entities []*RewardEntity) (imported []*RewardEntity, err error) {
+	imported = []*RewardEntity{}
+	for _, entity := range entities {
+		if !rs.DBExists(entity.Proof.SpecId) {
+			return nil, utils.LavaFormatError("reward db not found for spec", nil, utils.Attribute{Key: "spec", Value: entity.Proof.SpecId})
+		}
+		stored, err := rs.findOne(rs.assembleKey(entity.Epoch, entity.ConsumerAddr, entity.SessionId, entity.ConsumerKey))
+		if err == nil && stored.Proof != nil && stored.Proof.CuSum >= entity.Proof.CuSum {
+			continue
+		}
+		imported = append(imported, entity)
+	}
+	if len(imported) == 0 {
+		return imported, nil
+	}
+	return imported, rs.BatchSave(imported)
+}
+
+func SortRewardEntities(entities []*RewardEntity) {
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Epoch != entities[j].Epoch {
+			return entities[i].Epoch < entities[j].Epoch
+		}
+		if entities[i].ConsumerKey != entities[j].ConsumerKey {
+			return entities[i].ConsumerKey < entities[j].ConsumerKey
+		}
+		return entities[i].SessionId < entities[j].SessionId
+	})
+}
+
+// FilterRewardEntities returns the rewards of the given epoch range and specs, a zero toEpoch and empty specs match all
+func FilterRewardEntities(entities []*RewardEntity, fromEpoch, toEpoch uint64, specs []string) []*RewardEntity {
+	specsSet := map[string]struct{}{}
+	for _, spec := range specs {
+		specsSet[spec] = struct{}{}
+	}
+	filtered := []*RewardEntity{}
+	for _, entity := range entities {
+		if entity.Epoch < fromEpoch || (toEpoch != 0 && entity.Epoch > toEpoch) {
+			continue
+		}
+		if _, ok := specsSet[entity.Proof.SpecId]; len(specsSet) > 0 && !ok {
+			continue
+		}
+		filtered = append(filtered, entity)
+	}
+	return filtered
+}
+
+// ValidateRewardEntity verifies a reward is a proof signed by its consumer for the provider, that its keys match the proof
+// and that its epoch can still be claimed
+func ValidateRewardEntity(entity *RewardEntity, providerAddr string, earliestEpoch uint64) error {
+	proof := entity.Proof
+	if proof == nil {
+		return fmt.Errorf("reward of consumer %s session %d has no proof", entity.ConsumerAddr, entity.SessionId)
+	}
+	if proof.Epoch < 0 || entity.Epoch != uint64(proof.Epoch) {
+		return fmt.Errorf("reward epoch %d does not match its proof epoch %d", entity.Epoch, proof.Epoch)
+	}
+	if entity.Epoch < earliestEpoch {
+		return fmt.Errorf("reward epoch %d is older than the earliest epoch that can be claimed %d", entity.Epoch, earliestEpoch)
+	}
+	if entity.SessionId != proof.SessionId {
+		return fmt.Errorf("reward session %d does not match its proof session %d", entity.SessionId, proof.SessionId)
+	}
+	if proof.Provider != providerAddr {
+		return fmt.Errorf("proof provider %s is not %s", proof.Provider, providerAddr)
+	}
+	// same as the provider server, badge relays are signed by the badge user and paid by the badge signer
+	signer, err := sigs.ExtractSignerAddress(proof)
+	if err != nil {
+		return fmt.Errorf("invalid proof signature: %w", err)
+	}
+	consumer := signer
+	if proof.Badge != nil {
+		if signer.String() != proof.Badge.Address {
+			return fmt.Errorf("proof signer %s is not the badge user %s", signer, proof.Badge.Address)
+		}
+		consumer, err = sigs.ExtractSignerAddress(*proof.Badge)
+		if err != nil {
+			return fmt.Errorf("invalid badge signature: %w", err)
+		}
+	}
+	if consumer.String() != entity.ConsumerAddr {
+		return fmt.Errorf("proof is signed by %s and not by the reward consumer %s", consumer, entity.ConsumerAddr)
+	}
+	if entity.ConsumerKey != getKeyForConsumerRewards(proof.SpecId, entity.ConsumerAddr) {
+		return fmt.Errorf("reward consumer key %s does not match its proof", entity.ConsumerKey)
+	}
+	return nil
+}
+
+// BatchRewardEntities splits the rewards into relay payment batches of at most batchSize rewards
+func BatchRewardEntities(entities []*RewardEntity, batchSize int) [][]*RewardEntity {
+	batches := [][]*RewardEntity{}
+	if batchSize <= 0 {
+		batchSize = len(entities)
+	}
+	for start := 0; start < len(entities); start += batchSize {
+		end := start + batchSize
+		if end > len(entities) {
+			end = len(entities)
+		}
+		batches = append(batches, entities[start:end])
+	}
+	return batches
+}
+
+// RewardProofs returns the proofs of the rewards, to be sent in a relay payment
+func RewardProofs(entities []*RewardEntity) []*pairingtypes.RelaySession {
+	proofs := make([]*pairingtypes.RelaySession, 0, len(entities))
+	for _, entity := range entities {
+		proofs = append(proofs, entity.Proof)
+	}
+	return proofs
+}
+
+// DeleteRewards removes claimed rewards from the storage so they are not claimed again
+func (rs *RewardDB) DeleteRewards(entities []*RewardEntity) error {
+	for _, entity := range entities {
+		err := rs.DeleteClaimedRewards(entity.Epoch, entity.ConsumerAddr, entity.SessionId, entity.ConsumerKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LocalDBSpecs returns the spec ids that have a reward db for the provider and shard under the storage path
+func LocalDBSpecs(storagePath, providerAddr string, shard uint) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(storagePath, providerAddr))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	shardString := strconv.FormatUint(uint64(shard), 10)
+	specs := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(storagePath, providerAddr, entry.Name(), shardString))
+		if err != nil || !info.IsDir() {
+			continue
+		}
+		specs = append(specs, entry.Name())
+	}
+	return specs, nil
+}
+
+// OpenLocalRewardDB opens the reward dbs of the given specs, the dbs are locked while open so the provider using them must be stopped
+func OpenLocalRewardDB(storagePath, providerAddr string, shard uint, specs []string, ttl time.Duration) (*RewardDB, error) {
+	rewardDB := NewRewardDBWithTTL(ttl)
+	for _, spec := range specs {
+		if rewardDB.DBExists(spec) {
+			continue
+		}
+		db, err := OpenLocalDB(storagePath, providerAddr, spec, shard)
+		if err != nil {
+			rewardDB.Close()
+			return nil, utils.LavaFormatError("failed opening reward db, make sure no provider is using it", err, utils.Attribute{Key: "spec", Value: spec})
+		}
+		rewardDB.AddDB(db)
+	}
+	return rewardDB, nil
+}
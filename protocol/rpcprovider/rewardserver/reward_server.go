@@ -3,6 +3,7 @@ package rewardserver
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -92,10 +93,12 @@ type RewardServer struct {
 	rewardsSnapshotThresholdCh     chan struct{}
 	failedRewardsPaymentRequests   map[uint64]*RelaySessionsToRetryAttempts // key is SessionId
 	chainTrackerSpecsInf           ChainTrackerSpecsInf
+	relayPaymentGasPerProof        uint64 // learned from the simulated batches, sizes the next batches
 }
 
 type RewardsTxSender interface {
 	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) error
+	EstimateRelayPaymentGas(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (uint64, error)
 	GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx context.Context) (uint64, error)
 	EarliestBlockInMemory(ctx context.Context) (uint64, error)
 	GetEpochSize(ctx context.Context) (uint64, error)
@@ -226,27 +229,14 @@ func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) err
 	failedRewardRequestsToRetry := rws.gatherFailedRequestPaymentsToRetry(earliestSavedEpoch)
 	if len(failedRewardRequestsToRetry) > 0 {
 		utils.LavaFormatDebug("Found failed reward claims, retrying", utils.LogAttr("number_of_rewards", len((failedRewardRequestsToRetry))))
-		specs := map[string]struct{}{}
-		for _, relay := range failedRewardRequestsToRetry {
-			utils.LavaFormatDebug("[sendRewardsClaim] retrying failed id", utils.LogAttr("id", relay.SessionId))
-			specs[relay.SpecId] = struct{}{}
-		}
-
-		err = rws.rewardsTxSender.TxRelayPayment(ctx, failedRewardRequestsToRetry, strconv.FormatUint(rws.serverID, 10), rws.latestBlockReports(specs))
-		if err != nil {
-			rws.updatePaymentRequestAttempt(failedRewardRequestsToRetry, false)
-			utils.LavaFormatError("failed sending previously failed payment requests", err)
-		} else {
-			rws.updatePaymentRequestAttempt(failedRewardRequestsToRetry, true)
-		}
 	}
 
 	rewardsToClaim, err := rws.gatherRewardsForClaim(ctx, epoch, earliestSavedEpoch)
 	if err != nil {
+		rws.sendRelayPayments(ctx, failedRewardRequestsToRetry)
 		return err
 	}
 
-	specs := map[string]struct{}{}
 	for _, relay := range rewardsToClaim {
 		consumerAddr, err := sigs.ExtractSignerAddress(relay)
 		if err != nil {
@@ -265,20 +255,18 @@ func (rws *RewardServer) sendRewardsClaim(ctx context.Context, epoch uint64) err
 		}
 		rws.addExpectedPayment(expectedPay)
 		rws.updateCUServiced(relay.CuSum)
-		specs[relay.SpecId] = struct{}{}
 	}
-	if len(rewardsToClaim) > 0 {
-		err = rws.rewardsTxSender.TxRelayPayment(ctx, rewardsToClaim, strconv.FormatUint(rws.serverID, 10), rws.latestBlockReports(specs))
-		if err != nil {
-			rws.updatePaymentRequestAttempt(rewardsToClaim, false)
-			return utils.LavaFormatError("failed sending rewards claim", err)
-		}
-		rws.updatePaymentRequestAttempt(rewardsToClaim, true)
 
-		utils.LavaFormatDebug("Sent rewards claim", utils.Attribute{Key: "number_of_relay_sessions_sent", Value: len(rewardsToClaim)})
-	} else {
+	proofs := append(failedRewardRequestsToRetry, rewardsToClaim...)
+	if len(proofs) == 0 {
 		utils.LavaFormatDebug("no rewards to claim")
+		return nil
 	}
+	failed := rws.sendRelayPayments(ctx, proofs)
+	if len(failed) > 0 {
+		return utils.LavaFormatError("failed sending rewards claim", nil, utils.Attribute{Key: "failed_relay_sessions", Value: len(failed)}, utils.Attribute{Key: "number_of_relay_sessions", Value: len(proofs)})
+	}
+	utils.LavaFormatDebug("Sent rewards claim", utils.Attribute{Key: "number_of_relay_sessions_sent", Value: len(proofs)})
 	return nil
 }
 
@@ -456,6 +444,83 @@ func (rws *RewardServer) PaymentHandler(payment *PaymentRequest) {
 	}
 }
 
+// RewardsReport holds the rewards of the provider that weren't paid yet
+type RewardsReport struct {
+	Pending         []ConsumerRewardsReport `json:"pending"` // proofs waiting for their epoch to be claimed
+	Claimed         []ConsumerRewardsReport `json:"claimed"` // claimed and waiting for the payment event
+	RetryingProofs  int                     `json:"retrying_proofs"`
+	TotalCUServiced uint64                  `json:"total_cu_serviced"`
+	TotalCUPaid     uint64                  `json:"total_cu_paid"`
+}
+
+type ConsumerRewardsReport struct {
+	Epoch    uint64 `json:"epoch"`
+	Consumer string `json:"consumer"`
+	ChainID  string `json:"chain_id"`
+	Proofs   int    `json:"proofs"`
+	CU       uint64 `json:"cu"`
+}
+
+// Report returns the pending and claimed rewards per consumer and epoch, filtered by consumer address when it isn't empty
+func (rws *RewardServer) Report(consumerAddress string) RewardsReport {
+	rws.lock.RLock()
+	defer rws.lock.RUnlock()
+	report := RewardsReport{
+		Pending:         []ConsumerRewardsReport{},
+		Claimed:         []ConsumerRewardsReport{},
+		RetryingProofs:  len(rws.failedRewardsPaymentRequests),
+		TotalCUServiced: rws.cUServiced(),
+		TotalCUPaid:     rws.paidCU(),
+	}
+	for epoch, epochRewards := range rws.rewards {
+		for _, consumerRewards := range epochRewards.consumerRewards {
+			if consumerAddress != "" && consumerRewards.consumer != consumerAddress {
+				continue
+			}
+			consumerReport := ConsumerRewardsReport{Epoch: epoch, Consumer: consumerRewards.consumer}
+			for _, proof := range consumerRewards.proofs {
+				consumerReport.ChainID = proof.SpecId
+				consumerReport.Proofs++
+				consumerReport.CU += proof.CuSum
+			}
+			report.Pending = append(report.Pending, consumerReport)
+		}
+	}
+	claimed := map[string]*ConsumerRewardsReport{}
+	for _, expectedPayment := range rws.expectedPayments {
+		consumer := expectedPayment.Client.String()
+		if consumerAddress != "" && consumer != consumerAddress {
+			continue
+		}
+		key := strconv.FormatInt(expectedPayment.BlockHeightDeadline, 10) + expectedPayment.ConsumerRewardsKey
+		consumerReport, ok := claimed[key]
+		if !ok {
+			consumerReport = &ConsumerRewardsReport{Epoch: uint64(expectedPayment.BlockHeightDeadline), Consumer: consumer, ChainID: expectedPayment.ChainID}
+			claimed[key] = consumerReport
+		}
+		consumerReport.Proofs++
+		consumerReport.CU += expectedPayment.CU
+	}
+	for _, consumerReport := range claimed {
+		report.Claimed = append(report.Claimed, *consumerReport)
+	}
+	sortRewardsReports(report.Pending)
+	sortRewardsReports(report.Claimed)
+	return report
+}
+
+func sortRewardsReports(reports []ConsumerRewardsReport) {
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Epoch != reports[j].Epoch {
+			return reports[i].Epoch < reports[j].Epoch
+		}
+		if reports[i].Consumer != reports[j].Consumer {
+			return reports[i].Consumer < reports[j].Consumer
+		}
+		return reports[i].ChainID < reports[j].ChainID
+	})
+}
+
 func NewRewardServer(rewardsTxSender RewardsTxSender, providerMetrics *metrics.ProviderMetricsManager, rewardDB *RewardDB, rewardStoragePath string, rewardsSnapshotThreshold uint, rewardsSnapshotTimeoutSec uint, chainTrackerSpecsInf ChainTrackerSpecsInf) *RewardServer {
 	rws := &RewardServer{totalCUServiced: 0, totalCUPaid: 0}
 	rws.serverID = uint64(rand.Int63())
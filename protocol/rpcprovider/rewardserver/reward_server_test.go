@@ -622,6 +622,14 @@ type rewardsTxSenderMock struct {
 	earliestBlockInMemory  uint64
 	sentPayments           []*pairingtypes.RelaySession
 	txRelayPaymentCallback func(context.Context, []*pairingtypes.RelaySession, string, []*pairingtypes.LatestBlockReport) error
+	estimateGasCallback    func([]*pairingtypes.RelaySession) (uint64, error)
+}
+
+func (rts *rewardsTxSenderMock) EstimateRelayPaymentGas(ctx context.Context, payments []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (uint64, error) {
+	if rts.estimateGasCallback != nil {
+		return rts.estimateGasCallback(payments)
+	}
+	return uint64(len(payments)) * 1000, nil
 }
 
 func (rts *rewardsTxSenderMock) defaultTxRelayPaymentCallback(_ context.Context, payments []*pairingtypes.RelaySession, _ string, _ []*pairingtypes.LatestBlockReport) error {
@@ -659,3 +667,93 @@ func (rts *rewardsTxSenderMock) GetAverageBlockTime() time.Duration {
 func (rts *rewardsTxSenderMock) EarliestBlockInMemory(_ context.Context) (uint64, error) {
 	return rts.earliestBlockInMemory, nil
 }
+
+func TestRewardsReport(t *testing.T) {
+	rand.InitRandomSeed()
+	stubRewardsTxSender := rewardsTxSenderMock{}
+	rewardDB, err := createInMemoryRewardDb([]string{"spec"})
+	require.NoError(t, err)
+	rws := NewRewardServer(&stubRewardsTxSender, nil, rewardDB, "badger_test", 1, 10, nil)
+	privKey, acc := sigs.GenerateFloatingKey()
+
+	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	for _, sessionId := range []uint64{1, 2, 3} {
+		epoch := sessionId%2 + 1
+		proof := common.BuildRelayRequestWithSession(ctx, "provider", []byte{}, sessionId, uint64(10), "spec", nil)
+		proof.Epoch = int64(epoch)
+		proof.Sig, err = sigs.Sign(privKey, *proof)
+		require.NoError(t, err)
+		rws.SendNewProof(context.Background(), proof, epoch, acc.String(), "apiInterface")
+	}
+
+	report := rws.Report("")
+	require.Equal(t, []ConsumerRewardsReport{
+		{Epoch: 1, Consumer: acc.String(), ChainID: "spec", Proofs: 1, CU: 10},
+		{Epoch: 2, Consumer: acc.String(), ChainID: "spec", Proofs: 2, CU: 20},
+	}, report.Pending)
+	require.Empty(t, report.Claimed)
+
+	// claiming epoch 1 moves its rewards to the claimed ones until they get paid
+	rws.runRewardServerEpochUpdate(1)
+	report = rws.Report(acc.String())
+	require.Equal(t, []ConsumerRewardsReport{{Epoch: 2, Consumer: acc.String(), ChainID: "spec", Proofs: 2, CU: 20}}, report.Pending)
+	require.Equal(t, []ConsumerRewardsReport{{Epoch: 1, Consumer: acc.String(), ChainID: "spec", Proofs: 1, CU: 10}}, report.Claimed)
+	require.Equal(t, uint64(10), report.TotalCUServiced)
+
+	require.Empty(t, rws.Report("other").Pending)
+}
+
+func TestRelayPaymentBatches(t *testing.T) {
+	rand.InitRandomSeed()
+	defer func(maxProofs int, maxGas uint64) {
+		RelayPaymentMaxProofs, RelayPaymentMaxGas = maxProofs, maxGas
+	}(RelayPaymentMaxProofs, RelayPaymentMaxGas)
+	RelayPaymentMaxProofs = 4
+	RelayPaymentMaxGas = 2500
+
+	const poisonedSession = 7
+	batches := [][]uint64{}
+	stubRewardsTxSender := rewardsTxSenderMock{
+		estimateGasCallback: func(proofs []*pairingtypes.RelaySession) (uint64, error) {
+			for _, proof := range proofs {
+				if proof.SessionId == poisonedSession {
+					return 0, fmt.Errorf("invalid proof")
+				}
+			}
+			return uint64(len(proofs)) * 1000, nil
+		},
+	}
+	stubRewardsTxSender.txRelayPaymentCallback = func(ctx context.Context, proofs []*pairingtypes.RelaySession, _ string, _ []*pairingtypes.LatestBlockReport) error {
+		batch := []uint64{}
+		for _, proof := range proofs {
+			batch = append(batch, proof.SessionId)
+		}
+		batches = append(batches, batch)
+		stubRewardsTxSender.sentPayments = append(stubRewardsTxSender.sentPayments, proofs...)
+		return nil
+	}
+	rewardDB, err := createInMemoryRewardDb([]string{"spec"})
+	require.NoError(t, err)
+	rws := NewRewardServer(&stubRewardsTxSender, nil, rewardDB, "badger_test", 1, 10, nil)
+
+	ctx := sdk.WrapSDKContext(sdk.NewContext(nil, tmproto.Header{}, false, nil))
+	proofs := []*pairingtypes.RelaySession{}
+	for sessionId := uint64(1); sessionId <= 8; sessionId++ {
+		proof := common.BuildRelayRequestWithSession(ctx, "provider", []byte{}, sessionId, sessionId, "spec", nil)
+		// odd sessions belong to the older epoch and expire first
+		proof.Epoch = int64(2 - sessionId%2)
+		proofs = append(proofs, proof)
+	}
+
+	failed := rws.sendRelayPayments(context.Background(), proofs)
+	require.Len(t, failed, 1)
+	require.Equal(t, uint64(poisonedSession), failed[0].SessionId)
+	require.Contains(t, rws.failedRewardsPaymentRequests, uint64(poisonedSession))
+	require.Len(t, stubRewardsTxSender.sentPayments, 7)
+
+	// the first batch of 4 failed simulation and was split to isolate the poisoned proof,
+	// then the batches shrank to fit the learned gas per proof
+	require.Equal(t, [][]uint64{{5}, {3, 1}, {8, 6}, {4, 2}}, batches)
+	require.Equal(t, uint64(1000), rws.relayPaymentGasPerProof)
+	require.Equal(t, 2, rws.relayPaymentBatchSize())
+}
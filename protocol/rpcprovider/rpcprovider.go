@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -32,9 +33,11 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/utils/slices"
 	epochstorage "github.com/lavanet/lava/x/epochstorage/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -78,6 +81,7 @@ type ProviderStateTrackerInf interface {
 	RegisterForEpochUpdates(ctx context.Context, epochUpdatable updaters.EpochUpdatable)
 	RegisterForDowntimeParamsUpdates(ctx context.Context, downtimeParamsUpdatable updaters.DowntimeParamsUpdatable) error
 	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) error
+	EstimateRelayPaymentGas(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (uint64, error)
 	SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error
 	SendVoteCommitment(voteID string, vote *reliabilitymanager.VoteData) error
 	LatestBlock() int64
@@ -107,6 +111,10 @@ type rpcProviderStartOptions struct {
 	rewardsSnapshotThreshold  uint
 	rewardsSnapshotTimeoutSec uint
 	healthCheckMetricsOptions *rpcProviderHealthCheckMetricsOptions
+	adminListenAddress        string
+	adminToken                string
+	configFile                string                                             // empty when the endpoints were passed as arguments
+	loadEndpoints             func() ([]*lavasession.RPCProviderEndpoint, error) // reads the endpoints from the config file again, nil without one
 }
 
 type rpcProviderHealthCheckMetricsOptions struct {
@@ -135,14 +143,24 @@ type RPCProvider struct {
 	relaysHealthCheckEnabled  bool
 	relaysHealthCheckInterval time.Duration
 	grpcHealthCheckEndpoint   string
+	responseCaches            map[string]*ResponseCache // chainID -> in process cache of finalized responses, shared by the api interfaces of the chain
+	specValidator             *SpecValidator
+	reloadLock                sync.Mutex                                  // serializes endpoint configuration reloads
+	configuredEndpoints       map[string]*lavasession.RPCProviderEndpoint // endpointReloadKey -> endpoint of the current configuration, set up or not
+	activeEndpoints           map[string]*activeEndpoint                  // endpointReloadKey -> endpoint that was set up
+	configFile                string
+	loadEndpoints             func() ([]*lavasession.RPCProviderEndpoint, error)
 }
 
 func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	ctx, cancel := context.WithCancel(options.ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
+	reloadSignalChan := make(chan os.Signal, 1)
+	signal.Notify(reloadSignalChan, syscall.SIGHUP)
 	defer func() {
 		signal.Stop(signalChan)
+		signal.Stop(reloadSignalChan)
 		cancel()
 	}()
 	rpcp.chainTrackers = &ChainTrackers{}
@@ -151,6 +169,11 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	rpcp.providerMetricsManager = metrics.NewProviderMetricsManager(options.metricsListenAddress) // start up prometheus metrics
 	rpcp.providerMetricsManager.SetVersion(upgrade.GetCurrentVersion().ProviderVersion)
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
+	rpcp.responseCaches = map[string]*ResponseCache{}
+	rpcp.configuredEndpoints = map[string]*lavasession.RPCProviderEndpoint{}
+	rpcp.activeEndpoints = map[string]*activeEndpoint{}
+	rpcp.configFile = options.configFile
+	rpcp.loadEndpoints = options.loadEndpoints
 	rpcp.shardID = options.shardID
 	rpcp.relaysHealthCheckEnabled = options.healthCheckMetricsOptions.relaysHealthEnableFlag
 	rpcp.relaysHealthCheckInterval = options.healthCheckMetricsOptions.relaysHealthIntervalFlag
@@ -207,14 +230,13 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	rpcp.blockMemorySize = blockMemorySize
 	// pre loop to handle synchronous actions
 	rpcp.chainMutexes = map[string]*sync.Mutex{}
-	for idx, endpoint := range options.rpcProviderEndpoints {
-		rpcp.chainMutexes[endpoint.ChainID] = &sync.Mutex{}   // create a mutex per chain for shared resources
-		if idx > 0 && endpoint.NetworkAddress.Address == "" { // handle undefined addresses as the previous endpoint for shared listeners
-			endpoint.NetworkAddress = options.rpcProviderEndpoints[idx-1].NetworkAddress
-		}
+	fillSharedNetworkAddresses(options.rpcProviderEndpoints)
+	for _, endpoint := range options.rpcProviderEndpoints {
+		rpcp.configuredEndpoints[endpointReloadKey(endpoint)] = endpoint
 	}
 
 	specValidator := NewSpecValidator()
+	rpcp.specValidator = specValidator
 	disabledEndpointsList := rpcp.SetupProviderEndpoints(options.rpcProviderEndpoints, specValidator, true)
 	rpcp.relaysMonitorAggregator.StartMonitoring(ctx)
 	specValidator.Start(ctx)
@@ -231,6 +253,14 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	} else {
 		utils.LavaFormatInfo("[+] all endpoints up and running")
 	}
+	go rpcp.watchEndpointsConfig(ctx, reloadSignalChan)
+	if options.adminListenAddress != "" {
+		if options.adminToken == "" {
+			utils.LavaFormatError("provider admin api requires a token, not starting it", nil, utils.LogAttr("flag", AdminTokenFlagName), utils.LogAttr("env", AdminTokenEnv))
+		} else {
+			go NewProviderAdmin(rpcp, options.adminToken).Serve(ctx, options.adminListenAddress)
+		}
+	}
 	// tearing down
 	select {
 	case <-ctx.Done():
@@ -239,6 +269,7 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 		utils.LavaFormatInfo("Provider Server signalChan")
 	}
 
+	rpcp.reloadLock.Lock() // a reload in progress must not set up endpoints on closed listeners
 	for _, listener := range rpcp.rpcProviderListeners {
 		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 10*time.Second)
 		listener.Shutdown(shutdownCtx)
@@ -271,6 +302,11 @@ func getActiveEndpoints(rpcProviderEndpoints []*lavasession.RPCProviderEndpoint,
 
 func (rpcp *RPCProvider) RetryDisabledEndpoints(disabledEndpoints []*lavasession.RPCProviderEndpoint, specValidator *SpecValidator, retryCount int) {
 	time.Sleep(time.Duration(retryCount) * time.Second)
+	// endpoints removed or replaced by a configuration reload aren't retried
+	disabledEndpoints = slices.Filter(disabledEndpoints, rpcp.isConfiguredEndpoint)
+	if len(disabledEndpoints) == 0 {
+		return
+	}
 	parallel := retryCount > 2
 	utils.LavaFormatInfo("Retrying disabled endpoints", utils.Attribute{Key: "disabled endpoints list", Value: disabledEndpoints}, utils.Attribute{Key: "parallel", Value: parallel})
 	disabledEndpointsAfterRetry := rpcp.SetupProviderEndpoints(disabledEndpoints, specValidator, parallel)
@@ -313,6 +349,29 @@ func (rpcp *RPCProvider) SetupProviderEndpoints(rpcProviderEndpoints []*lavasess
 	return disabledEndpointsList
 }
 
+// a mutex per chain for shared resources, chains can be added by a configuration reload
+func (rpcp *RPCProvider) getChainMutex(chainID string) *sync.Mutex {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	chainMutex, ok := rpcp.chainMutexes[chainID]
+	if !ok {
+		chainMutex = &sync.Mutex{}
+		rpcp.chainMutexes[chainID] = chainMutex
+	}
+	return chainMutex
+}
+
+func (rpcp *RPCProvider) getResponseCache(chainID string) *ResponseCache {
+	rpcp.lock.Lock()
+	defer rpcp.lock.Unlock()
+	responseCache, ok := rpcp.responseCaches[chainID]
+	if !ok {
+		responseCache = NewResponseCache(ResponseCacheSizeMB * 1024 * 1024)
+		rpcp.responseCaches[chainID] = responseCache
+	}
+	return responseCache
+}
+
 func (rpcp *RPCProvider) getAllAddonsAndExtensionsFromNodeUrlSlice(nodeUrls []common.NodeUrl) *ProviderPolicy {
 	policy := &ProviderPolicy{}
 	for _, nodeUrl := range nodeUrls {
@@ -328,6 +387,16 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	}
 	chainID := rpcProviderEndpoint.ChainID
 	apiInterface := rpcProviderEndpoint.ApiInterface
+	// the chain tracker outlives the endpoint that set it up, it is handed over to the chain's other endpoints
+	chainCtx := ctx
+	// cancelled when the endpoint is removed by a configuration reload
+	ctx, cancel := context.WithCancel(ctx)
+	setupDone := false
+	defer func() {
+		if !setupDone {
+			cancel()
+		}
+	}()
 	providerSessionManager := lavasession.NewProviderSessionManager(rpcProviderEndpoint, rpcp.blockMemorySize)
 	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, providerSessionManager)
 	chainParser, err := chainlib.NewChainParser(apiInterface)
@@ -348,10 +417,14 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 		utils.LogAttr("apiInterface", apiInterface),
 		utils.LogAttr("supportedServices", providerPolicy.addons))
 	chainParser.SetPolicy(providerPolicy, rpcProviderEndpoint.ChainID, apiInterface)
-	chainRouter, err := chainlib.GetChainRouter(ctx, rpcp.parallelConnections, rpcProviderEndpoint, chainParser)
+	routerCtx, routerCancel := context.WithCancel(ctx)
+	nodeChainRouter, err := chainlib.GetChainRouter(routerCtx, rpcp.parallelConnections, rpcProviderEndpoint, chainParser, rpcp.providerMetricsManager)
 	if err != nil {
+		routerCancel()
 		return utils.LavaFormatError("[PANIC] panic severity critical error, failed creating chain proxy, continuing with others endpoints", err, utils.Attribute{Key: "parallelConnections", Value: uint64(rpcp.parallelConnections)}, utils.Attribute{Key: "rpcProviderEndpoint", Value: rpcProviderEndpoint})
 	}
+	// the node urls can be replaced by a configuration reload without setting up the endpoint again
+	chainRouter := newReloadableChainRouter(nodeChainRouter, routerCancel)
 
 	_, averageBlockTime, blocksToFinalization, blocksInFinalizationData := chainParser.ChainBlockStats()
 	var chainTracker *chaintracker.ChainTracker
@@ -385,9 +458,11 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	}
 
 	// in order to utilize shared resources between chains we need go routines with the same chain to wait for one another here
+	ownsChainTracker := false
 	chainCommonSetup := func() error {
-		rpcp.chainMutexes[chainID].Lock()
-		defer rpcp.chainMutexes[chainID].Unlock()
+		chainMutex := rpcp.getChainMutex(chainID)
+		chainMutex.Lock()
+		defer chainMutex.Unlock()
 		var found bool
 		chainTracker, found = rpcp.chainTrackers.GetTrackerPerChain(chainID)
 		if !found {
@@ -400,29 +475,67 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 				)
 			}
 			blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
+			// the cache purges non finalized entries of orphaned blocks and promotes finalized ones according to our canonical hashes
+			notifyCacheOnBlockHashes := func(int64) {
+				if !rpcp.cache.CacheActive() {
+					return
+				}
+				registeredTracker, found := rpcp.chainTrackers.GetTrackerPerChain(chainID)
+				if !found {
+					return
+				}
+				toBlock := spectypes.LATEST_BLOCK
+				fromBlock := toBlock - int64(blocksToSaveChainTracker) + 1
+				latestBlock, requestedHashes, _, err := registeredTracker.GetLatestBlockData(fromBlock, toBlock, spectypes.NOT_APPLICABLE)
+				if err != nil {
+					return
+				}
+				update := &pairingtypes.BlockHashesUpdate{ChainId: chainID, FinalizedBlock: latestBlock - int64(blocksToFinalization)}
+				for _, blockStore := range requestedHashes {
+					update.Hashes = append(update.Hashes, pairingtypes.BlockHashEntry{Block: blockStore.Block, Hash: []byte(blockStore.Hash)})
+				}
+				go func() {
+					cacheCtx, cancel := context.WithTimeout(context.Background(), common.DataReliabilityTimeoutIncrease)
+					defer cancel()
+					err := rpcp.cache.NotifyBlockHashes(cacheCtx, update)
+					if err != nil {
+						utils.LavaFormatDebug("failed notifying cache on block hashes", utils.LogAttr("error", err), utils.LogAttr("chainID", chainID))
+					}
+				}()
+			}
 			chainTrackerConfig := chaintracker.ChainTrackerConfig{
-				BlocksToSave:        blocksToSaveChainTracker,
-				AverageBlockTime:    averageBlockTime,
-				ServerBlockMemory:   ChainTrackerDefaultMemory + blocksToSaveChainTracker,
-				NewLatestCallback:   recordMetricsOnNewBlock,
+				BlocksToSave:      blocksToSaveChainTracker,
+				AverageBlockTime:  averageBlockTime,
+				ServerBlockMemory: ChainTrackerDefaultMemory + blocksToSaveChainTracker,
+				NewLatestCallback: func(blockFrom int64, blockTo int64, hash string) {
+					recordMetricsOnNewBlock(blockFrom, blockTo, hash)
+					notifyCacheOnBlockHashes(blockTo)
+				},
+				ForkCallback:        notifyCacheOnBlockHashes,
 				ConsistencyCallback: consistencyErrorCallback,
 				Pmetrics:            rpcp.providerMetricsManager,
 			}
 
-			chainTracker, err = chaintracker.NewChainTracker(ctx, chainFetcher, chainTrackerConfig)
+			chainTracker, err = chaintracker.NewChainTracker(chainCtx, chainFetcher, chainTrackerConfig)
 			if err != nil {
 				return utils.LavaFormatError("panic severity critical error, aborting support for chain api due to node access, continuing with other endpoints", err, utils.Attribute{Key: "chainTrackerConfig", Value: chainTrackerConfig}, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint})
 			}
 
 			utils.LavaFormatDebug("Registering for spec verifications for endpoint", utils.LogAttr("rpcEndpoint", rpcEndpoint))
 			// we register for spec verifications only once, and this triggers all chainFetchers of that specId when it triggers
-			err = rpcp.providerStateTracker.RegisterForSpecVerifications(ctx, specValidator, rpcEndpoint.ChainID)
+			err = rpcp.providerStateTracker.RegisterForSpecVerifications(chainCtx, specValidator, rpcEndpoint.ChainID)
 			if err != nil {
 				return utils.LavaFormatError("failed to RegisterForSpecUpdates, panic severity critical error, aborting support for chain api due to invalid chain parser, continuing with others", err, utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
 			}
 
 			// Any validation needs to be before we store chain tracker for given chain id
 			rpcp.chainTrackers.SetTrackerForChain(rpcProviderEndpoint.ChainID, chainTracker)
+			ownsChainTracker = true
+		} else if chainTracker.IsPaused() {
+			// the endpoints of the chain were removed by a configuration reload, the tracker polls through this one from now on
+			chainTracker.SetChainFetcher(chainFetcher)
+			ownsChainTracker = true
+			utils.LavaFormatInfo("resuming paused chain tracker", utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.String()})
 		} else {
 			utils.LavaFormatDebug("reusing chain tracker", utils.Attribute{Key: "chain", Value: rpcProviderEndpoint.ChainID})
 		}
@@ -454,8 +567,11 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 		rpcp.providerMetricsManager.RegisterRelaysMonitor(chainID, apiInterface, relaysMonitor)
 	}
 
+	// every node url of the endpoint holds its own parallel connections
+	relayScheduler := NewRelayScheduler(rpcp.parallelConnections*uint(len(rpcProviderEndpoint.NodeUrls)), ConsumerRateLimit, ConsumerRateLimitBurst, RelayQueueSize)
+
 	rpcProviderServer := &RPCProviderServer{}
-	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, relaysMonitor)
+	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, relaysMonitor, relayScheduler, rpcp.getResponseCache(chainID))
 	// set up grpc listener
 	var listener *ProviderListener
 	func() {
@@ -476,7 +592,22 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	err = listener.RegisterReceiver(rpcProviderServer, rpcProviderEndpoint)
 	if err != nil {
 		utils.LavaFormatError("error in register receiver", err)
+	} else {
+		rpcp.lock.Lock()
+		rpcp.activeEndpoints[endpointReloadKey(rpcProviderEndpoint)] = &activeEndpoint{
+			endpoint:         rpcProviderEndpoint,
+			server:           rpcProviderServer,
+			sessionManager:   providerSessionManager,
+			chainParser:      chainParser,
+			chainRouter:      chainRouter,
+			chainFetcher:     &chainFetcher,
+			listener:         listener,
+			ownsChainTracker: ownsChainTracker,
+			cancel:           cancel,
+		}
+		rpcp.lock.Unlock()
 	}
+	setupDone = true
 	utils.LavaFormatDebug("provider finished setting up endpoint", utils.Attribute{Key: "endpoint", Value: rpcProviderEndpoint.Key()})
 	// prevents these objects form being overrun later
 	chainParser.Activate()
@@ -488,7 +619,7 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints []*lavasession.RPCProviderEndpoint, err error) {
 	err = viper_endpoints.UnmarshalKey(common.EndpointsConfigName, &endpoints)
 	if err != nil {
-		utils.LavaFormatFatal("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
+		return nil, utils.LavaFormatError("could not unmarshal endpoints", err, utils.Attribute{Key: "viper_endpoints", Value: viper_endpoints.AllSettings()})
 	}
 	for _, endpoint := range endpoints {
 		endpoint.Geolocation = geolocation
@@ -549,6 +680,7 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			var rpcProviderEndpoints []*lavasession.RPCProviderEndpoint
 			var endpoints_strings []string
 			var viper_endpoints *viper.Viper
+			var loadEndpoints func() ([]*lavasession.RPCProviderEndpoint, error)
 			if len(args) > 1 {
 				viper_endpoints, err = common.ParseEndpointArgs(args, Yaml_config_properties, common.EndpointsConfigName)
 				if err != nil {
@@ -578,6 +710,20 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			if err != nil {
 				utils.LavaFormatFatal("failed to read geolocation flag, required flag", err)
 			}
+			if len(args) <= 1 {
+				// the config file is read again with a separate viper on reloads
+				configFile := viper.ConfigFileUsed()
+				loadEndpoints = func() ([]*lavasession.RPCProviderEndpoint, error) {
+					reloadViper := viper.New()
+					reloadViper.SetConfigFile(configFile)
+					reloadViper.SetConfigType("yml")
+					err := reloadViper.ReadInConfig()
+					if err != nil {
+						return nil, err
+					}
+					return ParseEndpoints(reloadViper, geolocation)
+				}
+			}
 			rpcProviderEndpoints, err = ParseEndpoints(viper.GetViper(), geolocation)
 			if err != nil || len(rpcProviderEndpoints) == 0 {
 				return utils.LavaFormatError("invalid endpoints definition", err, utils.Attribute{Key: "endpoint_strings", Value: strings.Join(endpoints_strings, "")})
@@ -669,6 +815,11 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			enableRelaysHealth := viper.GetBool(common.RelaysHealthEnableFlag)
 			relaysHealthInterval := viper.GetDuration(common.RelayHealthIntervalFlag)
 			healthCheckURLPath := viper.GetString(HealthCheckURLPathFlagName)
+			adminListenAddress := viper.GetString(AdminListenAddressFlagName)
+			adminToken := viper.GetString(AdminTokenFlagName)
+			if adminToken == "" {
+				adminToken = os.Getenv(AdminTokenEnv)
+			}
 
 			rpcProviderHealthCheckMetricsOptions := rpcProviderHealthCheckMetricsOptions{
 				enableRelaysHealth,
@@ -690,6 +841,10 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 				rewardsSnapshotThreshold,
 				rewardsSnapshotTimeoutSec,
 				&rpcProviderHealthCheckMetricsOptions,
+				adminListenAddress,
+				adminToken,
+				viper.ConfigFileUsed(),
+				loadEndpoints,
 			}
 
 			rpcProvider := RPCProvider{}
@@ -714,6 +869,8 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint(ShardIDFlagName, DefaultShardID, "shard id")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotThresholdFlagName, rewardserver.DefaultRewardsSnapshotThreshold, "the number of rewards to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotTimeoutSecFlagName, rewardserver.DefaultRewardsSnapshotTimeoutSec, "the seconds to wait until making snapshot of the rewards memory")
+	cmdRPCProvider.Flags().IntVar(&rewardserver.RelayPaymentMaxProofs, rewardserver.RelayPaymentMaxProofsFlagName, rewardserver.RelayPaymentMaxProofs, "the max proofs in a single relay payment transaction")
+	cmdRPCProvider.Flags().Uint64Var(&rewardserver.RelayPaymentMaxGas, rewardserver.RelayPaymentMaxGasFlagName, rewardserver.RelayPaymentMaxGas, "the max simulated gas of a single relay payment transaction, larger batches are split, 0 disables the limit")
 	cmdRPCProvider.Flags().String(StickinessHeaderName, RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
 	cmdRPCProvider.Flags().Uint64Var(&chaintracker.PollingMultiplier, chaintracker.PollingMultiplierFlagName, 1, "when set, forces the chain tracker to poll more often, improving the sync at the cost of more queries")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationInterval, SpecValidationIntervalFlagName, SpecValidationInterval, "determines the interval of which to run validation on the spec for all connected chains")
@@ -721,6 +878,14 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Bool(common.RelaysHealthEnableFlag, true, "enables relays health check")
 	cmdRPCProvider.Flags().Duration(common.RelayHealthIntervalFlag, RelayHealthIntervalFlagDefault, "interval between relay health checks")
 	cmdRPCProvider.Flags().String(HealthCheckURLPathFlagName, HealthCheckURLPathFlagDefault, "the url path for the provider's grpc health check")
+	cmdRPCProvider.Flags().Uint64Var(&ResponseCacheSizeMB, ResponseCacheSizeFlagName, ResponseCacheSizeMB, "megabytes of finalized responses each chain keeps in memory in front of the cache service, 0 disables it")
+	cmdRPCProvider.Flags().Float64Var(&ConsumerRateLimit, ConsumerRateLimitFlagName, ConsumerRateLimit, "compute units per second each consumer project may use on an endpoint, 0 disables rate limiting")
+	cmdRPCProvider.Flags().Float64Var(&ConsumerRateLimitBurst, ConsumerRateLimitBurstFlagName, ConsumerRateLimitBurst, "compute units a consumer project may use at once before being rate limited, defaults to a second worth of the rate limit")
+	cmdRPCProvider.Flags().UintVar(&RelayQueueSize, RelayQueueSizeFlagName, RelayQueueSize, "max relays of a consumer project waiting for a node connection, additional relays are rejected as overloaded")
+	cmdRPCProvider.Flags().String(AdminListenAddressFlagName, "", "the address to serve the admin api on (such as 127.0.0.1:7780), disabled when empty")
+	cmdRPCProvider.Flags().String(AdminTokenFlagName, "", "the token admin api requests must carry, can reference a secret such as env://NAME or file:///path, defaults to $"+AdminTokenEnv)
+	cmdRPCProvider.Flags().DurationVar(&ConfigWatchInterval, ConfigWatchIntervalFlagName, ConfigWatchInterval, "how often to check the config file for endpoint changes to apply without a restart, 0 disables watching, SIGHUP always reloads it")
+	cmdRPCProvider.Flags().DurationVar(&EndpointDrainTimeout, EndpointDrainTimeoutFlagName, EndpointDrainTimeout, "how long relays in flight on removed endpoints and replaced node urls get to finish on a config reload")
 	cmdRPCProvider.Flags().DurationVar(&updaters.TimeOutForFetchingLavaBlocks, common.TimeOutForFetchingLavaBlocksFlag, time.Second*5, "setting the timeout for fetching lava blocks")
 
 	common.AddRollingLogConfig(cmdRPCProvider)
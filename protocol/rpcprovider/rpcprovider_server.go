@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -57,6 +58,9 @@ type RPCProviderServer struct {
 	allowedMissingCUThreshold float64
 	metrics                   *metrics.ProviderMetrics
 	relaysMonitor             *metrics.RelaysMonitor
+	relayScheduler            *RelayScheduler
+	responseCache             *ResponseCache
+	inflightRelays            int64 // atomic, lets removed endpoints drain before closing their connections
 }
 
 type ReliabilityManagerInf interface {
@@ -92,6 +96,8 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	allowedMissingCUThreshold float64,
 	providerMetrics *metrics.ProviderMetrics,
 	relaysMonitor *metrics.RelaysMonitor,
+	relayScheduler *RelayScheduler,
+	responseCache *ResponseCache,
 ) {
 	rpcps.cache = cache
 	rpcps.chainRouter = chainRouter
@@ -107,6 +113,8 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rpcps.allowedMissingCUThreshold = allowedMissingCUThreshold
 	rpcps.metrics = providerMetrics
 	rpcps.relaysMonitor = relaysMonitor
+	rpcps.relayScheduler = relayScheduler
+	rpcps.responseCache = responseCache
 
 	rpcps.initRelaysMonitor(ctx)
 }
@@ -155,6 +163,8 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 	if request.RelayData == nil || request.RelaySession == nil {
 		return nil, utils.LavaFormatWarning("invalid relay request, internal fields are nil", nil)
 	}
+	atomic.AddInt64(&rpcps.inflightRelays, 1)
+	defer atomic.AddInt64(&rpcps.inflightRelays, -1)
 	ctx = utils.AppendUniqueIdentifier(ctx, lavaprotocol.GetSalt(request.RelayData))
 	startTime := time.Now()
 	// This is for the SDK, since the timeout is not automatically added to the request like in Go
@@ -186,18 +196,21 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 		return nil, rpcps.handleRelayErrorStatus(err)
 	}
 
-	// Try sending relay
-	reply, err := rpcps.TryRelay(ctx, request, consumerAddress, chainMessage)
+	// Wait for a node connection, a consumer exceeding its share of the provider is rejected
+	projectId, maxComputeUnits := relaySession.GetConsumerProject()
+	release, err := rpcps.relayScheduler.Admit(ctx, projectId, chainMessage.GetApi().ComputeUnits, maxComputeUnits)
+	var reply *pairingtypes.RelayReply
+	if err == nil {
+		// Try sending relay
+		reply, err = rpcps.TryRelay(ctx, request, consumerAddress, chainMessage)
+		release()
+	}
 
 	if err != nil || common.ContextOutOfTime(ctx) {
 		// failed to send relay. we need to adjust session state. cuSum and relayNumber.
 		relayFailureError := rpcps.providerSessionManager.OnSessionFailure(relaySession, request.RelaySession.RelayNum)
 		if relayFailureError != nil {
-			var extraInfo string
-			if err != nil {
-				extraInfo = err.Error()
-			}
-			err = sdkerrors.Wrapf(relayFailureError, "On relay failure: "+extraInfo)
+			err = wrapRelayFailureError(err, relayFailureError)
 		}
 		err = utils.LavaFormatError("TryRelay Failed", err,
 			utils.Attribute{Key: "request.SessionId", Value: request.RelaySession.SessionId},
@@ -263,6 +276,7 @@ func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingt
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	chainMessage.SetForceCacheRefresh(common.IsForceCacheRefreshFromGrpcContext(ctx))
 	relayCU := chainMessage.GetApi().ComputeUnits
 	virtualEpoch := rpcps.stateTracker.GetVirtualEpoch(uint64(request.RelaySession.Epoch))
 	err = relaySession.PrepareSessionForUsage(ctx, relayCU, request.RelaySession.CuSum, rpcps.allowedMissingCUThreshold, virtualEpoch)
@@ -635,6 +649,18 @@ func (rpcps *RPCProviderServer) verifyRelayRequestMetaData(ctx context.Context,
 	return nil
 }
 
+func wrapRelayFailureError(err error, relayFailureError error) error {
+	if lavasession.ProviderOverloadedError.Is(err) {
+		// keep the overloaded code, the consumer backs off the provider on it
+		return sdkerrors.Wrapf(err, "On relay failure: "+relayFailureError.Error())
+	}
+	var extraInfo string
+	if err != nil {
+		extraInfo = err.Error()
+	}
+	return sdkerrors.Wrapf(relayFailureError, "On relay failure: "+extraInfo)
+}
+
 func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
 	if err == nil {
 		return nil
@@ -643,6 +669,8 @@ func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
 		err = status.Error(codes.Code(lavasession.SessionOutOfSyncError.ABCICode()), err.Error())
 	} else if lavasession.EpochMismatchError.Is(err) {
 		err = status.Error(codes.Code(lavasession.EpochMismatchError.ABCICode()), err.Error())
+	} else if lavasession.ProviderOverloadedError.Is(err) {
+		err = status.Error(codes.Code(lavasession.ProviderOverloadedError.ABCICode()), err.Error())
 	}
 	return err
 }
@@ -720,28 +748,37 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	var reply *pairingtypes.RelayReply = nil
 	var err error = nil
 	ignoredMetadata := []pairingtypes.Metadata{}
-	if requestedBlockHash != nil || finalized {
+	if (requestedBlockHash != nil || finalized) && !chainMsg.GetForceCacheRefresh() {
 		var cacheReply *pairingtypes.CacheRelayReply
 
 		hashKey, outPutFormatter, hashErr := chainlib.HashCacheRequest(request.RelayData, rpcps.rpcProviderEndpoint.ChainID)
 		if hashErr != nil {
 			utils.LavaFormatError("TryRelay Failed computing hash for cache request", hashErr)
 		} else {
-			cacheCtx, cancel := context.WithTimeout(ctx, common.CacheTimeout)
-			cacheReply, err = cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{
-				RequestHash:    hashKey,
-				RequestedBlock: request.RelayData.RequestBlock,
-				ChainId:        rpcps.rpcProviderEndpoint.ChainID,
-				BlockHash:      requestedBlockHash,
-				Finalized:      finalized,
-				SeenBlock:      request.RelayData.SeenBlock,
-			})
-			cancel()
-			reply = cacheReply.GetReply()
+			if finalized {
+				// hot finalized data is served from memory without a round trip to the cache service
+				reply, ignoredMetadata, _ = rpcps.responseCache.Get(hashKey, request.RelayData.RequestBlock)
+			}
+			if reply == nil {
+				cacheCtx, cancel := context.WithTimeout(ctx, common.CacheTimeout)
+				cacheReply, err = cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{
+					RequestHash:    hashKey,
+					RequestedBlock: request.RelayData.RequestBlock,
+					ChainId:        rpcps.rpcProviderEndpoint.ChainID,
+					BlockHash:      requestedBlockHash,
+					Finalized:      finalized,
+					SeenBlock:      request.RelayData.SeenBlock,
+				})
+				cancel()
+				reply = cacheReply.GetReply()
+				ignoredMetadata = cacheReply.GetOptionalMetadata()
+				if finalized {
+					rpcps.responseCache.Set(hashKey, request.RelayData.RequestBlock, reply, ignoredMetadata)
+				}
+			}
 			if reply != nil {
 				reply.Data = outPutFormatter(reply.Data) // setting request id back to reply.
 			}
-			ignoredMetadata = cacheReply.GetOptionalMetadata()
 			if err != nil && performance.NotConnectedError.Is(err) {
 				utils.LavaFormatDebug("cache not connected", utils.LogAttr("err", err), utils.Attribute{Key: "GUID", Value: ctx})
 			}
@@ -768,10 +805,13 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		}
 		reply.Metadata, _, ignoredMetadata = rpcps.chainParser.HandleHeaders(reply.Metadata, chainMsg.GetApiCollection(), spectypes.Header_pass_reply)
 		// TODO: use overwriteReqBlock on the reply metadata to set the correct latest block
-		if cache.CacheActive() && (requestedBlockHash != nil || finalized) {
+		if (cache.CacheActive() || rpcps.responseCache != nil) && (requestedBlockHash != nil || finalized) {
 			// copy request and reply as they change later on and we call SetEntry in a routine.
 			requestedBlock := request.RelayData.RequestBlock                                                       // get requested block before removing it from the data
 			hashKey, _, hashErr := chainlib.HashCacheRequest(request.RelayData, rpcps.rpcProviderEndpoint.ChainID) // get the hash (this changes the data)
+			if finalized && hashErr == nil {
+				rpcps.responseCache.Set(hashKey, requestedBlock, reply, ignoredMetadata)
+			}
 			copyReply := &pairingtypes.RelayReply{}
 			copyReplyErr := protocopy.DeepCopyProtoObject(reply, copyReply)
 			go func() {
@@ -779,6 +819,9 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 					utils.LavaFormatError("Failed copying relay private data on TryRelay", nil, utils.LogAttr("copyReplyErr", copyReplyErr), utils.LogAttr("hashErr", hashErr))
 					return
 				}
+				if !cache.CacheActive() {
+					return
+				}
 				new_ctx := context.Background()
 				new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
 				defer cancel()
@@ -88,6 +88,21 @@ func (sv *SpecValidator) AddChainFetcher(ctx context.Context, chainFetcher *chai
 	return nil
 }
 
+func (sv *SpecValidator) RemoveChainFetcher(chainFetcher *chainlib.ChainFetcherIf, chainId string) {
+	sv.lock.Lock()
+	defer sv.lock.Unlock()
+	chainFetchers := sv.chainFetchers[chainId]
+	for idx, existing := range chainFetchers {
+		if existing == chainFetcher {
+			sv.chainFetchers[chainId] = append(chainFetchers[:idx:idx], chainFetchers[idx+1:]...)
+			break
+		}
+	}
+	if len(sv.chainFetchers[chainId]) == 0 {
+		delete(sv.chainFetchers, chainId)
+	}
+}
+
 func (sv *SpecValidator) AddRPCProviderListener(address string, providerListener *ProviderListener) {
 	sv.lock.Lock()
 	defer sv.lock.Unlock()
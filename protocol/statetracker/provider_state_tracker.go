@@ -127,6 +127,10 @@ func (pst *ProviderStateTracker) TxRelayPayment(ctx context.Context, relayReques
 	return pst.txSender.TxRelayPayment(ctx, relayRequests, description, latestBlocks)
 }
 
+func (pst *ProviderStateTracker) EstimateRelayPaymentGas(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (uint64, error) {
+	return pst.txSender.EstimateRelayPaymentGas(ctx, relayRequests, description, latestBlocks)
+}
+
 func (pst *ProviderStateTracker) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
 	return pst.txSender.SendVoteReveal(voteID, vote)
 }
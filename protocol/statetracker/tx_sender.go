@@ -324,6 +324,23 @@ func (pts *ProviderTxSender) TxRelayPayment(ctx context.Context, relayRequests [
 	return nil
 }
 
+// EstimateRelayPaymentGas simulates a relay payment and returns the gas it uses before adjustment, failing when any of the proofs is rejected
+func (pts *ProviderTxSender) EstimateRelayPaymentGas(ctx context.Context, relayRequests []*pairingtypes.RelaySession, description string, latestBlocks []*pairingtypes.LatestBlockReport) (uint64, error) {
+	msg := pairingtypes.NewMsgRelayPayment(pts.clientCtx.FromAddress.String(), relayRequests, description, latestBlocks)
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	txfactory, err := pts.prepareFactory(pts.txFactory.WithGasPrices(defaultGasPrice))
+	if err != nil {
+		return 0, err
+	}
+	simResult, _, err := tx.CalculateGas(pts.clientCtx, txfactory, msg)
+	if err != nil {
+		return 0, err
+	}
+	return simResult.GasInfo.GasUsed, nil
+}
+
 func (pts *ProviderTxSender) SendVoteReveal(voteID string, vote *reliabilitymanager.VoteData) error {
 	msg := conflicttypes.NewMsgConflictVoteReveal(pts.clientCtx.FromAddress.String(), voteID, vote.Nonce, vote.RelayDataHash)
 	err := pts.SimulateAndBroadCastTxWithRetryOnSeqMismatch(msg, false)
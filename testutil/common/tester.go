@@ -460,6 +460,22 @@ func (ts *Tester) TxDualstakingClaimRewards(
 	return ts.Servers.DualstakingServer.ClaimRewards(ts.GoCtx, msg)
 }
 
+// TxDualstakingSetAutoCompound: implement 'tx dualstaking set-auto-compound'
+func (ts *Tester) TxDualstakingSetAutoCompound(
+	creator string,
+	provider string,
+	enabled bool,
+) (*dualstakingtypes.MsgSetAutoCompoundResponse, error) {
+	validator, _ := ts.GetAccount(VALIDATOR, 0)
+	msg := &dualstakingtypes.MsgSetAutoCompound{
+		Creator:   creator,
+		Validator: sdk.ValAddress(validator.Addr).String(),
+		Provider:  provider,
+		Enabled:   enabled,
+	}
+	return ts.Servers.DualstakingServer.SetAutoCompound(ts.GoCtx, msg)
+}
+
 // TxSubscriptionBuy: implement 'tx subscription buy'
 func (ts *Tester) TxSubscriptionBuy(creator, consumer, plan string, months int, autoRenewal, advancePurchase bool) (*subscriptiontypes.MsgBuyResponse, error) {
 	msg := &subscriptiontypes.MsgBuy{
@@ -505,6 +521,45 @@ func (ts *Tester) TxSubscriptionAutoRenewal(creator, consumer, planIndex string,
 	return err
 }
 
+// TxSubscriptionOveruseDeposit: implement 'tx subscription overuse-deposit'
+func (ts *Tester) TxSubscriptionOveruseDeposit(creator, consumer string, amount sdk.Coin) error {
+	msg := &subscriptiontypes.MsgOveruseDeposit{
+		Creator:  creator,
+		Consumer: consumer,
+		Amount:   amount,
+	}
+	_, err := ts.Servers.SubscriptionServer.OveruseDeposit(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionTransfer: implement 'tx subscription transfer'
+func (ts *Tester) TxSubscriptionTransfer(consumer, newConsumer string) error {
+	msg := subscriptiontypes.NewMsgTransferSubscription(consumer, newConsumer)
+	_, err := ts.Servers.SubscriptionServer.TransferSubscription(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionAcceptTransfer: implement 'tx subscription accept-transfer'
+func (ts *Tester) TxSubscriptionAcceptTransfer(newConsumer, consumer string) error {
+	msg := subscriptiontypes.NewMsgAcceptSubscriptionTransfer(newConsumer, consumer)
+	_, err := ts.Servers.SubscriptionServer.AcceptSubscriptionTransfer(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionTopUp: implement 'tx subscription top-up'
+func (ts *Tester) TxSubscriptionTopUp(creator, consumer string, months int, credit sdk.Coin) error {
+	msg := subscriptiontypes.NewMsgTopUpSubscription(creator, consumer, uint64(months), credit)
+	_, err := ts.Servers.SubscriptionServer.TopUpSubscription(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionCancel: implement 'tx subscription cancel'
+func (ts *Tester) TxSubscriptionCancel(creator, consumer string) error {
+	msg := subscriptiontypes.NewMsgCancelSubscription(creator, consumer)
+	_, err := ts.Servers.SubscriptionServer.CancelSubscription(ts.GoCtx, msg)
+	return err
+}
+
 // TxProjectAddKeys: implement 'tx project add-keys'
 func (ts *Tester) TxProjectAddKeys(projectID, creator string, projectKeys ...projectstypes.ProjectKey) error {
 	msg := projectstypes.MsgAddKeys{
@@ -547,6 +602,20 @@ func (ts *Tester) TxProjectSetPolicy(projectID, subkey string, policy *planstype
 	return ts.Servers.ProjectServer.SetPolicy(ts.GoCtx, msg)
 }
 
+// TxProjectSetEnabled: implement 'tx project set-enabled'
+func (ts *Tester) TxProjectSetEnabled(projectID, creator string, enabled bool) error {
+	msg := projectstypes.NewMsgSetProjectEnabled(creator, projectID, enabled)
+	_, err := ts.Servers.ProjectServer.SetProjectEnabled(ts.GoCtx, msg)
+	return err
+}
+
+// TxProjectSetCuBudget: implement 'tx project set-cu-budget'
+func (ts *Tester) TxProjectSetCuBudget(projectID, subkey string, cuBudget uint64) error {
+	msg := projectstypes.NewMsgSetProjectCuBudget(subkey, projectID, cuBudget)
+	_, err := ts.Servers.ProjectServer.SetProjectCuBudget(ts.GoCtx, msg)
+	return err
+}
+
 // TxPairingStakeProvider: implement 'tx pairing stake-provider'
 func (ts *Tester) TxPairingStakeProvider(
 	addr string,
@@ -767,6 +836,14 @@ func (ts *Tester) QuerySubscriptionNextToMonthExpiry() (*subscriptiontypes.Query
 	return ts.Keepers.Subscription.NextToMonthExpiry(ts.GoCtx, msg)
 }
 
+// QuerySubscriptionOveruse: implement 'q subscription overuse'
+func (ts *Tester) QuerySubscriptionOveruse(consumer string) (*subscriptiontypes.QueryOveruseResponse, error) {
+	msg := &subscriptiontypes.QueryOveruseRequest{
+		Consumer: consumer,
+	}
+	return ts.Keepers.Subscription.Overuse(ts.GoCtx, msg)
+}
+
 // QueryProjectInfo implements 'q project info'
 func (ts *Tester) QueryProjectInfo(projectID string) (*projectstypes.QueryInfoResponse, error) {
 	msg := &projectstypes.QueryInfoRequest{Project: projectID}
@@ -814,6 +891,16 @@ func (ts *Tester) QueryPairingVerifyPairing(chainID, client, provider string, bl
 	return ts.Keepers.Pairing.VerifyPairing(ts.GoCtx, msg)
 }
 
+// QueryPairingUserEntry implements 'q pairing user-entry'
+func (ts *Tester) QueryPairingUserEntry(chainID, client string) (*pairingtypes.QueryUserEntryResponse, error) {
+	msg := &pairingtypes.QueryUserEntryRequest{
+		Address: client,
+		ChainID: chainID,
+		Block:   ts.BlockHeight(),
+	}
+	return ts.Keepers.Pairing.UserEntry(ts.GoCtx, msg)
+}
+
 // QueryPairingEffectivePolicy implements 'q pairing effective-policy'
 func (ts *Tester) QueryPairingEffectivePolicy(chainID, consumer string) (*pairingtypes.QueryEffectivePolicyResponse, error) {
 	msg := &pairingtypes.QueryEffectivePolicyRequest{
@@ -867,6 +954,15 @@ func (ts *Tester) QueryDualstakingDelegatorRewards(delegator string, provider st
 	return ts.Keepers.Dualstaking.DelegatorRewards(ts.GoCtx, msg)
 }
 
+// QueryDualstakingDelegatorAutoCompound implements 'q dualstaking delegator-auto-compound'
+func (ts *Tester) QueryDualstakingDelegatorAutoCompound(delegator string, provider string) (*dualstakingtypes.QueryDelegatorAutoCompoundResponse, error) {
+	msg := &dualstakingtypes.QueryDelegatorAutoCompoundRequest{
+		Delegator: delegator,
+		Provider:  provider,
+	}
+	return ts.Keepers.Dualstaking.DelegatorAutoCompound(ts.GoCtx, msg)
+}
+
 // QueryFixationAllIndices implements 'q fixationstore all-indices'
 func (ts *Tester) QueryFixationAllIndices(storeKey string, prefix string) (*fixationstoretypes.QueryAllIndicesResponse, error) {
 	msg := &fixationstoretypes.QueryAllIndicesRequest{
@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryDelegatorProviders())
 	cmd.AddCommand(CmdQueryProviderDelegators())
 	cmd.AddCommand(CmdQueryDelegatorRewards())
+	cmd.AddCommand(CmdQueryDelegatorAutoCompound())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func CmdQueryDelegatorAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "delegator-auto-compound [delegator]",
+		Short: `shows the auto-compound settings and compounded rewards totals of a delegator.
+		Can be more specific using the optional --provider flag`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegator := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// check if the command includes --provider
+			providerFlag := cmd.Flags().Lookup(providerFlagName)
+			if providerFlag == nil {
+				return fmt.Errorf("%s flag wasn't found", providerFlagName)
+			}
+			provider := providerFlag.Value.String()
+
+			res, err := queryClient.DelegatorAutoCompound(cmd.Context(), &types.QueryDelegatorAutoCompoundRequest{
+				Delegator: delegator,
+				Provider:  provider,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(providerFlagName, "", "output the auto-compound setting of a specific provider")
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [provider] [enabled] [optional: validator] --from <delegator>",
+		Short: "turn auto-compounding of the delegation rewards from a provider on or off",
+		Long: `turn auto-compounding of the delegation rewards from a provider on or off. When on, the
+		rewards are delegated back to the provider on every reward distribution (up to the provider's
+		delegate limit). The compounded rewards are bonded with the given validator (if omitted, the
+		validator is chosen automatically)`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argProvider := args[0]
+			argEnabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			var argValidator string
+			if len(args) > 2 {
+				argValidator = args[2]
+			} else if argEnabled {
+				argValidator = GetValidator(clientCtx)
+			}
+
+			msg := types.NewMsgSetAutoCompound(
+				clientCtx.GetFromAddress().String(),
+				argValidator,
+				argProvider,
+				argEnabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,7 +13,16 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-
+		AutoCompoundList: []types.AutoCompound{
+			{
+				Delegator: "d0",
+				Provider:  "p0",
+			},
+			{
+				Delegator: "d0",
+				Provider:  "p1",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,6 +34,7 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 	require.ElementsMatch(t, genesisState.DelegatorRewardList, got.DelegatorRewardList)
+	require.ElementsMatch(t, genesisState.AutoCompoundList, got.AutoCompoundList)
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
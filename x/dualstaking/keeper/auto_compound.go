@@ -0,0 +1,253 @@
+package keeper
+
+// Auto-compounding lets a delegator opt-in (per provider) to have its delegator
+// rewards converted into additional delegation instead of claiming them manually
+// and delegating them again. The setting holds the validator used to bond the
+// compounded funds and the total amount compounded so far.
+//
+// Compounding takes place on every reward distribution: after the delegator's
+// reward is updated, the accrued reward (in bond denom) is sent to the delegator
+// and delegated back to the same provider (and chain). The compounded amount is
+// capped so the provider's delegations do not exceed its delegate limit, since
+// delegations above the limit yield no rewards; the remainder stays claimable.
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// SetAutoCompound set a specific AutoCompound in the store from its index
+func (k Keeper) SetAutoCompound(ctx sdk.Context, autoCompound types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	b := k.cdc.MustMarshal(&autoCompound)
+	store.Set(types.AutoCompoundKey(
+		autoCompound.Delegator,
+		autoCompound.Provider,
+	), b)
+}
+
+// GetAutoCompound returns an AutoCompound from its index
+func (k Keeper) GetAutoCompound(
+	ctx sdk.Context,
+	delegator string,
+	provider string,
+) (val types.AutoCompound, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+
+	b := store.Get(types.AutoCompoundKey(
+		delegator,
+		provider,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAutoCompound removes an AutoCompound from the store
+func (k Keeper) RemoveAutoCompound(
+	ctx sdk.Context,
+	delegator string,
+	provider string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	store.Delete(types.AutoCompoundKey(
+		delegator,
+		provider,
+	))
+}
+
+// GetAllAutoCompound returns all AutoCompound
+func (k Keeper) GetAllAutoCompound(ctx sdk.Context) (list []types.AutoCompound) {
+	return k.getAutoCompoundWithPrefix(ctx, []byte{})
+}
+
+// GetDelegatorAutoCompound returns all the AutoCompound of a delegator
+func (k Keeper) GetDelegatorAutoCompound(ctx sdk.Context, delegator string) (list []types.AutoCompound) {
+	return k.getAutoCompoundWithPrefix(ctx, types.AutoCompoundDelegatorPrefix(delegator))
+}
+
+func (k Keeper) getAutoCompoundWithPrefix(ctx sdk.Context, keyPrefix []byte) (list []types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoCompoundKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDelegatorAutoCompound turns the auto-compounding of a delegator's rewards
+// from a provider on or off. When enabled, the compounded rewards are bonded
+// with the given validator.
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delegator, validator, provider string, enabled bool) error {
+	autoCompound, found := k.GetAutoCompound(ctx, delegator, provider)
+
+	if enabled {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return utils.LavaFormatWarning("invalid validator address", err,
+				utils.Attribute{Key: "validator", Value: validator},
+			)
+		}
+
+		if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+			return utils.LavaFormatWarning("cannot enable auto-compound", stakingtypes.ErrNoValidatorFound,
+				utils.Attribute{Key: "validator", Value: validator},
+			)
+		}
+
+		nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+		if len(k.GetAllProviderDelegatorDelegations(ctx, delegator, provider, nextEpoch)) == 0 {
+			return utils.LavaFormatWarning("cannot enable auto-compound", types.ErrDelegationNotFound,
+				utils.Attribute{Key: "delegator", Value: delegator},
+				utils.Attribute{Key: "provider", Value: provider},
+			)
+		}
+
+		autoCompound.Validator = validator
+	} else if !found {
+		return utils.LavaFormatWarning("cannot disable auto-compound", fmt.Errorf("auto-compound not set"),
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+		)
+	}
+
+	if !found {
+		autoCompound.Delegator = delegator
+		autoCompound.Provider = provider
+		autoCompound.Compounded = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+	}
+
+	autoCompound.Enabled = enabled
+	k.SetAutoCompound(ctx, autoCompound)
+
+	details := map[string]string{
+		"delegator":  delegator,
+		"provider":   provider,
+		"validator":  autoCompound.Validator,
+		"enabled":    fmt.Sprint(enabled),
+		"compounded": autoCompound.Compounded.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SetAutoCompoundEventName, details, "Set Delegator Auto-Compound")
+
+	return nil
+}
+
+// autoCompoundRoom returns how much can still be delegated to a provider (on a chain)
+// before its delegations exceed its delegate limit.
+func (k Keeper) autoCompoundRoom(ctx sdk.Context, provider, chainID string) math.Int {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return math.ZeroInt()
+	}
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+	if !found {
+		return math.ZeroInt()
+	}
+	room := stakeEntry.DelegateLimit.Amount.Sub(stakeEntry.DelegateTotal.Amount)
+	if !room.IsPositive() {
+		return math.ZeroInt()
+	}
+	return room
+}
+
+// autoCompoundDelegatorReward converts the accrued reward of a delegation into
+// additional delegation, if the delegator enabled auto-compounding with the
+// provider. The compounded amount is capped by the room left below the provider's
+// delegate limit, which is reduced accordingly (a nil room means no limit).
+// Failures are not fatal: the reward simply remains claimable.
+func (k Keeper) autoCompoundDelegatorReward(ctx sdk.Context, delegation types.Delegation, room *math.Int) {
+	autoCompound, found := k.GetAutoCompound(ctx, delegation.Delegator, delegation.Provider)
+	if !found || !autoCompound.Enabled {
+		return
+	}
+
+	rewardMapKey := types.DelegationKey(delegation.Provider, delegation.Delegator, delegation.ChainID)
+	delegatorReward, found := k.GetDelegatorReward(ctx, rewardMapKey)
+	if !found {
+		return
+	}
+
+	amount := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatorReward.Amount.AmountOf(k.stakingKeeper.BondDenom(ctx)))
+
+	// delegations above the provider's delegate limit yield no rewards, so only
+	// compound up to the limit (the provider's self delegation has no limit)
+	if room != nil {
+		amount.Amount = math.MinInt(amount.Amount, *room)
+	}
+
+	if amount.IsZero() {
+		return
+	}
+
+	// compound in a cached context so a failure leaves no partial changes
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.compoundDelegatorReward(cacheCtx, delegatorReward, autoCompound.Validator, amount)
+	if err != nil {
+		utils.LavaFormatWarning("failed to auto-compound delegator reward", err,
+			utils.Attribute{Key: "delegator", Value: delegation.Delegator},
+			utils.Attribute{Key: "provider", Value: delegation.Provider},
+			utils.Attribute{Key: "chainID", Value: delegation.ChainID},
+			utils.Attribute{Key: "amount", Value: amount.String()},
+		)
+		return
+	}
+	writeCache()
+
+	if room != nil {
+		*room = room.Sub(amount.Amount)
+	}
+
+	autoCompound.Compounded = autoCompound.Compounded.Add(amount)
+	k.SetAutoCompound(ctx, autoCompound)
+
+	details := map[string]string{
+		"delegator":  delegation.Delegator,
+		"provider":   delegation.Provider,
+		"chainID":    delegation.ChainID,
+		"validator":  autoCompound.Validator,
+		"amount":     amount.String(),
+		"compounded": autoCompound.Compounded.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.AutoCompoundEventName, details, "Auto-Compound Delegator Reward")
+}
+
+// compoundDelegatorReward deducts the amount from the delegator reward and
+// delegates it (through the validator) to the reward's provider and chain.
+func (k Keeper) compoundDelegatorReward(ctx sdk.Context, delegatorReward types.DelegatorReward, validator string, amount sdk.Coin) error {
+	delegatorAcc, err := sdk.AccAddressFromBech32(delegatorReward.Delegator)
+	if err != nil {
+		return err
+	}
+
+	rewardMapKey := types.DelegationKey(delegatorReward.Provider, delegatorReward.Delegator, delegatorReward.ChainId)
+	delegatorReward.Amount = delegatorReward.Amount.Sub(amount)
+	if delegatorReward.Amount.IsZero() {
+		k.RemoveDelegatorReward(ctx, rewardMapKey)
+	} else {
+		k.SetDelegatorReward(ctx, delegatorReward)
+	}
+
+	// the reward coins are held by the module until claimed; release them to
+	// the delegator so they can be bonded on its behalf
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAcc, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	return k.DelegateFull(ctx, delegatorReward.Delegator, validator, delegatorReward.Provider, delegatorReward.ChainId, amount)
+}
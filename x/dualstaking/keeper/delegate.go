@@ -164,6 +164,11 @@ func (k Keeper) decreaseDelegation(ctx sdk.Context, delegator, provider, chainID
 		}
 	}
 
+	// the auto-compound setting goes away with the delegator's last delegation to the provider
+	if delegationEntry.Amount.IsZero() && !k.hasProviderDelegatorDelegations(ctx, delegator, provider, nextEpoch) {
+		k.RemoveAutoCompound(ctx, delegator, provider)
+	}
+
 	if provider != types.EMPTY_PROVIDER {
 		return k.modifyStakeEntryDelegation(ctx, delegator, provider, chainID, amount, false)
 	}
@@ -461,6 +466,18 @@ func (k Keeper) GetDelegation(ctx sdk.Context, delegator, provider, chainID stri
 	return delegationEntry, found
 }
 
+// hasProviderDelegatorDelegations returns whether a delegator has delegations to a provider (on any chain)
+func (k Keeper) hasProviderDelegatorDelegations(ctx sdk.Context, delegator, provider string, epoch uint64) bool {
+	prefix := types.DelegationKey(provider, delegator, "")
+	for _, ind := range k.delegationFS.GetAllEntryIndicesWithPrefix(ctx, prefix) {
+		var delegation types.Delegation
+		if k.delegationFS.FindEntry(ctx, ind, epoch, &delegation) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) GetAllProviderDelegatorDelegations(ctx sdk.Context, delegator, provider string, epoch uint64) []types.Delegation {
 	prefix := types.DelegationKey(provider, delegator, "")
 	indices := k.delegationFS.GetAllEntryIndicesWithPrefix(ctx, prefix)
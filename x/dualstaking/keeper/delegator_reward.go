@@ -218,7 +218,7 @@ func (k Keeper) RewardProvidersAndDelegators(ctx sdk.Context, providerAddr sdk.A
 	fullProviderReward := providerReward.Add(leftoverRewards...)
 
 	if !calcOnlyProvider {
-		k.rewardDelegator(ctx, types.Delegation{Provider: providerAddr.String(), ChainID: chainID, Delegator: providerAddr.String()}, fullProviderReward, senderModule)
+		k.rewardDelegator(ctx, types.Delegation{Provider: providerAddr.String(), ChainID: chainID, Delegator: providerAddr.String()}, fullProviderReward, senderModule, nil)
 	}
 
 	return fullProviderReward, claimableRewards, nil
@@ -228,11 +228,18 @@ func (k Keeper) RewardProvidersAndDelegators(ctx sdk.Context, providerAddr sdk.A
 func (k Keeper) updateDelegatorsReward(ctx sdk.Context, totalDelegations math.Int, delegations []types.Delegation, delegatorsReward sdk.Coins, senderModule string, calcOnly bool) (leftoverRewards sdk.Coins) {
 	usedDelegatorRewards := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())) // the delegator rewards are calculated using int division, so there might be leftovers
 
+	// the delegations share the provider's room for auto-compounded rewards (all of them are of
+	// the same provider and chain), so it is taken once and reduced with each compounding
+	var autoCompoundRoom math.Int
+	if !calcOnly && len(delegations) > 0 {
+		autoCompoundRoom = k.autoCompoundRoom(ctx, delegations[0].Provider, delegations[0].ChainID)
+	}
+
 	for _, delegation := range delegations {
 		delegatorReward := k.CalcDelegatorReward(ctx, delegatorsReward, totalDelegations, delegation)
 
 		if !calcOnly {
-			k.rewardDelegator(ctx, delegation, delegatorReward, senderModule)
+			k.rewardDelegator(ctx, delegation, delegatorReward, senderModule, &autoCompoundRoom)
 		}
 
 		usedDelegatorRewards = usedDelegatorRewards.Add(delegatorReward...)
@@ -241,7 +248,9 @@ func (k Keeper) updateDelegatorsReward(ctx sdk.Context, totalDelegations math.In
 	return delegatorsReward.Sub(usedDelegatorRewards...)
 }
 
-func (k Keeper) rewardDelegator(ctx sdk.Context, delegation types.Delegation, amount sdk.Coins, senderModule string) {
+// rewardDelegator adds the amount to the delegator's reward and auto-compounds it (if enabled) within
+// the given room below the provider's delegate limit (nil for no limit)
+func (k Keeper) rewardDelegator(ctx sdk.Context, delegation types.Delegation, amount sdk.Coins, senderModule string, autoCompoundRoom *math.Int) {
 	if amount.IsZero() {
 		return
 	}
@@ -260,7 +269,10 @@ func (k Keeper) rewardDelegator(ctx sdk.Context, delegation types.Delegation, am
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount)
 	if err != nil {
 		utils.LavaFormatError("failed to send rewards to module", err, utils.LogAttr("sender", senderModule), utils.LogAttr("amount", amount.String()))
+		return
 	}
+
+	k.autoCompoundDelegatorReward(ctx, delegation, autoCompoundRoom)
 }
 
 func (k Keeper) PayContributors(ctx sdk.Context, senderModule string, contributorAddresses []sdk.AccAddress, contributorReward sdk.Coins, specId string) error {
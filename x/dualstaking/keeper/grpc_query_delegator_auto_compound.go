@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DelegatorAutoCompound(goCtx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var autoCompounds []types.AutoCompound
+	if req.Provider != "" {
+		autoCompound, found := k.GetAutoCompound(ctx, req.Delegator, req.Provider)
+		if found {
+			autoCompounds = append(autoCompounds, autoCompound)
+		}
+	} else {
+		autoCompounds = k.GetDelegatorAutoCompound(ctx, req.Delegator)
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{AutoCompounds: autoCompounds}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgSetAutoCompoundResponse{}, utils.LavaFormatError("invalid creator address", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return &types.MsgSetAutoCompoundResponse{}, utils.LavaFormatError("invalid provider address", err)
+	}
+
+	err = k.Keeper.SetDelegatorAutoCompound(
+		ctx,
+		msg.Creator,
+		msg.Validator,
+		msg.Provider,
+		msg.Enabled,
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, err
+}
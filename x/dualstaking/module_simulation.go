@@ -39,6 +39,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgClaimRewards int = 100
 
+	opWeightMsgSetAutoCompound = "op_weight_msg_set_auto_compound"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetAutoCompound int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -111,6 +115,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		dualstakingsimulation.SimulateMsgClaimRewards(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgSetAutoCompound int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = defaultWeightMsgSetAutoCompound
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAutoCompound,
+		dualstakingsimulation.SimulateMsgSetAutoCompound(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -14,6 +14,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRedelegate{}, "dualstaking/Redelegate", nil)
 	cdc.RegisterConcrete(&MsgUnbond{}, "dualstaking/Unbond", nil)
 	cdc.RegisterConcrete(&MsgClaimRewards{}, "dualstaking/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "dualstaking/MsgSetAutoCompound", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +31,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimRewards{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoCompound{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
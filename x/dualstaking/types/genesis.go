@@ -15,6 +15,7 @@ func DefaultGenesis() *GenesisState {
 		// this line is used by starport scaffolding # genesis/types/default
 		Params:              DefaultParams(),
 		DelegatorRewardList: []DelegatorReward{},
+		AutoCompoundList:    []AutoCompound{},
 		DelegationsFS:       *fixationstoretypes.DefaultGenesis(),
 		DelegatorsFS:        *fixationstoretypes.DefaultGenesis(),
 	}
@@ -33,6 +34,17 @@ func (gs GenesisState) Validate() error {
 		}
 		delegatorRewardIndexMap[index] = struct{}{}
 	}
+
+	// Check for duplicated index in autoCompound
+	autoCompoundIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AutoCompoundList {
+		index := string(AutoCompoundKey(elem.Delegator, elem.Provider))
+		if _, ok := autoCompoundIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for autoCompound")
+		}
+		autoCompoundIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -31,6 +31,7 @@ type GenesisState struct {
 	DelegationsFS       types.GenesisState `protobuf:"bytes,2,opt,name=delegationsFS,proto3" json:"delegationsFS"`
 	DelegatorsFS        types.GenesisState `protobuf:"bytes,3,opt,name=delegatorsFS,proto3" json:"delegatorsFS"`
 	DelegatorRewardList []DelegatorReward  `protobuf:"bytes,5,rep,name=delegator_reward_list,json=delegatorRewardList,proto3" json:"delegator_reward_list"`
+	AutoCompoundList    []AutoCompound     `protobuf:"bytes,6,rep,name=auto_compound_list,json=autoCompoundList,proto3" json:"auto_compound_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +95,13 @@ func (m *GenesisState) GetDelegatorRewardList() []DelegatorReward {
 	return nil
 }
 
+func (m *GenesisState) GetAutoCompoundList() []AutoCompound {
+	if m != nil {
+		return m.AutoCompoundList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.dualstaking.GenesisState")
 }
@@ -103,28 +111,31 @@ func init() {
 }
 
 var fileDescriptor_d5bca863c53f218f = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x4d, 0x4b, 0xfb, 0x30,
-	0x1c, 0xc7, 0xdb, 0x3d, 0xf1, 0xa7, 0xdb, 0x1f, 0xa4, 0x2a, 0x94, 0x1d, 0xe2, 0x50, 0x94, 0x0d,
-	0x21, 0x85, 0x79, 0xf7, 0x30, 0x7c, 0x00, 0xf1, 0x20, 0x9b, 0x27, 0x2f, 0x23, 0x5b, 0x63, 0x0c,
-	0x76, 0xcd, 0x48, 0x7e, 0xd3, 0xf9, 0x2e, 0x7c, 0x59, 0x3b, 0xee, 0xe8, 0x49, 0xa4, 0x7d, 0x23,
-	0xd2, 0x34, 0x8e, 0x45, 0xe8, 0xc5, 0x53, 0xd2, 0xf2, 0xf9, 0x7e, 0x92, 0x6f, 0x7e, 0xde, 0x49,
-	0x4c, 0x5e, 0x48, 0x42, 0x21, 0xcc, 0xd7, 0x30, 0x5a, 0x90, 0x58, 0x01, 0x79, 0xe6, 0x09, 0x0b,
-	0x19, 0x4d, 0xa8, 0xe2, 0x0a, 0xcf, 0xa5, 0x00, 0xe1, 0x07, 0x86, 0xc3, 0xf9, 0x8a, 0xb7, 0xb8,
-	0xf6, 0x1e, 0x13, 0x4c, 0x68, 0x28, 0xcc, 0x77, 0x05, 0xdf, 0x3e, 0x2e, 0xf5, 0xce, 0x89, 0x24,
-	0x33, 0xa3, 0x6d, 0xf7, 0x2c, 0xec, 0x91, 0x2f, 0x09, 0x70, 0x91, 0x28, 0x10, 0x92, 0x6e, 0xbe,
-	0x0c, 0x7a, 0x64, 0xa1, 0xc0, 0x67, 0x54, 0x16, 0x9c, 0xde, 0x1a, 0x28, 0x2c, 0x3d, 0x36, 0xa2,
-	0x31, 0x65, 0x04, 0x84, 0x1c, 0x4b, 0xfa, 0x4a, 0x64, 0x54, 0x04, 0x0e, 0xb3, 0x8a, 0xd7, 0xba,
-	0x2e, 0x9a, 0x8e, 0x80, 0x00, 0xf5, 0xcf, 0xbd, 0x46, 0x71, 0xc3, 0xc0, 0xed, 0xb8, 0xdd, 0x66,
-	0xbf, 0x83, 0xcb, 0x9a, 0xe3, 0x3b, 0xcd, 0x0d, 0x6a, 0xab, 0xcf, 0x03, 0x67, 0x68, 0x52, 0xfe,
-	0xbd, 0xf7, 0xdf, 0x1c, 0x95, 0x17, 0xb9, 0x1a, 0x05, 0x15, 0xad, 0xe9, 0xda, 0x1a, 0xab, 0x29,
-	0xde, 0xbe, 0x80, 0xd1, 0xd9, 0x12, 0x7f, 0xe8, 0xb5, 0x36, 0x05, 0x72, 0x69, 0xf5, 0x4f, 0x52,
-	0xcb, 0xe1, 0x4f, 0xbd, 0xfd, 0xdf, 0x8f, 0x32, 0x8e, 0xb9, 0x82, 0xa0, 0xde, 0xa9, 0x76, 0x9b,
-	0xfd, 0x5e, 0x79, 0xf1, 0x8b, 0x9f, 0xd8, 0x50, 0xa7, 0x8c, 0x7d, 0x37, 0xb2, 0x7f, 0xdf, 0x72,
-	0x05, 0x37, 0xb5, 0x7f, 0xb5, 0x9d, 0xfa, 0xe0, 0x72, 0x95, 0x22, 0x77, 0x9d, 0x22, 0xf7, 0x2b,
-	0x45, 0xee, 0x7b, 0x86, 0x9c, 0x75, 0x86, 0x9c, 0x8f, 0x0c, 0x39, 0x0f, 0xa7, 0x8c, 0xc3, 0xd3,
-	0x62, 0x82, 0xa7, 0x62, 0x66, 0xcf, 0x6e, 0x69, 0x4d, 0x0f, 0xde, 0xe6, 0x54, 0x4d, 0x1a, 0x7a,
-	0x66, 0x67, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xd4, 0x46, 0x25, 0xb5, 0x02, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x93, 0xaf, 0x3f, 0x7c, 0xa4, 0x15, 0x4a, 0x54, 0x08, 0x5d, 0xc4, 0xa2, 0x58, 0x5a,
+	0x94, 0x09, 0xd4, 0xbd, 0x60, 0xfd, 0x03, 0x71, 0x21, 0xad, 0xab, 0x6e, 0xca, 0xb4, 0x19, 0xe3,
+	0x60, 0x92, 0x09, 0x33, 0x27, 0x5a, 0xef, 0xc2, 0x3b, 0x72, 0xdb, 0x65, 0x97, 0xae, 0x44, 0xda,
+	0x1b, 0x91, 0x4c, 0xc6, 0xd2, 0x11, 0xb2, 0x71, 0x95, 0xc9, 0xf0, 0xbc, 0xcf, 0x99, 0x73, 0x38,
+	0x56, 0x3b, 0xc4, 0xcf, 0x38, 0x26, 0xe0, 0x65, 0x5f, 0xcf, 0x4f, 0x71, 0x28, 0x00, 0x3f, 0xd1,
+	0x38, 0xf0, 0x02, 0x12, 0x13, 0x41, 0x05, 0x4a, 0x38, 0x03, 0x66, 0x3b, 0x8a, 0x43, 0xd9, 0x17,
+	0x6d, 0x70, 0xcd, 0x9d, 0x80, 0x05, 0x4c, 0x42, 0x5e, 0x76, 0xca, 0xf9, 0xe6, 0x61, 0xa1, 0x37,
+	0xc1, 0x1c, 0x47, 0x4a, 0xdb, 0xec, 0x6a, 0xd8, 0x03, 0x9d, 0x61, 0xa0, 0x2c, 0x16, 0xc0, 0x38,
+	0x59, 0xff, 0x29, 0xf4, 0x40, 0x43, 0x81, 0x46, 0x84, 0xe7, 0x9c, 0x3c, 0x2a, 0xc8, 0x2b, 0x2c,
+	0xeb, 0x93, 0x90, 0x04, 0x18, 0x18, 0x1f, 0x73, 0xf2, 0x82, 0xb9, 0xaf, 0x02, 0xc7, 0x85, 0x01,
+	0x9c, 0x02, 0x1b, 0x4f, 0x59, 0x94, 0xb0, 0x34, 0x56, 0xf4, 0xfe, 0x7b, 0xc9, 0xaa, 0x5f, 0xe7,
+	0x73, 0x19, 0x02, 0x06, 0x62, 0x9f, 0x5a, 0xd5, 0xbc, 0x1f, 0xc7, 0x6c, 0x99, 0x9d, 0x5a, 0xaf,
+	0x85, 0x8a, 0xe6, 0x84, 0xee, 0x24, 0xd7, 0x2f, 0xcf, 0x3f, 0xf7, 0x8c, 0x81, 0x4a, 0xd9, 0xf7,
+	0xd6, 0x96, 0x7a, 0x58, 0xd6, 0xf6, 0xd5, 0xd0, 0xf9, 0x27, 0x35, 0x1d, 0x5d, 0xa3, 0xcd, 0x05,
+	0x6d, 0x3e, 0x40, 0xe9, 0x74, 0x89, 0x3d, 0xb0, 0xea, 0xeb, 0x76, 0x33, 0x69, 0xe9, 0x4f, 0x52,
+	0xcd, 0x61, 0x4f, 0xad, 0xdd, 0xdf, 0x23, 0x1c, 0x87, 0x54, 0x80, 0x53, 0x69, 0x95, 0x3a, 0xb5,
+	0x5e, 0xb7, 0xb8, 0xf1, 0x8b, 0x9f, 0xd8, 0x40, 0xa6, 0x94, 0x7d, 0xdb, 0xd7, 0xaf, 0x6f, 0xa9,
+	0x00, 0x7b, 0x64, 0xd9, 0xda, 0xd8, 0xf3, 0x0a, 0x55, 0x59, 0xa1, 0x5d, 0x5c, 0xe1, 0x2c, 0x05,
+	0x76, 0xae, 0x22, 0x4a, 0xdf, 0xc0, 0x1b, 0x77, 0x99, 0xfb, 0xa6, 0xfc, 0xbf, 0xdc, 0xa8, 0xf4,
+	0x2f, 0xe7, 0x4b, 0xd7, 0x5c, 0x2c, 0x5d, 0xf3, 0x6b, 0xe9, 0x9a, 0x6f, 0x2b, 0xd7, 0x58, 0xac,
+	0x5c, 0xe3, 0x63, 0xe5, 0x1a, 0xa3, 0xa3, 0x80, 0xc2, 0x63, 0x3a, 0x41, 0x53, 0x16, 0xe9, 0x5b,
+	0x34, 0xd3, 0xd6, 0x02, 0x5e, 0x13, 0x22, 0x26, 0x55, 0xb9, 0x0f, 0x27, 0xdf, 0x03, 0x00, 0x5c,
+	0xaa, 0x03, 0x6e, 0x3f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +158,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundList) > 0 {
+		for iNdEx := len(m.AutoCompoundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DelegatorRewardList) > 0 {
 		for iNdEx := len(m.DelegatorRewardList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -223,6 +248,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundList) > 0 {
+		for _, e := range m.AutoCompoundList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -394,6 +425,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundList = append(m.AutoCompoundList, AutoCompound{})
+			if err := m.AutoCompoundList[len(m.AutoCompoundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
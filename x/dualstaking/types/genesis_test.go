@@ -33,6 +33,16 @@ func TestGenesisState_Validate(t *testing.T) {
 						ChainId:   "c1",
 					},
 				},
+				AutoCompoundList: []types.AutoCompound{
+					{
+						Delegator: "d0",
+						Provider:  "p0",
+					},
+					{
+						Delegator: "d0",
+						Provider:  "p1",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -55,6 +65,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated autoCompound",
+			genState: &types.GenesisState{
+				AutoCompoundList: []types.AutoCompound{
+					{
+						Delegator: "d0",
+						Provider:  "p0",
+					},
+					{
+						Delegator: "d0",
+						Provider:  "p0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,26 @@
+package types
+
+const (
+	// AutoCompoundKeyPrefix is the prefix to retrieve all AutoCompound
+	AutoCompoundKeyPrefix = "AutoCompound/value/"
+)
+
+// AutoCompoundKey returns the store key to retrieve an AutoCompound from the index fields.
+// The delegator comes first so all the settings of a delegator can be iterated by prefix.
+func AutoCompoundKey(
+	delegator string,
+	provider string,
+) []byte {
+	var key []byte
+
+	key = append(key, AutoCompoundDelegatorPrefix(delegator)...)
+	key = append(key, []byte(provider)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AutoCompoundDelegatorPrefix returns the store prefix of all the AutoCompound of a delegator
+func AutoCompoundDelegatorPrefix(delegator string) []byte {
+	return []byte(delegator + " ")
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAutoCompound = "set_auto_compound"
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+func NewMsgSetAutoCompound(delegator string, validator string, provider string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Creator:   delegator,
+		Validator: validator,
+		Provider:  provider,
+		Enabled:   enabled,
+	}
+}
+
+func (msg *MsgSetAutoCompound) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoCompound) Type() string {
+	return TypeMsgSetAutoCompound
+}
+
+func (msg *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg *MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	// the validator is only needed to bond the compounded rewards
+	if msg.Enabled {
+		_, err = sdk.ValAddressFromBech32(msg.Validator)
+		if err != nil {
+			return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+		}
+	}
+
+	return nil
+}
@@ -471,6 +471,102 @@ func (m *DelegatorRewardInfo) GetAmount() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+type QueryDelegatorAutoCompoundRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{9}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type QueryDelegatorAutoCompoundResponse struct {
+	AutoCompounds []AutoCompound `protobuf:"bytes,1,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8393eed0cfbc46b2, []int{10}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetAutoCompounds() []AutoCompound {
+	if m != nil {
+		return m.AutoCompounds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.dualstaking.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.dualstaking.QueryParamsResponse")
@@ -481,6 +577,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorRewardsRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsRequest")
 	proto.RegisterType((*QueryDelegatorRewardsResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorRewardsResponse")
 	proto.RegisterType((*DelegatorRewardInfo)(nil), "lavanet.lava.dualstaking.DelegatorRewardInfo")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "lavanet.lava.dualstaking.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "lavanet.lava.dualstaking.QueryDelegatorAutoCompoundResponse")
 }
 
 func init() {
@@ -488,51 +586,57 @@ func init() {
 }
 
 var fileDescriptor_8393eed0cfbc46b2 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x14, 0x2d, 0x30, 0xf5, 0x60, 0x06, 0x0e, 0xa5, 0xc1, 0xa5, 0x6e, 0x30, 0x36, 0x2a,
-	0x3b, 0x82, 0x89, 0x82, 0x26, 0x46, 0x11, 0x0f, 0x24, 0x12, 0xb1, 0x09, 0x17, 0x2f, 0xcd, 0xb4,
-	0x3b, 0x2e, 0x13, 0xda, 0x99, 0x65, 0x67, 0x0a, 0x12, 0xc2, 0xc5, 0x3f, 0xa0, 0x09, 0xff, 0xc2,
-	0x9b, 0xff, 0x82, 0x44, 0x0f, 0x24, 0x5e, 0x8c, 0x07, 0x35, 0xe0, 0x0f, 0x31, 0x3b, 0x3b, 0x5b,
-	0x77, 0x81, 0x65, 0x8b, 0x89, 0xa7, 0xe9, 0xbe, 0xf9, 0xde, 0xfb, 0xde, 0xf7, 0x5e, 0xbe, 0x29,
-	0x9c, 0xee, 0x90, 0x2d, 0xc2, 0xa9, 0xc2, 0xe1, 0x89, 0xdd, 0x1e, 0xe9, 0x48, 0x45, 0x36, 0x18,
-	0xf7, 0xf0, 0x66, 0x8f, 0x06, 0x3b, 0x8e, 0x1f, 0x08, 0x25, 0x50, 0xc5, 0xa0, 0x9c, 0xf0, 0x74,
-	0x12, 0xa8, 0xea, 0xb8, 0x27, 0x3c, 0xa1, 0x41, 0x38, 0xfc, 0x15, 0xe1, 0xab, 0x93, 0x9e, 0x10,
-	0x5e, 0x87, 0x62, 0xe2, 0x33, 0x4c, 0x38, 0x17, 0x8a, 0x28, 0x26, 0xb8, 0x34, 0xb7, 0xb7, 0xda,
-	0x42, 0x76, 0x85, 0xc4, 0x2d, 0x22, 0x69, 0x44, 0x83, 0xb7, 0x66, 0x5b, 0x54, 0x91, 0x59, 0xec,
-	0x13, 0x8f, 0x71, 0x0d, 0x36, 0xd8, 0x1b, 0x99, 0xfd, 0xf9, 0x24, 0x20, 0xdd, 0xb8, 0xe4, 0xcd,
-	0x4c, 0x98, 0x4b, 0x3b, 0xd4, 0x23, 0x8a, 0x1a, 0xa0, 0x95, 0xe4, 0x8e, 0x59, 0xdb, 0x82, 0x19,
-	0x3e, 0x7b, 0x1c, 0xa2, 0x57, 0x61, 0x47, 0xab, 0xba, 0x7a, 0x83, 0x6e, 0xf6, 0xa8, 0x54, 0xf6,
-	0x1a, 0x1c, 0x4b, 0x45, 0xa5, 0x2f, 0xb8, 0xa4, 0xe8, 0x31, 0x2c, 0x45, 0x5d, 0x54, 0x40, 0x0d,
-	0xd4, 0xcb, 0x73, 0x35, 0x27, 0x6b, 0x4e, 0x4e, 0x94, 0xb9, 0x78, 0xe9, 0xe0, 0xc7, 0x54, 0xa1,
-	0x61, 0xb2, 0x6c, 0x02, 0x2d, 0x5d, 0x76, 0x29, 0xea, 0x51, 0x04, 0xab, 0x81, 0xd8, 0x62, 0x2e,
-	0x0d, 0x62, 0x62, 0x34, 0x09, 0x47, 0xdd, 0xf8, 0x52, 0x93, 0x8c, 0x36, 0xfe, 0x06, 0xd0, 0x75,
-	0x78, 0x65, 0x9b, 0xa9, 0xf5, 0xa6, 0x4f, 0xb9, 0xcb, 0xb8, 0x57, 0x29, 0xd6, 0x40, 0x7d, 0xa4,
-	0x51, 0x0e, 0x63, 0xab, 0x51, 0xc8, 0x16, 0x70, 0x2a, 0x93, 0xc2, 0xa8, 0x78, 0x01, 0xcb, 0xa6,
-	0x64, 0xb8, 0xa3, 0x0a, 0xa8, 0x0d, 0xd5, 0xcb, 0x73, 0xd3, 0xd9, 0x52, 0x96, 0xfa, 0x60, 0x23,
-	0x27, 0x99, 0x6e, 0x37, 0x8d, 0xa6, 0x98, 0xa7, 0x4f, 0xdc, 0xd7, 0x54, 0x85, 0x23, 0xbe, 0xb9,
-	0x34, 0x92, 0xfa, 0xdf, 0x17, 0x51, 0x74, 0x16, 0xc1, 0x7f, 0x51, 0x24, 0xe1, 0x64, 0x7a, 0x84,
-	0x0d, 0xba, 0x4d, 0x02, 0x77, 0xc0, 0x1d, 0x25, 0xd5, 0x16, 0x4f, 0xa8, 0x9d, 0x80, 0x23, 0xed,
-	0x75, 0xc2, 0x78, 0x93, 0xb9, 0x95, 0x21, 0x7d, 0x37, 0xac, 0xbf, 0x97, 0x5d, 0x9b, 0xc3, 0x6b,
-	0x19, 0xa4, 0x46, 0xe3, 0x0a, 0x1c, 0x0e, 0xa2, 0x90, 0xd1, 0x37, 0x93, 0xab, 0x2f, 0x2e, 0xb2,
-	0xcc, 0xdf, 0x08, 0x23, 0x34, 0xae, 0x61, 0x7f, 0x02, 0x70, 0xec, 0x0c, 0xd8, 0xb9, 0xcb, 0x4a,
-	0xb6, 0x5f, 0x4c, 0xb5, 0x8f, 0xda, 0xb0, 0x44, 0xba, 0xa2, 0xc7, 0x55, 0x65, 0x48, 0x37, 0x37,
-	0xe1, 0x44, 0xbe, 0x73, 0x42, 0xdf, 0x39, 0xc6, 0x77, 0xce, 0x33, 0xc1, 0xf8, 0xe2, 0xdd, 0xb0,
-	0x91, 0x8f, 0x3f, 0xa7, 0xea, 0x1e, 0x53, 0xeb, 0xbd, 0x96, 0xd3, 0x16, 0x5d, 0x6c, 0x4c, 0x1a,
-	0x1d, 0x33, 0xd2, 0xdd, 0xc0, 0x6a, 0xc7, 0xa7, 0x52, 0x27, 0xc8, 0x86, 0x29, 0x3d, 0xb7, 0x5f,
-	0x82, 0x97, 0xf5, 0x90, 0xd0, 0x7b, 0x00, 0x4b, 0x91, 0xc3, 0xd0, 0x9d, 0xec, 0x31, 0x9c, 0x36,
-	0x76, 0x75, 0x66, 0x40, 0x74, 0x34, 0x74, 0xbb, 0xfe, 0xee, 0xeb, 0xef, 0xfd, 0xa2, 0x8d, 0x6a,
-	0x38, 0xe7, 0x59, 0x42, 0x5f, 0x00, 0x44, 0xa7, 0x3d, 0x87, 0xe6, 0x73, 0xf8, 0x32, 0x5f, 0x82,
-	0xea, 0xc2, 0x3f, 0x64, 0x9a, 0xae, 0x9f, 0xea, 0xae, 0x1f, 0xa1, 0x05, 0x9c, 0xf7, 0x4a, 0x8a,
-	0xa0, 0x19, 0x6f, 0x57, 0xe2, 0xdd, 0x7e, 0x70, 0x0f, 0x7d, 0x06, 0x10, 0x9d, 0x36, 0x5c, 0xae,
-	0x9c, 0xcc, 0x47, 0x20, 0x57, 0x4e, 0xb6, 0xbb, 0xed, 0x27, 0x5a, 0xce, 0x43, 0x34, 0x7f, 0xce,
-	0x12, 0x4c, 0x76, 0xb3, 0x2f, 0x41, 0xe2, 0xdd, 0x38, 0xb8, 0x87, 0xbe, 0x03, 0x78, 0xf5, 0xa4,
-	0xb1, 0xd0, 0xfd, 0x41, 0x07, 0x9c, 0xb6, 0x7f, 0xf5, 0xc1, 0x85, 0xf3, 0x8c, 0x8e, 0x35, 0xad,
-	0xe3, 0x25, 0x5a, 0x19, 0x64, 0x2d, 0xc6, 0xa7, 0xc9, 0xa5, 0x24, 0x14, 0xe1, 0xdd, 0xd8, 0x88,
-	0x7b, 0x8b, 0xcf, 0x0f, 0x8e, 0x2c, 0x70, 0x78, 0x64, 0x81, 0x5f, 0x47, 0x16, 0xf8, 0x70, 0x6c,
-	0x15, 0x0e, 0x8f, 0xad, 0xc2, 0xb7, 0x63, 0xab, 0xf0, 0xfa, 0x76, 0xc2, 0x61, 0x29, 0xca, 0xb7,
-	0x29, 0x52, 0x6d, 0xb5, 0x56, 0x49, 0xff, 0x1f, 0xde, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0x87,
-	0xee, 0x91, 0xfe, 0x21, 0x08, 0x00, 0x00,
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0xcb, 0xef, 0xb7, 0xc0, 0xac, 0x1a, 0x33, 0x60, 0xb2, 0x34, 0x58, 0x96, 0x06,
+	0x75, 0xa3, 0xd0, 0x11, 0x4c, 0x14, 0xc4, 0x18, 0xf9, 0x63, 0x22, 0x46, 0xe2, 0xba, 0x86, 0x8b,
+	0x89, 0xd9, 0xcc, 0x6e, 0xc7, 0xd2, 0xb0, 0x3b, 0x53, 0x3a, 0x2d, 0x48, 0x08, 0x17, 0xdf, 0x80,
+	0x26, 0xbe, 0x0b, 0x6f, 0xbe, 0x0b, 0x12, 0x3d, 0x90, 0x78, 0x31, 0x1e, 0xd4, 0x00, 0xaf, 0xc2,
+	0x93, 0xe9, 0x74, 0xba, 0xb6, 0xb0, 0x65, 0x17, 0x8c, 0xa7, 0xd2, 0xa7, 0xcf, 0x9f, 0xef, 0x67,
+	0x9e, 0xcc, 0x97, 0x05, 0x63, 0x0d, 0xbc, 0x81, 0x29, 0xf1, 0x50, 0xf0, 0x44, 0xa6, 0x8f, 0x1b,
+	0xdc, 0xc3, 0x6b, 0x36, 0xb5, 0xd0, 0xba, 0x4f, 0xdc, 0x2d, 0xc3, 0x71, 0x99, 0xc7, 0x60, 0x41,
+	0x66, 0x19, 0xc1, 0xd3, 0x88, 0x65, 0xa9, 0x83, 0x16, 0xb3, 0x98, 0x48, 0x42, 0xc1, 0x5f, 0x61,
+	0xbe, 0x3a, 0x6c, 0x31, 0x66, 0x35, 0x08, 0xc2, 0x8e, 0x8d, 0x30, 0xa5, 0xcc, 0xc3, 0x9e, 0xcd,
+	0x28, 0x97, 0x5f, 0xaf, 0xd7, 0x19, 0x6f, 0x32, 0x8e, 0x6a, 0x98, 0x93, 0x70, 0x0c, 0xda, 0x98,
+	0xac, 0x11, 0x0f, 0x4f, 0x22, 0x07, 0x5b, 0x36, 0x15, 0xc9, 0x32, 0xf7, 0x4a, 0xaa, 0x3e, 0x07,
+	0xbb, 0xb8, 0x19, 0xb5, 0xbc, 0x96, 0x9a, 0x66, 0x92, 0x06, 0xb1, 0xb0, 0x47, 0x64, 0xe2, 0x78,
+	0x6a, 0x22, 0xf6, 0x3d, 0x56, 0xad, 0xb3, 0xa6, 0xc3, 0x7c, 0x6a, 0xca, 0x6c, 0x2d, 0xae, 0x34,
+	0xd2, 0x58, 0x67, 0xb6, 0x54, 0xa7, 0x0f, 0x02, 0xf8, 0x2c, 0xd0, 0x5f, 0x16, 0x5a, 0x2a, 0x64,
+	0xdd, 0x27, 0xdc, 0xd3, 0x57, 0xc0, 0x40, 0x22, 0xca, 0x1d, 0x46, 0x39, 0x81, 0xf7, 0x41, 0x2e,
+	0xd4, 0x5c, 0x50, 0x8a, 0x4a, 0x29, 0x3f, 0x55, 0x34, 0xd2, 0x4e, 0xd5, 0x08, 0x2b, 0xe7, 0xff,
+	0xdb, 0xfd, 0x3e, 0x92, 0xa9, 0xc8, 0x2a, 0x1d, 0x03, 0x4d, 0xb4, 0x5d, 0x0c, 0x89, 0x98, 0x5b,
+	0x76, 0xd9, 0x86, 0x6d, 0x12, 0x37, 0x1a, 0x0c, 0x87, 0x41, 0xbf, 0x19, 0x7d, 0x14, 0x43, 0xfa,
+	0x2b, 0x7f, 0x02, 0x70, 0x14, 0x9c, 0xdb, 0xb4, 0xbd, 0xd5, 0xaa, 0x43, 0xa8, 0x69, 0x53, 0xab,
+	0x90, 0x2d, 0x2a, 0xa5, 0xbe, 0x4a, 0x3e, 0x88, 0x95, 0xc3, 0x90, 0xce, 0xc0, 0x48, 0xea, 0x08,
+	0x49, 0xf1, 0x04, 0xe4, 0x65, 0xcb, 0x60, 0xa3, 0x05, 0xa5, 0xd8, 0x53, 0xca, 0x4f, 0x8d, 0xa5,
+	0xa3, 0x2c, 0xb6, 0x92, 0x25, 0x4e, 0xbc, 0x5c, 0xaf, 0x4a, 0xa6, 0x68, 0x4e, 0x6b, 0x70, 0x8b,
+	0x49, 0x05, 0x7d, 0x8e, 0xfc, 0x28, 0x91, 0x5a, 0xef, 0xa7, 0x21, 0x6a, 0x37, 0xe0, 0x9f, 0x10,
+	0x71, 0x30, 0x9c, 0x3c, 0xc2, 0x0a, 0xd9, 0xc4, 0xae, 0xd9, 0xe5, 0x8e, 0xe2, 0xb4, 0xd9, 0x23,
+	0xb4, 0x43, 0xa0, 0xaf, 0xbe, 0x8a, 0x6d, 0x5a, 0xb5, 0xcd, 0x42, 0x8f, 0xf8, 0xd6, 0x2b, 0xde,
+	0x97, 0x4c, 0x9d, 0x82, 0xcb, 0x29, 0x43, 0x25, 0xe3, 0x32, 0xe8, 0x75, 0xc3, 0x90, 0xe4, 0x9b,
+	0xe8, 0xc8, 0x17, 0x35, 0x59, 0xa2, 0xaf, 0x98, 0x04, 0x8d, 0x7a, 0xe8, 0x1f, 0x15, 0x30, 0xd0,
+	0x26, 0xed, 0xc4, 0x65, 0xc5, 0xe5, 0x67, 0x13, 0xf2, 0x61, 0x1d, 0xe4, 0x70, 0x93, 0xf9, 0xd4,
+	0x2b, 0xf4, 0x08, 0x71, 0x43, 0x46, 0x78, 0xef, 0x8c, 0xe0, 0xde, 0x19, 0xf2, 0xde, 0x19, 0x0b,
+	0xcc, 0xa6, 0xf3, 0x37, 0x03, 0x21, 0x1f, 0x7e, 0x8c, 0x94, 0x2c, 0xdb, 0x5b, 0xf5, 0x6b, 0x46,
+	0x9d, 0x35, 0x91, 0xbc, 0xa4, 0xe1, 0x63, 0x82, 0x9b, 0x6b, 0xc8, 0xdb, 0x72, 0x08, 0x17, 0x05,
+	0xbc, 0x22, 0x5b, 0xeb, 0x2f, 0xc1, 0x68, 0xf2, 0x8c, 0xe6, 0x7c, 0x8f, 0x2d, 0xc8, 0xfb, 0xfe,
+	0xd7, 0xdb, 0xd1, 0xb7, 0x80, 0x7e, 0x52, 0x7b, 0xb9, 0x87, 0xe7, 0xe0, 0x42, 0xc2, 0x67, 0xa2,
+	0x75, 0x5c, 0x4d, 0x5f, 0x47, 0xbc, 0x8f, 0xdc, 0xc3, 0x79, 0x1c, 0x8b, 0xf1, 0xa9, 0x5f, 0xbd,
+	0xe0, 0x7f, 0x31, 0x1b, 0xbe, 0x55, 0x40, 0x2e, 0xf4, 0x0e, 0x38, 0x9e, 0xde, 0xf1, 0xb8, 0x65,
+	0xa9, 0x13, 0x5d, 0x66, 0x87, 0x18, 0x7a, 0xe9, 0xcd, 0x97, 0xc3, 0xf7, 0x59, 0x1d, 0x16, 0x51,
+	0x07, 0x7b, 0x86, 0x9f, 0x15, 0x00, 0x8f, 0xbb, 0x09, 0x9c, 0xee, 0x30, 0x2f, 0xd5, 0xe3, 0xd4,
+	0x99, 0x33, 0x54, 0x4a, 0xd5, 0x73, 0x42, 0xf5, 0x2c, 0x9c, 0x41, 0x9d, 0xfe, 0x5b, 0x30, 0xb7,
+	0x1a, 0x2d, 0x96, 0xa3, 0xed, 0x56, 0x70, 0x07, 0x7e, 0x52, 0x00, 0x3c, 0x6e, 0x25, 0x1d, 0x71,
+	0x52, 0xed, 0x4d, 0x9d, 0x39, 0x43, 0xa5, 0xc4, 0x79, 0x20, 0x70, 0xee, 0xc2, 0xe9, 0x13, 0x96,
+	0x20, 0xab, 0xab, 0x2d, 0x04, 0x8e, 0xb6, 0xa3, 0xe0, 0x0e, 0xfc, 0xa6, 0x80, 0x8b, 0x47, 0x2d,
+	0x03, 0xde, 0xee, 0xf6, 0x80, 0x93, 0xc6, 0xa6, 0xde, 0x39, 0x75, 0x9d, 0xe4, 0x58, 0x11, 0x1c,
+	0x4f, 0xe1, 0x72, 0x37, 0x6b, 0x91, 0x0e, 0x14, 0x5f, 0x4a, 0x8c, 0x08, 0x6d, 0x47, 0x16, 0xb3,
+	0x03, 0x0f, 0x15, 0x70, 0xa9, 0xed, 0x65, 0x84, 0xb3, 0xdd, 0x2a, 0x6d, 0xe3, 0x10, 0xea, 0xbd,
+	0xb3, 0x15, 0x4b, 0xd6, 0xb2, 0x60, 0x7d, 0x0c, 0x1f, 0x75, 0xc3, 0x9a, 0x70, 0x8a, 0x14, 0xe2,
+	0xf9, 0x87, 0xbb, 0xfb, 0x9a, 0xb2, 0xb7, 0xaf, 0x29, 0x3f, 0xf7, 0x35, 0xe5, 0xdd, 0x81, 0x96,
+	0xd9, 0x3b, 0xd0, 0x32, 0x5f, 0x0f, 0xb4, 0xcc, 0x8b, 0x1b, 0x31, 0x8b, 0x4c, 0x4c, 0x7b, 0x9d,
+	0x98, 0x27, 0xbc, 0xb2, 0x96, 0x13, 0x3f, 0x68, 0x6e, 0xfd, 0x1e, 0x00, 0x83, 0xb7, 0xe5, 0xc2,
+	0x10, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -555,6 +659,8 @@ type QueryClient interface {
 	ProviderDelegators(ctx context.Context, in *QueryProviderDelegatorsRequest, opts ...grpc.CallOption) (*QueryProviderDelegatorsResponse, error)
 	// Queries a the unclaimed rewards of a delegator.
 	DelegatorRewards(ctx context.Context, in *QueryDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsResponse, error)
+	// Queries the auto-compound settings and compounded totals of a delegator.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 }
 
 type queryClient struct {
@@ -601,6 +707,15 @@ func (c *queryClient) DelegatorRewards(ctx context.Context, in *QueryDelegatorRe
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -611,6 +726,8 @@ type QueryServer interface {
 	ProviderDelegators(context.Context, *QueryProviderDelegatorsRequest) (*QueryProviderDelegatorsResponse, error)
 	// Queries a the unclaimed rewards of a delegator.
 	DelegatorRewards(context.Context, *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error)
+	// Queries the auto-compound settings and compounded totals of a delegator.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -629,6 +746,9 @@ func (*UnimplementedQueryServer) ProviderDelegators(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) DelegatorRewards(ctx context.Context, req *QueryDelegatorRewardsRequest) (*QueryDelegatorRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewards not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -706,6 +826,24 @@ func _Query_DelegatorRewards_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.dualstaking.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -726,6 +864,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorRewards",
 			Handler:    _Query_DelegatorRewards_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/dualstaking/query.proto",
@@ -1073,6 +1215,80 @@ func (m *DelegatorRewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1225,6 +1441,38 @@ func (m *DelegatorRewardInfo) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2114,6 +2362,204 @@ func (m *DelegatorRewardInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompound{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,8 @@ const (
 	UnbondingEventName         = "unbond_from_provider"
 	RedelegateEventName        = "redelegate_between_providers"
 	ClaimRewardsEventName      = "delegator_claim_rewards"
+	SetAutoCompoundEventName   = "delegator_set_auto_compound"
+	AutoCompoundEventName      = "delegator_auto_compound"
 	ContributorRewardEventName = "contributor_rewards"
 	ValidatorSlashEventName    = "validator_slash"
 	FreezeFromUnbond           = "freeze_from_unbond"
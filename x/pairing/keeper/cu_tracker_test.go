@@ -712,3 +712,227 @@ func TestTrackedCuDeletion(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, relayCuSum, cu)
 }
+
+// TestTrackedCuOveruse checks that once the subscription's monthly CU is used up, relays
+// are still accepted (up to the plan's overuse CU limit) as long as the creator's overuse
+// deposit pays OveruseRate per CU. Overuse the deposit can't pay for is rejected and never
+// charged to the creator's balance. The overuse charges are added to the providers' monthly
+// reward and the unused deposit is refunded when the subscription expires
+func TestTrackedCuOveruse(t *testing.T) {
+	ts := newTester(t)
+	ts.plan.OveruseCuLimit = 2 * ts.plan.PlanPolicy.EpochCuLimit
+	ts.AddPlan(ts.plan.Index, ts.plan)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	totalCuLimit := ts.plan.PlanPolicy.TotalCuLimit
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+	overuseRate := int64(ts.plan.OveruseRate)
+
+	payRelay := func(session uint64) error {
+		relaySession := ts.newRelaySession(provider, session, epochCuLimit, ts.BlockHeight(), 0)
+		sig, err := sigs.Sign(clientAcc.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		_, err = ts.TxPairingRelayPayment(provider, relaySession)
+		ts.AdvanceEpoch()
+		return err
+	}
+
+	// use up all the subscription's CU
+	session := uint64(0)
+	for ; session < totalCuLimit/epochCuLimit; session++ {
+		require.NoError(t, payRelay(session))
+	}
+
+	// without a deposit there is nothing to pay for overuse: relays are rejected and the balance is untouched
+	res, err := ts.QuerySubscriptionOveruse(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.MonthOveruseCu)
+	require.Equal(t, uint64(0), res.OveruseCuLeft)
+	balance := ts.GetBalance(clientAcc.Addr)
+	require.Error(t, payRelay(session))
+	session++
+	require.Equal(t, balance, ts.GetBalance(clientAcc.Addr))
+
+	// deposit the cost of one and a half relays: the overuse relay is paid from the deposit
+	overuseCost := int64(epochCuLimit) * overuseRate
+	deposit := sdk.NewInt64Coin(ts.BondDenom(), overuseCost+overuseCost/2)
+	require.NoError(t, ts.TxSubscriptionOveruseDeposit(client, client, deposit))
+	res, err = ts.QuerySubscriptionOveruse(client)
+	require.NoError(t, err)
+	require.Equal(t, epochCuLimit+epochCuLimit/2, res.OveruseCuLeft)
+	balance = ts.GetBalance(clientAcc.Addr)
+	require.NoError(t, payRelay(session))
+	session++
+	require.Equal(t, balance, ts.GetBalance(clientAcc.Addr))
+
+	// the deposit left pays for half a relay: only that half is charged and paid for, the rest isn't served for free
+	res, err = ts.QuerySubscriptionOveruse(client)
+	require.NoError(t, err)
+	require.Equal(t, overuseCost/2, res.Deposit.Amount.Int64())
+	require.Equal(t, epochCuLimit/2, res.OveruseCuLeft)
+	_, paidCu, err := ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, client, ts.BlockHeight(), epochCuLimit)
+	require.NoError(t, err)
+	require.Equal(t, epochCuLimit/2, paidCu)
+	res, err = ts.QuerySubscriptionOveruse(client)
+	require.NoError(t, err)
+	require.Equal(t, epochCuLimit+epochCuLimit/2, res.MonthOveruseCu)
+	require.True(t, res.Deposit.IsZero())
+	require.Equal(t, uint64(0), res.OveruseCuLeft)
+
+	// topping the deposit up pays for half of the next relay, which reaches the overuse CU limit
+	require.NoError(t, ts.TxSubscriptionOveruseDeposit(client, client, sdk.NewInt64Coin(ts.BondDenom(), overuseCost/2)))
+	require.NoError(t, payRelay(session))
+	session++
+
+	res, err = ts.QuerySubscriptionOveruse(client)
+	require.NoError(t, err)
+	require.Equal(t, 2*epochCuLimit, res.MonthOveruseCu)
+	require.Equal(t, 2*overuseCost, res.MonthOveruseCharged.Amount.Int64())
+	require.True(t, res.Deposit.IsZero())
+	require.Equal(t, uint64(0), res.OveruseCuLeft)
+
+	// overuse CU limit reached: relays are rejected
+	require.Error(t, payRelay(session))
+	session++
+
+	// a fresh deposit is refunded to the creator when the subscription expires
+	deposit = sdk.NewInt64Coin(ts.BondDenom(), overuseCost)
+	require.NoError(t, ts.TxSubscriptionOveruseDeposit(client, client, deposit))
+	balance = ts.GetBalance(clientAcc.Addr)
+
+	// advance month + blocksToSave + 1 to trigger the provider monthly payment
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+
+	require.Equal(t, balance+deposit.Amount.Int64(), ts.GetBalance(clientAcc.Addr))
+
+	reward, err := ts.QueryDualstakingDelegatorRewards(providerAcc.Addr.String(), providerAcc.Addr.String(), ts.spec.Index)
+	require.NoError(t, err)
+	expectedReward := ts.plan.Price.Amount.Int64() + 2*overuseCost
+	require.Equal(t, expectedReward, reward.Rewards[0].Amount.AmountOf(ts.BondDenom()).Int64())
+}
+
+// TestTrackedCuCrossingMonthCuLeft checks that on a plan without overuse, a relay that crosses the
+// subscription's CU left is paid in full and leaves no CU left, without failing the TX and the other
+// relays in it (the CU limits are enforced per provider, so valid relays can cross the CU left)
+func TestTrackedCuCrossingMonthCuLeft(t *testing.T) {
+	ts := newTester(t)
+	ts.plan.AllowOveruse = false
+	ts.plan.OveruseRate = 0
+	ts.AddPlan(ts.plan.Index, ts.plan)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	totalCuLimit := ts.plan.PlanPolicy.TotalCuLimit
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+
+	newRelay := func(session uint64, cu uint64) *types.RelaySession {
+		relaySession := ts.newRelaySession(provider, session, cu, ts.BlockHeight(), 0)
+		sig, err := sigs.Sign(clientAcc.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		return relaySession
+	}
+
+	// use up all the subscription's CU but half an epoch's
+	session := uint64(0)
+	for ; session < totalCuLimit/epochCuLimit-1; session++ {
+		_, err := ts.TxPairingRelayPayment(provider, newRelay(session, epochCuLimit))
+		require.NoError(t, err)
+		ts.AdvanceEpoch()
+	}
+	_, err := ts.TxPairingRelayPayment(provider, newRelay(session, epochCuLimit/2))
+	require.NoError(t, err)
+	session++
+	ts.AdvanceEpoch()
+
+	sub, found := ts.Keepers.Subscription.GetSubscription(ts.Ctx, client)
+	require.True(t, found)
+	require.Equal(t, epochCuLimit/2, sub.MonthCuLeft)
+	trackedCu, _, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, client, provider, ts.spec.Index, sub.Block)
+
+	// the third relay crosses the CU left. the provider's allowance in the epoch follows the CU left, so
+	// only the first relay is rewarded
+	relays := []*types.RelaySession{
+		newRelay(session, epochCuLimit/4),
+		newRelay(session+1, epochCuLimit/8),
+		newRelay(session+2, epochCuLimit/4),
+	}
+	res, err := ts.TxPairingRelayPayment(provider, relays...)
+	require.NoError(t, err)
+	require.False(t, res.RejectedRelays)
+
+	sub, found = ts.Keepers.Subscription.GetSubscription(ts.Ctx, client)
+	require.True(t, found)
+	require.Equal(t, uint64(0), sub.MonthCuLeft)
+	cu, found, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, client, provider, ts.spec.Index, sub.Block)
+	require.True(t, found)
+	require.Equal(t, trackedCu+epochCuLimit/4, cu)
+}
+
+// TestTrackedCuOveruseDepositRunsOut checks that when the overuse deposit runs out in the middle of a TX,
+// the relay it runs out on is paid (and its provider rewarded) only for the CU the deposit covers,
+// without failing the TX and the other relays in it
+func TestTrackedCuOveruseDepositRunsOut(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	totalCuLimit := ts.plan.PlanPolicy.TotalCuLimit
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+	overuseRate := int64(ts.plan.OveruseRate)
+
+	newRelay := func(session uint64, cu uint64) *types.RelaySession {
+		relaySession := ts.newRelaySession(provider, session, cu, ts.BlockHeight(), 0)
+		sig, err := sigs.Sign(clientAcc.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		return relaySession
+	}
+
+	// use up all the subscription's CU
+	session := uint64(0)
+	for ; session < totalCuLimit/epochCuLimit; session++ {
+		_, err := ts.TxPairingRelayPayment(provider, newRelay(session, epochCuLimit))
+		require.NoError(t, err)
+		ts.AdvanceEpoch()
+	}
+
+	// deposit the overuse cost of half an epoch's CU
+	deposit := sdk.NewInt64Coin(ts.BondDenom(), int64(epochCuLimit/2)*overuseRate)
+	require.NoError(t, ts.TxSubscriptionOveruseDeposit(client, client, deposit))
+
+	sub, found := ts.Keepers.Subscription.GetSubscription(ts.Ctx, client)
+	require.True(t, found)
+	trackedCu, _, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, client, provider, ts.spec.Index, sub.Block)
+
+	// the deposit pays for the first relay and half of the second. the provider's allowance in the epoch
+	// follows the CU the deposit can still pay for, so only the first relay is rewarded
+	relays := []*types.RelaySession{
+		newRelay(session, epochCuLimit/4),
+		newRelay(session+1, epochCuLimit/2),
+	}
+	res, err := ts.TxPairingRelayPayment(provider, relays...)
+	require.NoError(t, err)
+	require.False(t, res.RejectedRelays)
+
+	overuse, err := ts.QuerySubscriptionOveruse(client)
+	require.NoError(t, err)
+	require.Equal(t, epochCuLimit/2, overuse.MonthOveruseCu)
+	require.Equal(t, deposit.Amount, overuse.MonthOveruseCharged.Amount)
+	require.True(t, overuse.Deposit.IsZero())
+	require.Equal(t, uint64(0), overuse.OveruseCuLeft)
+
+	cu, found, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, client, provider, ts.spec.Index, sub.Block)
+	require.True(t, found)
+	require.Equal(t, trackedCu+epochCuLimit/4, cu)
+}
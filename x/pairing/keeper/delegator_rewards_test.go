@@ -646,3 +646,181 @@ func TestRedelegationFirstMonthReward(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(resRewards.Rewards))
 }
+
+// TestDelegatorRewardsAutoCompound checks that the rewards of a delegator that enabled
+// auto-compounding are delegated back to the provider, up to the provider's delegate limit
+func TestDelegatorRewardsAutoCompound(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)                   // 1 provider, 1 client, 1 providersToPair
+	ts.AddAccount(common.CONSUMER, 1, testBalance) // add delegator1
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	delegatorAcc, delegator := ts.GetAccount(common.CONSUMER, 1)
+
+	_, err := ts.TxSubscriptionBuy(client, client, "free", 3, false, false) // extend by a few months so the sub won't expire
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch() // to apply pairing
+
+	// cannot enable auto-compound without a delegation to the provider
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, true)
+	require.Error(t, err)
+
+	// cannot disable auto-compound that was never enabled
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, false)
+	require.Error(t, err)
+
+	delegationAmount := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	_, err = ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, delegationAmount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch() // apply delegations
+
+	// zero commission and a delegation limit that leaves room for more delegations
+	stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateCommission = 0
+	stakeEntry.DelegateLimit = sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(2*testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.AdvanceEpoch()
+
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, true)
+	require.NoError(t, err)
+
+	// the delegator's reward (half of the total reward) should be delegated back to the provider
+	balance := ts.GetBalance(delegatorAcc.Addr)
+	relayPaymentMessage := sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+	ts.payAndVerifyBalance(relayPaymentMessage, clientAcc.Addr, providerAcc.Addr, true, true, 50)
+
+	compounded := int64(relayCuSum) / 2
+	require.Equal(t, balance, ts.GetBalance(delegatorAcc.Addr))
+
+	resRewards, err := ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resRewards.Rewards))
+
+	delegation, found := ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, delegator, provider, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	require.Equal(t, testStake+compounded, delegation.Amount.Amount.Int64())
+
+	resAutoCompound, err := ts.QueryDualstakingDelegatorAutoCompound(delegator, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resAutoCompound.AutoCompounds))
+	require.True(t, resAutoCompound.AutoCompounds[0].Enabled)
+	require.Equal(t, compounded, resAutoCompound.AutoCompounds[0].Compounded.Amount.Int64())
+
+	// lower the delegation limit so only part of the next reward can be compounded
+	room := int64(10)
+	stakeEntry, found, stakeEntryIndex = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = stakeEntry.DelegateTotal.AddAmount(sdk.NewInt(room))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.AdvanceEpoch()
+
+	relayPaymentMessage = sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+	ts.payAndVerifyBalance(relayPaymentMessage, clientAcc.Addr, providerAcc.Addr, true, true, 49)
+
+	resRewards, err = ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resRewards.Rewards))
+	require.Equal(t, int64(relayCuSum)-49-room, resRewards.Rewards[0].Amount.AmountOf(ts.BondDenom()).Int64())
+
+	compounded += room
+	resAutoCompound, err = ts.QueryDualstakingDelegatorAutoCompound(delegator, provider)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resAutoCompound.AutoCompounds))
+	require.Equal(t, compounded, resAutoCompound.AutoCompounds[0].Compounded.Amount.Int64())
+
+	// disable auto-compound: the compounded total is kept and rewards remain claimable
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, false)
+	require.NoError(t, err)
+
+	stakeEntry, found, stakeEntryIndex = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(10*testStake))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.AdvanceEpoch()
+
+	relayPaymentMessage = sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+	ts.payAndVerifyBalance(relayPaymentMessage, clientAcc.Addr, providerAcc.Addr, true, true, 49)
+
+	resAutoCompound, err = ts.QueryDualstakingDelegatorAutoCompound(delegator, provider)
+	require.NoError(t, err)
+	require.False(t, resAutoCompound.AutoCompounds[0].Enabled)
+	require.Equal(t, compounded, resAutoCompound.AutoCompounds[0].Compounded.Amount.Int64())
+
+	claimRewardsAndVerifyBalance(ts, delegatorAcc.Addr, provider, ts.spec.Index)
+
+	// the auto-compound setting is removed with the delegator's last delegation to the provider
+	delegation, found = ts.Keepers.Dualstaking.GetDelegation(ts.Ctx, delegator, provider, ts.spec.Index, ts.GetNextEpoch())
+	require.True(t, found)
+	_, err = ts.TxDualstakingUnbond(delegator, provider, ts.spec.Index, delegation.Amount)
+	require.NoError(t, err)
+
+	resAutoCompound, err = ts.QueryDualstakingDelegatorAutoCompound(delegator, provider)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resAutoCompound.AutoCompounds))
+}
+
+// TestDelegatorRewardsAutoCompoundSharedLimit checks that delegators that auto-compound in the
+// same reward distribution share the room below the provider's delegate limit
+func TestDelegatorRewardsAutoCompoundSharedLimit(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)                   // 1 provider, 1 client, 1 providersToPair
+	ts.AddAccount(common.CONSUMER, 1, testBalance) // add delegator1
+	ts.AddAccount(common.CONSUMER, 2, testBalance) // add delegator2
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	_, delegator1 := ts.GetAccount(common.CONSUMER, 1)
+	_, delegator2 := ts.GetAccount(common.CONSUMER, 2)
+
+	_, err := ts.TxSubscriptionBuy(client, client, "free", 3, false, false) // extend by a few months so the sub won't expire
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch() // to apply pairing
+
+	delegationAmount := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	for _, delegator := range []string{delegator1, delegator2} {
+		_, err = ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, delegationAmount)
+		require.NoError(t, err)
+	}
+	ts.AdvanceEpoch() // apply delegations
+
+	for _, delegator := range []string{delegator1, delegator2} {
+		_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, true)
+		require.NoError(t, err)
+	}
+
+	// zero commission and room for less than each delegator's reward
+	room := int64(10)
+	stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateCommission = 0
+	stakeEntry.DelegateLimit = stakeEntry.DelegateTotal.AddAmount(sdk.NewInt(room))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.AdvanceEpoch()
+
+	relayPaymentMessage := sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+	_, err = ts.TxPairingRelayPayment(relayPaymentMessage.Creator, relayPaymentMessage.Relays...)
+	require.NoError(t, err)
+
+	// advance month + blocksToSave + 1 to trigger the provider monthly payment
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+
+	// together, the delegators compounded only the room below the limit
+	compounded := int64(0)
+	for _, delegator := range []string{delegator1, delegator2} {
+		resAutoCompound, err := ts.QueryDualstakingDelegatorAutoCompound(delegator, provider)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resAutoCompound.AutoCompounds))
+		compounded += resAutoCompound.AutoCompounds[0].Compounded.Amount.Int64()
+	}
+	require.Equal(t, room, compounded)
+
+	stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.True(t, stakeEntry.DelegateTotal.IsLTE(stakeEntry.DelegateLimit))
+}
@@ -45,14 +45,15 @@ func (k Keeper) UserEntry(goCtx context.Context, req *types.QueryUserEntryReques
 		return nil, err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
+	subCuLeft, planPolicy := k.SubscriptionCuLeft(ctx, sub, plan)
 	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
 	// geolocation is a bitmap. common denominator can be calculated with logical AND
 	geolocation, err := k.CalculateEffectiveGeolocationFromPolicies(policies)
 	if err != nil {
 		return nil, err
 	}
-	allowedCU, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCU, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), subCuLeft)
+	allowedCU, allowedCUTotal = project.ApplyCuBudget(allowedCU, allowedCUTotal)
 	if !planstypes.VerifyTotalCuUsage(allowedCUTotal, project.GetUsedCu()) {
 		allowedCU = 0
 	}
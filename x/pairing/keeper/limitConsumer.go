@@ -20,19 +20,28 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, epochAllo
 		return 0, err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
-	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
-
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	sub, _, found := k.subscriptionKeeper.GetSubscriptionForBlock(ctx, project.GetSubscription(), epoch)
 	if !found {
 		return 0, utils.LavaFormatError("can't find subscription", fmt.Errorf("EnforceClientCUsUsageInEpoch_cant_find_subscription"), utils.Attribute{Key: "subscriptionKey", Value: project.GetSubscription()})
 	}
 
-	if sub.GetMonthCuLeft() == 0 {
-		return 0, utils.LavaFormatError("total cu in epoch for consumer exceeded the amount of CU left in the subscription", fmt.Errorf("consumer CU limit exceeded for subscription"), []utils.Attribute{{Key: "subscriptionCuLeft", Value: sub.GetMonthCuLeft()}}...)
+	subCuLeft, planPolicy := k.SubscriptionCuLeft(ctx, sub, plan)
+	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
+
+	if subCuLeft == 0 {
+		return 0, utils.LavaFormatError("total cu in epoch for consumer exceeded the amount of CU left in the subscription", fmt.Errorf("consumer CU limit exceeded for subscription"), []utils.Attribute{{Key: "subscriptionCuLeft", Value: subCuLeft}}...)
+	}
+
+	if project.CuBudget != 0 && project.UsedCu >= project.CuBudget {
+		return 0, utils.LavaFormatWarning("total cu of consumer exceeded the project's CU budget", fmt.Errorf("consumer CU limit exceeded for project"),
+			utils.LogAttr("project", project.Index),
+			utils.LogAttr("cuBudget", project.CuBudget),
+			utils.LogAttr("usedCu", project.UsedCu),
+		)
 	}
 
-	_, effectivePolicyTotalCu := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.UsedCu, sub.MonthCuLeft)
+	_, effectivePolicyTotalCu := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.UsedCu, subCuLeft)
+	effectivePolicyTotalCu = project.ApplyCuBudgetToTotal(effectivePolicyTotalCu)
 	if !planstypes.VerifyTotalCuUsage(effectivePolicyTotalCu, totalCUInEpochForUserProvider) {
 		return effectivePolicyTotalCu - project.UsedCu, nil
 	}
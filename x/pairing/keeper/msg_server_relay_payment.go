@@ -224,6 +224,16 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			)
 		}
 
+		// a subscription overusing beyond its plan's overuse cap or deposit doesn't pay for all of the relay's CU,
+		// the provider is only rewarded for the CU it paid for
+		sub, paidCU, err := k.chargeCuToProjectAndSubscription(ctx, clientAddr, relay)
+		if err != nil {
+			return nil, utils.LavaFormatError("Failed charging CU to project and subscription", err)
+		}
+		if paidCU < rewardedCU {
+			rewardedCU = paidCU
+		}
+
 		// pairing is valid, we can pay provider for work
 		rewardedCUDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(rewardedCU))
 
@@ -268,9 +278,9 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		utils.LogLavaEvent(ctx, logger, types.RelayPaymentEventName, successDetails, "New Proof Of Work Was Accepted")
 
 		cuAfterQos := rewardedCUDec.TruncateInt().Uint64()
-		err = k.chargeCuToSubscriptionAndCreditProvider(ctx, clientAddr, relay, cuAfterQos)
+		err = k.subscriptionKeeper.AddTrackedCu(ctx, sub.Consumer, relay.Provider, relay.SpecId, cuAfterQos, sub.Block)
 		if err != nil {
-			return nil, utils.LavaFormatError("Failed charging CU to project and subscription", err)
+			return nil, utils.LavaFormatError("Failed crediting CU to provider", err)
 		}
 
 		// update provider payment storage with complainer's CU
@@ -404,30 +414,27 @@ func (k EpochPaymentHandler) updateProviderPaymentStorageWithComplainerCU(ctx sd
 	return nil
 }
 
-func (k Keeper) chargeCuToSubscriptionAndCreditProvider(ctx sdk.Context, clientAddr sdk.AccAddress, relay *types.RelaySession, cuAfterQos uint64) error {
+// chargeCuToProjectAndSubscription charges the relay's CU to its project and subscription, and returns the
+// subscription and the CU it paid for
+func (k Keeper) chargeCuToProjectAndSubscription(ctx sdk.Context, clientAddr sdk.AccAddress, relay *types.RelaySession) (subscriptiontypes.Subscription, uint64, error) {
 	epoch := uint64(relay.Epoch)
 
 	project, err := k.projectsKeeper.GetProjectForDeveloper(ctx, clientAddr.String(), epoch)
 	if err != nil {
-		return fmt.Errorf("failed to get project for client")
+		return subscriptiontypes.Subscription{}, 0, fmt.Errorf("failed to get project for client")
 	}
 
 	err = k.projectsKeeper.ChargeComputeUnitsToProject(ctx, project, epoch, relay.CuSum)
 	if err != nil {
-		return fmt.Errorf("failed to add CU to the project")
+		return subscriptiontypes.Subscription{}, 0, fmt.Errorf("failed to add CU to the project")
 	}
 
-	sub, err := k.subscriptionKeeper.ChargeComputeUnitsToSubscription(ctx, project.GetSubscription(), epoch, relay.CuSum)
+	sub, paidCu, err := k.subscriptionKeeper.ChargeComputeUnitsToSubscription(ctx, project.GetSubscription(), epoch, relay.CuSum)
 	if err != nil {
-		return fmt.Errorf("failed to add CU to the subscription")
+		return subscriptiontypes.Subscription{}, 0, fmt.Errorf("failed to add CU to the subscription")
 	}
 
-	err = k.subscriptionKeeper.AddTrackedCu(ctx, sub.Consumer, relay.Provider, relay.SpecId, cuAfterQos, sub.Block)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return sub, paidCu, nil
 }
 
 func appendRelayPaymentDetailsToEvent(from map[string]string, uniqueIdentifier uint64) (to map[string]string) {
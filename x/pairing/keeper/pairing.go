@@ -14,6 +14,7 @@ import (
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	projectstypes "github.com/lavanet/lava/x/projects/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
 )
 
 func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, block uint64) (epoch uint64, providersType spectypes.Spec_ProvidersTypes, errorRet error) {
@@ -142,7 +143,14 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
+	// take the subscription at the block (like its plan), so providers can still be paid for relays of
+	// a subscription that was cancelled or expired since (until its payment window closes)
+	sub, _, found := k.subscriptionKeeper.GetSubscriptionForBlock(ctx, project.GetSubscription(), block)
+	if !found {
+		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+
+	subCuLeft, planPolicy := k.SubscriptionCuLeft(ctx, sub, plan)
 	policies := []*planstypes.Policy{&planPolicy}
 	if project.SubscriptionPolicy != nil {
 		policies = append(policies, project.SubscriptionPolicy)
@@ -164,11 +172,8 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
-	if !found {
-		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
-	}
-	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), subCuLeft)
+	allowedCUEpoch, allowedCUTotal = project.ApplyCuBudget(allowedCUEpoch, allowedCUTotal)
 
 	selectedProvidersMode, selectedProvidersList := k.CalculateEffectiveSelectedProviders(policies)
 
@@ -241,6 +246,29 @@ func (k Keeper) CalculateEffectiveProvidersToPairFromPolicies(policies []*planst
 	return providersToPair, nil
 }
 
+// SubscriptionCuLeft returns the CU the subscription can still use this month and the plan's policy. When
+// the plan allows overuse, the CU that can still be overused are added to it, and the returned policy's
+// total CU limit is extended by the month's overuse so the projects aren't capped by the monthly allowance.
+// The policy is a copy, the plan itself is left as it is
+func (k Keeper) SubscriptionCuLeft(ctx sdk.Context, sub subscriptiontypes.Subscription, plan planstypes.Plan) (uint64, planstypes.Policy) {
+	planPolicy := plan.GetPlanPolicy()
+	if !plan.AllowOveruse {
+		return sub.MonthCuLeft, planPolicy
+	}
+
+	overusedCu, overuseCuLeft := k.subscriptionKeeper.GetOveruseCu(ctx, sub)
+	if planPolicy.TotalCuLimit != 0 {
+		for _, cu := range []uint64{overusedCu, overuseCuLeft} {
+			if planPolicy.TotalCuLimit > math.MaxUint64-cu {
+				planPolicy.TotalCuLimit = math.MaxUint64
+				break
+			}
+			planPolicy.TotalCuLimit += cu
+		}
+	}
+	return sub.MonthCuLeft + overuseCuLeft, planPolicy
+}
+
 func (k Keeper) CalculateEffectiveAllowedCuPerEpochFromPolicies(policies []*planstypes.Policy, cuUsedInProject, cuLeftInSubscription uint64) (allowedCUThisEpoch, allowedCUTotal uint64) {
 	var policyEpochCuLimit []uint64
 	var policyTotalCuLimit []uint64
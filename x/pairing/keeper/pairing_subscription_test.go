@@ -542,3 +542,99 @@ func TestAddProjectAfterPlanUpdate(t *testing.T) {
 	// to the second project (since it's under a subscription that uses the old plan)
 	require.Equal(t, adminPolicy.EpochCuLimit, verify.CuPerEpoch)
 }
+
+// TestDisabledProjectPairing checks that a disabled project can't get pairing and
+// that its relays are not paid, effective immediately
+func TestDisabledProjectPairing(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	proj, err := ts.QueryProjectDeveloper(clientAddr)
+	require.NoError(t, err)
+
+	verify, err := ts.QueryPairingVerifyPairing(ts.spec.Index, clientAddr, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.True(t, verify.Valid)
+
+	err = ts.TxProjectSetEnabled(proj.Project.Index, clientAddr, false)
+	require.NoError(t, err)
+
+	_, err = ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.Error(t, err)
+	_, err = ts.QueryPairingVerifyPairing(ts.spec.Index, clientAddr, providerAddr, ts.BlockHeight())
+	require.Error(t, err)
+
+	relaySession := ts.newRelaySession(providerAddr, 0, 100, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+
+	// enable the project again
+	err = ts.TxProjectSetEnabled(proj.Project.Index, clientAddr, true)
+	require.NoError(t, err)
+
+	_, err = ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+}
+
+// TestProjectCuBudget checks that a project's CU budget limits the pairing's allowed
+// CU and that relays beyond the budget are rejected
+func TestProjectCuBudget(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+	cuBudget := epochCuLimit + epochCuLimit/2
+
+	proj, err := ts.QueryProjectDeveloper(clientAddr)
+	require.NoError(t, err)
+	err = ts.TxProjectSetCuBudget(proj.Project.Index, clientAddr, cuBudget)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	entry, err := ts.QueryPairingUserEntry(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, epochCuLimit, entry.MaxCU)
+
+	relaySession := ts.newRelaySession(providerAddr, 0, epochCuLimit, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// only half an epoch worth of CU is left in the budget
+	entry, err = ts.QueryPairingUserEntry(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, epochCuLimit/2, entry.MaxCU)
+
+	relaySession = ts.newRelaySession(providerAddr, 1, epochCuLimit/2, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// budget used up: relays are rejected
+	entry, err = ts.QueryPairingUserEntry(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), entry.MaxCU)
+
+	relaySession = ts.newRelaySession(providerAddr, 2, 10, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+}
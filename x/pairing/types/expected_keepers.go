@@ -86,8 +86,10 @@ type ProjectsKeeper interface {
 
 type SubscriptionKeeper interface {
 	GetPlanFromSubscription(ctx sdk.Context, consumer string, block uint64) (planstypes.Plan, error)
-	ChargeComputeUnitsToSubscription(ctx sdk.Context, subscriptionOwner string, block, cuAmount uint64) (subscriptiontypes.Subscription, error)
+	ChargeComputeUnitsToSubscription(ctx sdk.Context, subscriptionOwner string, block, cuAmount uint64) (subscriptiontypes.Subscription, uint64, error)
 	GetSubscription(ctx sdk.Context, consumer string) (val subscriptiontypes.Subscription, found bool)
+	GetSubscriptionForBlock(ctx sdk.Context, consumer string, block uint64) (val subscriptiontypes.Subscription, entryBlock uint64, found bool)
+	GetOveruseCu(ctx sdk.Context, sub subscriptiontypes.Subscription) (overusedCu uint64, overuseCuLeft uint64)
 	GetAllSubTrackedCuIndices(ctx sdk.Context, sub string) []string
 	GetTrackedCu(ctx sdk.Context, sub string, provider string, chainID string, block uint64) (cu uint64, found bool, key string)
 	CalcTotalMonthlyReward(ctx sdk.Context, totalAmount math.Int, trackedCu uint64, totalCuUsedBySub uint64) math.Int
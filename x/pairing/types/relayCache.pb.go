@@ -402,669 +402,3271 @@ func (m *RelayCacheSet) GetAverageBlockTime() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*CacheRelayReply)(nil), "lavanet.lava.pairing.CacheRelayReply")
-	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
-	proto.RegisterType((*CacheHash)(nil), "lavanet.lava.pairing.CacheHash")
-	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
-	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+type BlockHashEntry struct {
+	Block int64  `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("lavanet/lava/pairing/relayCache.proto", fileDescriptor_36fbab536e2bbad1)
+func (m *BlockHashEntry) Reset()         { *m = BlockHashEntry{} }
+func (m *BlockHashEntry) String() string { return proto.CompactTextString(m) }
+func (*BlockHashEntry) ProtoMessage()    {}
+func (*BlockHashEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{5}
 }
-
-var fileDescriptor_36fbab536e2bbad1 = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x9b, 0x34, 0x3f, 0x93, 0x96, 0x96, 0x55, 0x85, 0x42, 0x68, 0x8d, 0x31, 0xea, 0xcf,
-	0x01, 0xd9, 0x52, 0x91, 0x38, 0x71, 0x80, 0x52, 0xd4, 0x56, 0xa2, 0x12, 0x38, 0x20, 0x21, 0x2e,
-	0xd1, 0x26, 0x9e, 0xda, 0x2b, 0x1c, 0xdb, 0x78, 0xb7, 0x15, 0xe5, 0x29, 0x78, 0x1d, 0xc4, 0x0b,
-	0xf4, 0xd8, 0x23, 0x27, 0x84, 0xda, 0xa7, 0x80, 0x13, 0xf2, 0xc4, 0x49, 0x93, 0xc8, 0x8d, 0x2a,
-	0xc1, 0xc9, 0xde, 0x6f, 0xbf, 0xd9, 0x99, 0xf9, 0xbe, 0xd1, 0xc0, 0x7a, 0xc8, 0x4f, 0x78, 0x84,
-	0xca, 0xc9, 0xbe, 0x4e, 0xc2, 0x45, 0x2a, 0x22, 0xdf, 0x49, 0x31, 0xe4, 0xa7, 0x2f, 0x78, 0x2f,
-	0x40, 0x3b, 0x49, 0x63, 0x15, 0xb3, 0x95, 0x9c, 0x66, 0x67, 0x5f, 0x3b, 0xa7, 0xb5, 0x56, 0xfc,
-	0xd8, 0x8f, 0x89, 0xe0, 0x64, 0x7f, 0x03, 0x6e, 0xcb, 0xbc, 0xfe, 0xc9, 0x9c, 0x71, 0xcf, 0x8f,
-	0x63, 0x3f, 0x44, 0x87, 0x4e, 0xdd, 0xe3, 0x23, 0x07, 0xfb, 0x89, 0xca, 0x2f, 0xad, 0xef, 0x3a,
-	0x2c, 0x51, 0x6a, 0x37, 0x8b, 0x70, 0x31, 0x09, 0x4f, 0xd9, 0x13, 0x98, 0x4f, 0xb3, 0x9f, 0xa6,
-	0x6e, 0xea, 0x5b, 0x8d, 0x6d, 0xd3, 0x2e, 0x2a, 0xc7, 0xbe, 0x0a, 0x70, 0x07, 0x74, 0xf6, 0x06,
-	0x6e, 0xc7, 0x89, 0x12, 0x71, 0xc4, 0xc3, 0x4e, 0x1f, 0x15, 0xf7, 0xb8, 0xe2, 0xcd, 0x39, 0xb3,
-	0xb4, 0xd5, 0xd8, 0x36, 0x8a, 0xdf, 0x38, 0xcc, 0x59, 0x3b, 0xe5, 0xb3, 0x9f, 0xf7, 0x35, 0x77,
-	0x79, 0x18, 0x3e, 0xc4, 0xd9, 0x1a, 0x80, 0x44, 0x8c, 0x3a, 0xdd, 0x30, 0xee, 0x7d, 0x6c, 0x96,
-	0x4c, 0x7d, 0xab, 0xe4, 0xd6, 0x33, 0x64, 0x27, 0x03, 0xac, 0x57, 0x00, 0x54, 0xfc, 0x3b, 0xc9,
-	0x7d, 0x64, 0xab, 0x50, 0xa7, 0xd3, 0xbe, 0x50, 0x92, 0x6a, 0x2f, 0xbb, 0x57, 0x00, 0x33, 0xa1,
-	0x41, 0x87, 0x43, 0x21, 0x25, 0xca, 0xe6, 0x1c, 0xdd, 0x8f, 0x43, 0x56, 0x30, 0x8c, 0xe7, 0x32,
-	0x60, 0xcf, 0xa0, 0x9a, 0xe2, 0xa7, 0x63, 0x94, 0x2a, 0x97, 0x61, 0x63, 0x86, 0x0c, 0xaf, 0x53,
-	0x71, 0xc2, 0x15, 0xee, 0x72, 0xc5, 0xdd, 0x61, 0x18, 0xbb, 0x0b, 0xb5, 0x5e, 0xc0, 0x45, 0xd4,
-	0x11, 0x1e, 0x65, 0xab, 0xbb, 0x55, 0x3a, 0x1f, 0x78, 0xd6, 0x1f, 0x1d, 0x16, 0xdd, 0x91, 0xeb,
-	0x7b, 0xa8, 0xd8, 0x03, 0x58, 0xc8, 0xe3, 0x3a, 0x01, 0x97, 0x01, 0xe5, 0x5c, 0x70, 0x1b, 0x39,
-	0x46, 0x15, 0xad, 0x01, 0x90, 0x0c, 0x03, 0xc2, 0x1c, 0x11, 0xea, 0x84, 0xd0, 0xf5, 0x2a, 0xd4,
-	0x8f, 0x44, 0xc4, 0x43, 0xf1, 0x05, 0x3d, 0x52, 0xaa, 0xe6, 0x5e, 0x01, 0x6c, 0x13, 0x96, 0xf2,
-	0xb7, 0xd0, 0xcb, 0xd5, 0x2c, 0x93, 0x9a, 0xb7, 0x46, 0x30, 0x49, 0xca, 0x36, 0x60, 0x49, 0x06,
-	0x3c, 0x45, 0xaf, 0x23, 0x15, 0x57, 0x98, 0x15, 0x3f, 0x4f, 0xc5, 0x2f, 0x0e, 0xe0, 0x76, 0x86,
-	0x1e, 0x78, 0x13, 0xdd, 0x55, 0x26, 0xba, 0x9b, 0x32, 0xad, 0x3a, 0x6d, 0xda, 0xb7, 0xd2, 0x78,
-	0xf3, 0xed, 0xff, 0xd2, 0xfc, 0x53, 0xa8, 0xa5, 0x28, 0x93, 0x38, 0x92, 0x48, 0xbd, 0xdf, 0x64,
-	0x6a, 0x47, 0x11, 0x93, 0xd2, 0x95, 0xa7, 0xa5, 0x2b, 0x1c, 0xeb, 0xf9, 0x7f, 0x1a, 0xeb, 0x02,
-	0x91, 0x2b, 0x45, 0x22, 0x17, 0xb8, 0x56, 0x2d, 0x74, 0x6d, 0xdc, 0x8d, 0xfa, 0x2c, 0x37, 0x60,
-	0xca, 0x0d, 0xf6, 0x08, 0x18, 0x3f, 0xc1, 0x94, 0xfb, 0x38, 0x60, 0x74, 0x94, 0xe8, 0x63, 0xb3,
-	0x41, 0xb4, 0xe5, 0xfc, 0x86, 0x98, 0x6f, 0x45, 0x1f, 0xb7, 0x7f, 0xeb, 0xb0, 0x40, 0x12, 0x62,
-	0x4a, 0xee, 0xb1, 0xf7, 0x50, 0xdb, 0x43, 0x45, 0x10, 0x7b, 0x38, 0x43, 0xf2, 0xe1, 0xa0, 0xb7,
-	0xd6, 0x8b, 0x49, 0x53, 0x3b, 0xc8, 0xd2, 0xd8, 0x01, 0xd4, 0xda, 0x37, 0x7e, 0xb9, 0x8d, 0xaa,
-	0x75, 0xc7, 0x1e, 0x2c, 0x3a, 0x7b, 0xb8, 0xe8, 0xec, 0x97, 0xd9, 0xa2, 0xb3, 0x34, 0xb6, 0x0b,
-	0x95, 0x7d, 0xe4, 0xa1, 0x0a, 0xd8, 0x35, 0x9c, 0x96, 0x39, 0xa3, 0x2a, 0x5a, 0x2e, 0x96, 0xb6,
-	0xf3, 0xfc, 0xec, 0xc2, 0xd0, 0xcf, 0x2f, 0x0c, 0xfd, 0xd7, 0x85, 0xa1, 0x7f, 0xbd, 0x34, 0xb4,
-	0xf3, 0x4b, 0x43, 0xfb, 0x71, 0x69, 0x68, 0x1f, 0x36, 0x7d, 0xa1, 0x82, 0xe3, 0xae, 0xdd, 0x8b,
-	0xfb, 0xce, 0xc4, 0x3a, 0xfe, 0x3c, 0x5a, 0xc8, 0xea, 0x34, 0x41, 0xd9, 0xad, 0x50, 0xda, 0xc7,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0x66, 0x88, 0xc1, 0x08, 0x06, 0x00, 0x00,
+func (m *BlockHashEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHashEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHashEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHashEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHashEntry.Merge(m, src)
+}
+func (m *BlockHashEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHashEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHashEntry.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_BlockHashEntry proto.InternalMessageInfo
 
-// RelayerCacheClient is the client API for RelayerCache service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RelayerCacheClient interface {
-	GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*CacheRelayReply, error)
-	SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error)
+func (m *BlockHashEntry) GetBlock() int64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
 }
 
-type relayerCacheClient struct {
-	cc grpc1.ClientConn
+func (m *BlockHashEntry) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
 }
 
-func NewRelayerCacheClient(cc grpc1.ClientConn) RelayerCacheClient {
-	return &relayerCacheClient{cc}
+// canonical block hashes of a chain, used to purge entries of orphaned blocks and promote finalized entries
+type BlockHashesUpdate struct {
+	ChainId        string           `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Hashes         []BlockHashEntry `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes"`
+	FinalizedBlock int64            `protobuf:"varint,3,opt,name=finalized_block,json=finalizedBlock,proto3" json:"finalized_block,omitempty"`
 }
 
-func (c *relayerCacheClient) GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*CacheRelayReply, error) {
-	out := new(CacheRelayReply)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/GetRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BlockHashesUpdate) Reset()         { *m = BlockHashesUpdate{} }
+func (m *BlockHashesUpdate) String() string { return proto.CompactTextString(m) }
+func (*BlockHashesUpdate) ProtoMessage()    {}
+func (*BlockHashesUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{6}
+}
+func (m *BlockHashesUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHashesUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHashesUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BlockHashesUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHashesUpdate.Merge(m, src)
+}
+func (m *BlockHashesUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHashesUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHashesUpdate.DiscardUnknown(m)
 }
 
-func (c *relayerCacheClient) SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/SetRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockHashesUpdate proto.InternalMessageInfo
+
+func (m *BlockHashesUpdate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *relayerCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error) {
-	out := new(CacheUsage)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/Health", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BlockHashesUpdate) GetHashes() []BlockHashEntry {
+	if m != nil {
+		return m.Hashes
 	}
-	return out, nil
+	return nil
 }
 
-// RelayerCacheServer is the server API for RelayerCache service.
-type RelayerCacheServer interface {
-	GetRelay(context.Context, *RelayCacheGet) (*CacheRelayReply, error)
-	SetRelay(context.Context, *RelayCacheSet) (*emptypb.Empty, error)
-	Health(context.Context, *emptypb.Empty) (*CacheUsage, error)
+func (m *BlockHashesUpdate) GetFinalizedBlock() int64 {
+	if m != nil {
+		return m.FinalizedBlock
+	}
+	return 0
 }
 
-// UnimplementedRelayerCacheServer can be embedded to have forward compatible implementations.
-type UnimplementedRelayerCacheServer struct {
+type CacheEntryMetadata struct {
+	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Key            []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RequestedBlock int64  `protobuf:"varint,3,opt,name=requested_block,json=requestedBlock,proto3" json:"requested_block,omitempty"`
+	SeenBlock      int64  `protobuf:"varint,4,opt,name=seen_block,json=seenBlock,proto3" json:"seen_block,omitempty"`
+	Source         string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Size_          int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Ttl            int64  `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (*UnimplementedRelayerCacheServer) GetRelay(ctx context.Context, req *RelayCacheGet) (*CacheRelayReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRelay not implemented")
+func (m *CacheEntryMetadata) Reset()         { *m = CacheEntryMetadata{} }
+func (m *CacheEntryMetadata) String() string { return proto.CompactTextString(m) }
+func (*CacheEntryMetadata) ProtoMessage()    {}
+func (*CacheEntryMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{7}
 }
-func (*UnimplementedRelayerCacheServer) SetRelay(ctx context.Context, req *RelayCacheSet) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRelay not implemented")
+func (m *CacheEntryMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*CacheUsage, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+func (m *CacheEntryMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheEntryMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterRelayerCacheServer(s grpc1.Server, srv RelayerCacheServer) {
-	s.RegisterService(&_RelayerCache_serviceDesc, srv)
+func (m *CacheEntryMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheEntryMetadata.Merge(m, src)
+}
+func (m *CacheEntryMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheEntryMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheEntryMetadata.DiscardUnknown(m)
 }
 
-func _RelayerCache_GetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RelayCacheGet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RelayerCacheServer).GetRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.RelayerCache/GetRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerCacheServer).GetRelay(ctx, req.(*RelayCacheGet))
+var xxx_messageInfo_CacheEntryMetadata proto.InternalMessageInfo
+
+func (m *CacheEntryMetadata) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RelayerCache_SetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RelayCacheSet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RelayerCacheServer).SetRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.RelayerCache/SetRelay",
+func (m *CacheEntryMetadata) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerCacheServer).SetRelay(ctx, req.(*RelayCacheSet))
+	return nil
+}
+
+func (m *CacheEntryMetadata) GetRequestedBlock() int64 {
+	if m != nil {
+		return m.RequestedBlock
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _RelayerCache_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CacheEntryMetadata) GetSeenBlock() int64 {
+	if m != nil {
+		return m.SeenBlock
 	}
-	if interceptor == nil {
-		return srv.(RelayerCacheServer).Health(ctx, in)
+	return 0
+}
+
+func (m *CacheEntryMetadata) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.RelayerCache/Health",
+	return ""
+}
+
+func (m *CacheEntryMetadata) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RelayerCacheServer).Health(ctx, req.(*emptypb.Empty))
+	return 0
+}
+
+func (m *CacheEntryMetadata) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _RelayerCache_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.pairing.RelayerCache",
-	HandlerType: (*RelayerCacheServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRelay",
-			Handler:    _RelayerCache_GetRelay_Handler,
-		},
-		{
-			MethodName: "SetRelay",
-			Handler:    _RelayerCache_SetRelay_Handler,
-		},
-		{
-			MethodName: "Health",
-			Handler:    _RelayerCache_Health_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lavanet/lava/pairing/relayCache.proto",
+type CacheListRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *CacheRelayReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CacheListRequest) Reset()         { *m = CacheListRequest{} }
+func (m *CacheListRequest) String() string { return proto.CompactTextString(m) }
+func (*CacheListRequest) ProtoMessage()    {}
+func (*CacheListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{8}
+}
+func (m *CacheListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CacheListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheListRequest.Merge(m, src)
+}
+func (m *CacheListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheListRequest.DiscardUnknown(m)
 }
 
-func (m *CacheRelayReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CacheListRequest proto.InternalMessageInfo
+
+func (m *CacheListRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *CacheRelayReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SeenBlock != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
-		i--
-		dAtA[i] = 0x18
+func (m *CacheListRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	if len(m.OptionalMetadata) > 0 {
-		for iNdEx := len(m.OptionalMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OptionalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRelayCache(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Reply != nil {
-		{
-			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+	return 0
+}
+
+type CacheListResponse struct {
+	Entries []CacheEntryMetadata `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *CacheListResponse) Reset()         { *m = CacheListResponse{} }
+func (m *CacheListResponse) String() string { return proto.CompactTextString(m) }
+func (*CacheListResponse) ProtoMessage()    {}
+func (*CacheListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{9}
+}
+func (m *CacheListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CacheListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheListResponse.Merge(m, src)
+}
+func (m *CacheListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheListResponse.DiscardUnknown(m)
 }
 
-func (m *CacheUsage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CacheListResponse proto.InternalMessageInfo
+
+func (m *CacheListResponse) GetEntries() []CacheEntryMetadata {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CacheUsage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CacheEntryRequest struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *CacheUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CacheMisses != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheMisses))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CacheHits != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheHits))
-		i--
-		dAtA[i] = 0x8
+func (m *CacheEntryRequest) Reset()         { *m = CacheEntryRequest{} }
+func (m *CacheEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*CacheEntryRequest) ProtoMessage()    {}
+func (*CacheEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{10}
+}
+func (m *CacheEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CacheEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheEntryRequest.Merge(m, src)
+}
+func (m *CacheEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheEntryRequest.DiscardUnknown(m)
 }
 
-func (m *CacheHash) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CacheEntryRequest proto.InternalMessageInfo
+
+func (m *CacheEntryRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CacheHash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// all set fields must match for an entry to be invalidated
+type CacheInvalidateRequest struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestHash []byte `protobuf:"bytes,2,opt,name=request_hash,json=requestHash,proto3" json:"request_hash,omitempty"`
+	FromBlock   int64  `protobuf:"varint,3,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock     int64  `protobuf:"varint,4,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
 }
 
-func (m *CacheHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+func (m *CacheInvalidateRequest) Reset()         { *m = CacheInvalidateRequest{} }
+func (m *CacheInvalidateRequest) String() string { return proto.CompactTextString(m) }
+func (*CacheInvalidateRequest) ProtoMessage()    {}
+func (*CacheInvalidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{11}
+}
+func (m *CacheInvalidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheInvalidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheInvalidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CacheInvalidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheInvalidateRequest.Merge(m, src)
+}
+func (m *CacheInvalidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheInvalidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheInvalidateRequest.DiscardUnknown(m)
 }
 
-func (m *RelayCacheGet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CacheInvalidateRequest proto.InternalMessageInfo
+
+func (m *CacheInvalidateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RelayCacheGet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CacheInvalidateRequest) GetRequestHash() []byte {
+	if m != nil {
+		return m.RequestHash
+	}
+	return nil
 }
 
-func (m *RelayCacheGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SeenBlock != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SharedStateId) > 0 {
-		i -= len(m.SharedStateId)
-		copy(dAtA[i:], m.SharedStateId)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.SharedStateId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *CacheInvalidateRequest) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
 	}
-	if m.RequestedBlock != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.RequestedBlock))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *CacheInvalidateRequest) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
 	}
-	if m.Finalized {
-		i--
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+type CacheInvalidateResponse struct {
+	Invalidated uint64 `protobuf:"varint,1,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
+}
+
+func (m *CacheInvalidateResponse) Reset()         { *m = CacheInvalidateResponse{} }
+func (m *CacheInvalidateResponse) String() string { return proto.CompactTextString(m) }
+func (*CacheInvalidateResponse) ProtoMessage()    {}
+func (*CacheInvalidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{12}
+}
+func (m *CacheInvalidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheInvalidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheInvalidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *CacheInvalidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheInvalidateResponse.Merge(m, src)
+}
+func (m *CacheInvalidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheInvalidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheInvalidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheInvalidateResponse proto.InternalMessageInfo
+
+func (m *CacheInvalidateResponse) GetInvalidated() uint64 {
+	if m != nil {
+		return m.Invalidated
 	}
-	if len(m.RequestHash) > 0 {
-		i -= len(m.RequestHash)
-		copy(dAtA[i:], m.RequestHash)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.RequestHash)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+type CacheExportRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *CacheExportRequest) Reset()         { *m = CacheExportRequest{} }
+func (m *CacheExportRequest) String() string { return proto.CompactTextString(m) }
+func (*CacheExportRequest) ProtoMessage()    {}
+func (*CacheExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{13}
+}
+func (m *CacheExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CacheExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheExportRequest.Merge(m, src)
+}
+func (m *CacheExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheExportRequest.DiscardUnknown(m)
 }
 
-func (m *RelayCacheSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CacheExportRequest proto.InternalMessageInfo
+
+func (m *CacheExportRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RelayCacheSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CacheSnapshotEntry struct {
+	Metadata  CacheEntryMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Value     CacheRelayReply    `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	BlockHash []byte             `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.SeenBlock != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.RequestedBlock != 0 {
-		i = encodeVarintRelayCache(dAtA, i, uint64(m.RequestedBlock))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.SharedStateId) > 0 {
-		i -= len(m.SharedStateId)
-		copy(dAtA[i:], m.SharedStateId)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.SharedStateId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OptionalMetadata) > 0 {
-		for iNdEx := len(m.OptionalMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OptionalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRelayCache(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.Finalized {
-		i--
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Response != nil {
-		{
-			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+func (m *CacheSnapshotEntry) Reset()         { *m = CacheSnapshotEntry{} }
+func (m *CacheSnapshotEntry) String() string { return proto.CompactTextString(m) }
+func (*CacheSnapshotEntry) ProtoMessage()    {}
+func (*CacheSnapshotEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{14}
+}
+func (m *CacheSnapshotEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheSnapshotEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheSnapshotEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RequestHash) > 0 {
-		i -= len(m.RequestHash)
-		copy(dAtA[i:], m.RequestHash)
-		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.RequestHash)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CacheSnapshotEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheSnapshotEntry.Merge(m, src)
+}
+func (m *CacheSnapshotEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheSnapshotEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheSnapshotEntry.DiscardUnknown(m)
 }
 
-func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRelayCache(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CacheSnapshotEntry proto.InternalMessageInfo
+
+func (m *CacheSnapshotEntry) GetMetadata() CacheEntryMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return CacheEntryMetadata{}
 }
-func (m *CacheRelayReply) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CacheSnapshotEntry) GetValue() CacheRelayReply {
+	if m != nil {
+		return m.Value
 	}
-	var l int
-	_ = l
-	if m.Reply != nil {
-		l = m.Reply.Size()
-		n += 1 + l + sovRelayCache(uint64(l))
+	return CacheRelayReply{}
+}
+
+func (m *CacheSnapshotEntry) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
 	}
-	if len(m.OptionalMetadata) > 0 {
-		for _, e := range m.OptionalMetadata {
-			l = e.Size()
-			n += 1 + l + sovRelayCache(uint64(l))
+	return nil
+}
+
+type CacheImportResponse struct {
+	Imported uint64 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+}
+
+func (m *CacheImportResponse) Reset()         { *m = CacheImportResponse{} }
+func (m *CacheImportResponse) String() string { return proto.CompactTextString(m) }
+func (*CacheImportResponse) ProtoMessage()    {}
+func (*CacheImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{15}
+}
+func (m *CacheImportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheImportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SeenBlock != 0 {
-		n += 1 + sovRelayCache(uint64(m.SeenBlock))
-	}
-	return n
+}
+func (m *CacheImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheImportResponse.Merge(m, src)
+}
+func (m *CacheImportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheImportResponse.DiscardUnknown(m)
 }
 
-func (m *CacheUsage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CacheHits != 0 {
-		n += 1 + sovRelayCache(uint64(m.CacheHits))
-	}
-	if m.CacheMisses != 0 {
-		n += 1 + sovRelayCache(uint64(m.CacheMisses))
+var xxx_messageInfo_CacheImportResponse proto.InternalMessageInfo
+
+func (m *CacheImportResponse) GetImported() uint64 {
+	if m != nil {
+		return m.Imported
 	}
-	return n
+	return 0
 }
 
-func (m *CacheHash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*CacheRelayReply)(nil), "lavanet.lava.pairing.CacheRelayReply")
+	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
+	proto.RegisterType((*CacheHash)(nil), "lavanet.lava.pairing.CacheHash")
+	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
+	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+	proto.RegisterType((*BlockHashEntry)(nil), "lavanet.lava.pairing.BlockHashEntry")
+	proto.RegisterType((*BlockHashesUpdate)(nil), "lavanet.lava.pairing.BlockHashesUpdate")
+	proto.RegisterType((*CacheEntryMetadata)(nil), "lavanet.lava.pairing.CacheEntryMetadata")
+	proto.RegisterType((*CacheListRequest)(nil), "lavanet.lava.pairing.CacheListRequest")
+	proto.RegisterType((*CacheListResponse)(nil), "lavanet.lava.pairing.CacheListResponse")
+	proto.RegisterType((*CacheEntryRequest)(nil), "lavanet.lava.pairing.CacheEntryRequest")
+	proto.RegisterType((*CacheInvalidateRequest)(nil), "lavanet.lava.pairing.CacheInvalidateRequest")
+	proto.RegisterType((*CacheInvalidateResponse)(nil), "lavanet.lava.pairing.CacheInvalidateResponse")
+	proto.RegisterType((*CacheExportRequest)(nil), "lavanet.lava.pairing.CacheExportRequest")
+	proto.RegisterType((*CacheSnapshotEntry)(nil), "lavanet.lava.pairing.CacheSnapshotEntry")
+	proto.RegisterType((*CacheImportResponse)(nil), "lavanet.lava.pairing.CacheImportResponse")
 }
 
-func (m *RelayCacheGet) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/relayCache.proto", fileDescriptor_36fbab536e2bbad1)
+}
+
+var fileDescriptor_36fbab536e2bbad1 = []byte{
+	// 1103 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0x9e, 0x89, 0xdf, 0xe5, 0x6c, 0x12, 0x37, 0x51, 0x30, 0x66, 0xd7, 0x98, 0x86, 0x3c, 0x90,
+	0x16, 0x1b, 0x82, 0xc4, 0x01, 0x38, 0x90, 0xec, 0x46, 0x9b, 0xa0, 0x5d, 0x04, 0x13, 0x16, 0x21,
+	0x24, 0xe4, 0xed, 0xc4, 0x1d, 0x4f, 0x2b, 0xf3, 0x62, 0xa6, 0x13, 0xad, 0xf7, 0x17, 0x70, 0xdc,
+	0x3b, 0xbf, 0x04, 0x71, 0xe3, 0xc2, 0x1e, 0x97, 0x1b, 0x27, 0x84, 0x92, 0x7f, 0xc1, 0x09, 0xf5,
+	0x63, 0xc6, 0x33, 0xce, 0x64, 0x62, 0xc4, 0x9e, 0xdc, 0x5d, 0xf3, 0x55, 0x77, 0xd5, 0xf7, 0x55,
+	0xd5, 0x8c, 0x61, 0xdd, 0x21, 0xe7, 0xc4, 0xa3, 0x7c, 0x20, 0x7e, 0x07, 0x01, 0x61, 0x21, 0xf3,
+	0xc6, 0x83, 0x90, 0x3a, 0x64, 0x72, 0x8f, 0x1c, 0xdb, 0xb4, 0x1f, 0x84, 0x3e, 0xf7, 0xd1, 0xaa,
+	0x86, 0xf5, 0xc5, 0x6f, 0x5f, 0xc3, 0x3a, 0xab, 0x63, 0x7f, 0xec, 0x4b, 0xc0, 0x40, 0xac, 0x14,
+	0xb6, 0xd3, 0xbb, 0xfe, 0x48, 0x8d, 0x78, 0x73, 0xec, 0xfb, 0x63, 0x87, 0x0e, 0xe4, 0xee, 0xe8,
+	0xec, 0x64, 0x40, 0xdd, 0x80, 0xeb, 0x87, 0xf8, 0x57, 0x13, 0x96, 0xe5, 0xd5, 0x96, 0xf0, 0xb0,
+	0x68, 0xe0, 0x4c, 0xd0, 0xc7, 0x50, 0x09, 0xc5, 0xa2, 0x6d, 0xf6, 0xcc, 0xad, 0xe6, 0x76, 0xaf,
+	0x9f, 0x17, 0x4e, 0x7f, 0xea, 0x60, 0x29, 0x38, 0xfa, 0x1a, 0x5a, 0x7e, 0xc0, 0x99, 0xef, 0x11,
+	0x67, 0xe8, 0x52, 0x4e, 0x46, 0x84, 0x93, 0xf6, 0x42, 0xaf, 0xb4, 0xd5, 0xdc, 0xee, 0xe6, 0x9f,
+	0xf1, 0x48, 0xa3, 0x76, 0xcb, 0x2f, 0xfe, 0x7a, 0xcb, 0xb0, 0x56, 0x62, 0xf7, 0xd8, 0x8e, 0xee,
+	0x00, 0x44, 0x94, 0x7a, 0xc3, 0x23, 0xc7, 0x3f, 0x3e, 0x6d, 0x97, 0x7a, 0xe6, 0x56, 0xc9, 0x6a,
+	0x08, 0xcb, 0xae, 0x30, 0xe0, 0x87, 0x00, 0x32, 0xf8, 0xc7, 0x11, 0x19, 0x53, 0x74, 0x1b, 0x1a,
+	0x72, 0xb7, 0xcf, 0x78, 0x24, 0x63, 0x2f, 0x5b, 0x53, 0x03, 0xea, 0x41, 0x53, 0x6e, 0x1e, 0xb1,
+	0x28, 0xa2, 0x51, 0x7b, 0x41, 0x3e, 0x4f, 0x9b, 0xb0, 0x1d, 0xfb, 0x93, 0xc8, 0x46, 0x9f, 0x43,
+	0x2d, 0xa4, 0x3f, 0x9e, 0xd1, 0x88, 0x6b, 0x1a, 0x36, 0x0a, 0x68, 0xf8, 0x2a, 0x64, 0xe7, 0x84,
+	0xd3, 0xfb, 0x84, 0x13, 0x2b, 0x76, 0x43, 0x6f, 0x40, 0xfd, 0xd8, 0x26, 0xcc, 0x1b, 0xb2, 0x91,
+	0xbc, 0xad, 0x61, 0xd5, 0xe4, 0xfe, 0x60, 0x84, 0xff, 0x31, 0xe1, 0x96, 0x95, 0xa8, 0xfe, 0x80,
+	0x72, 0xf4, 0x36, 0x2c, 0x6a, 0xbf, 0xa1, 0x4d, 0x22, 0x5b, 0xde, 0xb9, 0x68, 0x35, 0xb5, 0x4d,
+	0x46, 0x74, 0x07, 0x40, 0xd2, 0xa0, 0x00, 0x0b, 0x12, 0xd0, 0x90, 0x16, 0xf9, 0xf8, 0x36, 0x34,
+	0x4e, 0x98, 0x47, 0x1c, 0xf6, 0x8c, 0x8e, 0x24, 0x53, 0x75, 0x6b, 0x6a, 0x40, 0x9b, 0xb0, 0xac,
+	0xcf, 0xa2, 0x23, 0xcd, 0x66, 0x59, 0xb2, 0xb9, 0x94, 0x98, 0x25, 0xa5, 0x68, 0x03, 0x96, 0x23,
+	0x9b, 0x84, 0x74, 0x34, 0x8c, 0x38, 0xe1, 0x54, 0x04, 0x5f, 0x91, 0xc1, 0xdf, 0x52, 0xe6, 0x43,
+	0x61, 0x3d, 0x18, 0x65, 0xb2, 0xab, 0x66, 0xb2, 0x9b, 0x11, 0xad, 0x36, 0x2b, 0xda, 0x2f, 0xa5,
+	0x74, 0xf2, 0x87, 0xaf, 0x24, 0xf9, 0xcf, 0xa0, 0x1e, 0xd2, 0x28, 0xf0, 0xbd, 0x88, 0xb6, 0x4b,
+	0x73, 0x56, 0x6d, 0xe2, 0x91, 0xa5, 0xae, 0x3c, 0x4b, 0x5d, 0x6e, 0x59, 0x57, 0xfe, 0x57, 0x59,
+	0xe7, 0x90, 0x5c, 0xcd, 0x23, 0x39, 0x47, 0xb5, 0x5a, 0xae, 0x6a, 0x69, 0x35, 0x1a, 0x45, 0x6a,
+	0xc0, 0x8c, 0x1a, 0xe8, 0x2e, 0x20, 0x72, 0x4e, 0x43, 0x32, 0xa6, 0x0a, 0x31, 0xe4, 0xcc, 0xa5,
+	0xed, 0xa6, 0x84, 0xad, 0xe8, 0x27, 0x12, 0xf9, 0x0d, 0x73, 0x29, 0xfe, 0x04, 0x96, 0x76, 0x63,
+	0xd2, 0xf7, 0x3c, 0x1e, 0x4e, 0xd0, 0x2a, 0x54, 0xd4, 0xc9, 0xa6, 0x74, 0x51, 0x1b, 0x84, 0xa0,
+	0x9c, 0x12, 0x4a, 0xae, 0xf1, 0xcf, 0x26, 0xb4, 0x12, 0x67, 0x1a, 0x3d, 0x0e, 0x46, 0x84, 0xd3,
+	0x4c, 0xe4, 0x66, 0x36, 0xf2, 0x5d, 0xa8, 0xda, 0x12, 0xaa, 0x87, 0xc8, 0xbb, 0xf9, 0x6c, 0x67,
+	0x03, 0xd2, 0x9c, 0x6b, 0x4f, 0xc1, 0x60, 0xa2, 0x64, 0x66, 0x8a, 0x2c, 0x25, 0x66, 0x55, 0x95,
+	0xbf, 0x9b, 0x80, 0x64, 0x41, 0xca, 0x53, 0x12, 0xa5, 0x0a, 0xc2, 0x5b, 0x81, 0xd2, 0x29, 0x9d,
+	0xe8, 0x14, 0xc5, 0x32, 0x4f, 0xae, 0x52, 0xae, 0x5c, 0x59, 0x4d, 0xca, 0xb3, 0x9a, 0xac, 0x41,
+	0x35, 0xf2, 0xcf, 0xc2, 0x63, 0xaa, 0x5b, 0x4f, 0xef, 0x04, 0xab, 0x11, 0x7b, 0x46, 0x65, 0xad,
+	0x94, 0x2c, 0xb9, 0x16, 0x51, 0x70, 0xee, 0xe8, 0xb2, 0x10, 0x4b, 0x7c, 0x0f, 0x56, 0x64, 0x22,
+	0x0f, 0x59, 0xc4, 0xad, 0x9c, 0x59, 0x34, 0x93, 0xc6, 0x2a, 0x54, 0x1c, 0xe6, 0x32, 0xae, 0x27,
+	0xa2, 0xda, 0xe0, 0x1f, 0xa0, 0x95, 0x3a, 0x44, 0xf7, 0xc9, 0x3e, 0xd4, 0xa8, 0xc7, 0x43, 0x46,
+	0xc5, 0x78, 0x15, 0x8a, 0x6c, 0xe5, 0x2b, 0x72, 0x95, 0x47, 0xad, 0x4a, 0xec, 0x8e, 0xd7, 0xa1,
+	0x35, 0x05, 0xc5, 0x41, 0x6a, 0x42, 0xcd, 0x84, 0x50, 0xfc, 0xdc, 0x84, 0x35, 0x89, 0x3b, 0xf0,
+	0xce, 0x89, 0xc3, 0x44, 0xc1, 0xcc, 0x91, 0xd1, 0xec, 0x38, 0x59, 0xc8, 0x1d, 0x27, 0x27, 0xa1,
+	0xef, 0x66, 0xdf, 0x2b, 0xc2, 0x92, 0xb4, 0x13, 0xf7, 0x33, 0xea, 0xd4, 0xb8, 0xaf, 0xea, 0xe4,
+	0x53, 0x78, 0xfd, 0x4a, 0x44, 0x9a, 0x9e, 0x1e, 0x34, 0x59, 0x62, 0x1d, 0xe9, 0x37, 0x50, 0xda,
+	0x84, 0x07, 0x71, 0x8d, 0x3d, 0x0d, 0xfc, 0x70, 0x0e, 0x71, 0xf0, 0x6f, 0x71, 0x55, 0x1e, 0x7a,
+	0x24, 0x88, 0x6c, 0x9f, 0xab, 0xa6, 0xfb, 0x02, 0xea, 0xc9, 0x24, 0x52, 0x6f, 0xa7, 0xff, 0xaa,
+	0x44, 0xe2, 0x8f, 0x76, 0xa0, 0x72, 0x4e, 0x9c, 0x33, 0x2a, 0x69, 0x6a, 0x6e, 0xaf, 0x17, 0x1c,
+	0x34, 0x1d, 0x9e, 0xfa, 0x14, 0xe5, 0x39, 0x33, 0x9c, 0x4b, 0x33, 0xc3, 0x19, 0x7f, 0x08, 0xaf,
+	0x29, 0xca, 0x5c, 0x95, 0xb5, 0xa6, 0xab, 0x03, 0x75, 0x26, 0x2d, 0x09, 0x57, 0xc9, 0x7e, 0xfb,
+	0x8f, 0x05, 0x58, 0x94, 0xb7, 0xd1, 0x50, 0xba, 0xa2, 0xef, 0xa0, 0xfe, 0x80, 0x72, 0x69, 0x42,
+	0xef, 0x14, 0x8c, 0xf6, 0xf8, 0x85, 0xda, 0x99, 0x2f, 0x0f, 0x6c, 0xa0, 0x03, 0xa8, 0x1f, 0xce,
+	0x7d, 0xf2, 0x21, 0xe5, 0x9d, 0xb5, 0xbe, 0xfa, 0xa0, 0xea, 0xc7, 0x1f, 0x54, 0xfd, 0x3d, 0xf1,
+	0x41, 0x85, 0x0d, 0x74, 0x1f, 0xaa, 0xfb, 0x94, 0x38, 0xdc, 0x46, 0xd7, 0x60, 0x3a, 0xbd, 0x82,
+	0xa8, 0xe4, 0x47, 0x0c, 0x36, 0xd0, 0xb7, 0xd0, 0xfa, 0xd2, 0xe7, 0xec, 0x64, 0x92, 0x1a, 0x96,
+	0x68, 0xf3, 0x86, 0xd9, 0x17, 0xcf, 0xd3, 0xeb, 0xa3, 0xdb, 0xfe, 0xa9, 0x0c, 0xad, 0x34, 0xa7,
+	0x3b, 0x23, 0x97, 0x79, 0xe8, 0x09, 0x34, 0x45, 0x8f, 0xef, 0xa9, 0xc6, 0x44, 0x1b, 0x05, 0x01,
+	0xa6, 0x06, 0x4a, 0x67, 0xf3, 0x46, 0x9c, 0x52, 0x19, 0x1b, 0x68, 0x28, 0xa5, 0x53, 0x85, 0xbb,
+	0x79, 0x53, 0x99, 0xc6, 0xe7, 0xcf, 0x5d, 0xcf, 0xd8, 0x40, 0xa7, 0x00, 0xd3, 0x6e, 0x44, 0x77,
+	0x0b, 0x3c, 0xaf, 0x8c, 0x91, 0xce, 0xfb, 0x73, 0xa2, 0x93, 0x6c, 0x9e, 0x40, 0x55, 0x75, 0x2f,
+	0x2a, 0x0c, 0x31, 0xdd, 0xe0, 0x85, 0xc9, 0x64, 0x1a, 0x1b, 0x1b, 0x1f, 0x98, 0x88, 0x40, 0xf5,
+	0xc0, 0xbd, 0xf1, 0x86, 0x8c, 0x5f, 0xe7, 0xbd, 0xa2, 0x34, 0x32, 0x6d, 0x87, 0x8d, 0x2d, 0x73,
+	0x77, 0xe7, 0xc5, 0x45, 0xd7, 0x7c, 0x79, 0xd1, 0x35, 0xff, 0xbe, 0xe8, 0x9a, 0xcf, 0x2f, 0xbb,
+	0xc6, 0xcb, 0xcb, 0xae, 0xf1, 0xe7, 0x65, 0xd7, 0xf8, 0x7e, 0x73, 0xcc, 0xb8, 0x7d, 0x76, 0xd4,
+	0x3f, 0xf6, 0xdd, 0x41, 0xe6, 0x9f, 0xc5, 0xd3, 0xe4, 0xbf, 0x05, 0x9f, 0x04, 0x34, 0x3a, 0xaa,
+	0xca, 0xfa, 0xfa, 0xe8, 0xdf, 0x01, 0x00, 0x81, 0x9f, 0x9a, 0x8a, 0xd3, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RelayerCacheClient is the client API for RelayerCache service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelayerCacheClient interface {
+	GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*CacheRelayReply, error)
+	SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error)
+	NotifyBlockHashes(ctx context.Context, in *BlockHashesUpdate, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type relayerCacheClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelayerCacheClient(cc grpc1.ClientConn) RelayerCacheClient {
+	return &relayerCacheClient{cc}
+}
+
+func (c *relayerCacheClient) GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*CacheRelayReply, error) {
+	out := new(CacheRelayReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/GetRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RequestHash)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	return out, nil
+}
+
+func (c *relayerCacheClient) SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/SetRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	return out, nil
+}
+
+func (c *relayerCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error) {
+	out := new(CacheUsage)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Finalized {
-		n += 2
+	return out, nil
+}
+
+func (c *relayerCacheClient) NotifyBlockHashes(ctx context.Context, in *BlockHashesUpdate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/NotifyBlockHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.RequestedBlock != 0 {
-		n += 1 + sovRelayCache(uint64(m.RequestedBlock))
+	return out, nil
+}
+
+// RelayerCacheServer is the server API for RelayerCache service.
+type RelayerCacheServer interface {
+	GetRelay(context.Context, *RelayCacheGet) (*CacheRelayReply, error)
+	SetRelay(context.Context, *RelayCacheSet) (*emptypb.Empty, error)
+	Health(context.Context, *emptypb.Empty) (*CacheUsage, error)
+	NotifyBlockHashes(context.Context, *BlockHashesUpdate) (*emptypb.Empty, error)
+}
+
+// UnimplementedRelayerCacheServer can be embedded to have forward compatible implementations.
+type UnimplementedRelayerCacheServer struct {
+}
+
+func (*UnimplementedRelayerCacheServer) GetRelay(ctx context.Context, req *RelayCacheGet) (*CacheRelayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelay not implemented")
+}
+func (*UnimplementedRelayerCacheServer) SetRelay(ctx context.Context, req *RelayCacheSet) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelay not implemented")
+}
+func (*UnimplementedRelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*CacheUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (*UnimplementedRelayerCacheServer) NotifyBlockHashes(ctx context.Context, req *BlockHashesUpdate) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyBlockHashes not implemented")
+}
+
+func RegisterRelayerCacheServer(s grpc1.Server, srv RelayerCacheServer) {
+	s.RegisterService(&_RelayerCache_serviceDesc, srv)
+}
+
+func _RelayerCache_GetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheGet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.SharedStateId)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).GetRelay(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/GetRelay",
 	}
-	if m.SeenBlock != 0 {
-		n += 1 + sovRelayCache(uint64(m.SeenBlock))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).GetRelay(ctx, req.(*RelayCacheGet))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RelayCacheSet) Size() (n int) {
-	if m == nil {
-		return 0
+func _RelayerCache_SetRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheSet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RequestHash)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).SetRelay(ctx, in)
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/SetRelay",
 	}
-	if m.Response != nil {
-		l = m.Response.Size()
-		n += 1 + l + sovRelayCache(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).SetRelay(ctx, req.(*RelayCacheSet))
 	}
-	if m.Finalized {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCache_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OptionalMetadata) > 0 {
-		for _, e := range m.OptionalMetadata {
-			l = e.Size()
-			n += 1 + l + sovRelayCache(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).Health(ctx, in)
 	}
-	l = len(m.SharedStateId)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/Health",
 	}
-	if m.RequestedBlock != 0 {
-		n += 1 + sovRelayCache(uint64(m.RequestedBlock))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).Health(ctx, req.(*emptypb.Empty))
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovRelayCache(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCache_NotifyBlockHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHashesUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SeenBlock != 0 {
-		n += 1 + sovRelayCache(uint64(m.SeenBlock))
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).NotifyBlockHashes(ctx, in)
 	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovRelayCache(uint64(m.AverageBlockTime))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/NotifyBlockHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).NotifyBlockHashes(ctx, req.(*BlockHashesUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RelayerCache_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelayerCache",
+	HandlerType: (*RelayerCacheServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRelay",
+			Handler:    _RelayerCache_GetRelay_Handler,
+		},
+		{
+			MethodName: "SetRelay",
+			Handler:    _RelayerCache_SetRelay_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _RelayerCache_Health_Handler,
+		},
+		{
+			MethodName: "NotifyBlockHashes",
+			Handler:    _RelayerCache_NotifyBlockHashes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lavanet/lava/pairing/relayCache.proto",
+}
+
+// RelayerCacheAdminClient is the client API for RelayerCacheAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelayerCacheAdminClient interface {
+	ListEntries(ctx context.Context, in *CacheListRequest, opts ...grpc.CallOption) (*CacheListResponse, error)
+	GetEntry(ctx context.Context, in *CacheEntryRequest, opts ...grpc.CallOption) (*CacheEntryMetadata, error)
+	Invalidate(ctx context.Context, in *CacheInvalidateRequest, opts ...grpc.CallOption) (*CacheInvalidateResponse, error)
+	Export(ctx context.Context, in *CacheExportRequest, opts ...grpc.CallOption) (RelayerCacheAdmin_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (RelayerCacheAdmin_ImportClient, error)
+}
+
+type relayerCacheAdminClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelayerCacheAdminClient(cc grpc1.ClientConn) RelayerCacheAdminClient {
+	return &relayerCacheAdminClient{cc}
+}
+
+func (c *relayerCacheAdminClient) ListEntries(ctx context.Context, in *CacheListRequest, opts ...grpc.CallOption) (*CacheListResponse, error) {
+	out := new(CacheListResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/ListEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheAdminClient) GetEntry(ctx context.Context, in *CacheEntryRequest, opts ...grpc.CallOption) (*CacheEntryMetadata, error) {
+	out := new(CacheEntryMetadata)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/GetEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheAdminClient) Invalidate(ctx context.Context, in *CacheInvalidateRequest, opts ...grpc.CallOption) (*CacheInvalidateResponse, error) {
+	out := new(CacheInvalidateResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/Invalidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerCacheAdminClient) Export(ctx context.Context, in *CacheExportRequest, opts ...grpc.CallOption) (RelayerCacheAdmin_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RelayerCacheAdmin_serviceDesc.Streams[0], "/lavanet.lava.pairing.RelayerCacheAdmin/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relayerCacheAdminExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RelayerCacheAdmin_ExportClient interface {
+	Recv() (*CacheSnapshotEntry, error)
+	grpc.ClientStream
+}
+
+type relayerCacheAdminExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *relayerCacheAdminExportClient) Recv() (*CacheSnapshotEntry, error) {
+	m := new(CacheSnapshotEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *relayerCacheAdminClient) Import(ctx context.Context, opts ...grpc.CallOption) (RelayerCacheAdmin_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RelayerCacheAdmin_serviceDesc.Streams[1], "/lavanet.lava.pairing.RelayerCacheAdmin/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relayerCacheAdminImportClient{stream}
+	return x, nil
+}
+
+type RelayerCacheAdmin_ImportClient interface {
+	Send(*CacheSnapshotEntry) error
+	CloseAndRecv() (*CacheImportResponse, error)
+	grpc.ClientStream
+}
+
+type relayerCacheAdminImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *relayerCacheAdminImportClient) Send(m *CacheSnapshotEntry) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *relayerCacheAdminImportClient) CloseAndRecv() (*CacheImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CacheImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RelayerCacheAdminServer is the server API for RelayerCacheAdmin service.
+type RelayerCacheAdminServer interface {
+	ListEntries(context.Context, *CacheListRequest) (*CacheListResponse, error)
+	GetEntry(context.Context, *CacheEntryRequest) (*CacheEntryMetadata, error)
+	Invalidate(context.Context, *CacheInvalidateRequest) (*CacheInvalidateResponse, error)
+	Export(*CacheExportRequest, RelayerCacheAdmin_ExportServer) error
+	Import(RelayerCacheAdmin_ImportServer) error
+}
+
+// UnimplementedRelayerCacheAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedRelayerCacheAdminServer struct {
+}
+
+func (*UnimplementedRelayerCacheAdminServer) ListEntries(ctx context.Context, req *CacheListRequest) (*CacheListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (*UnimplementedRelayerCacheAdminServer) GetEntry(ctx context.Context, req *CacheEntryRequest) (*CacheEntryMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+}
+func (*UnimplementedRelayerCacheAdminServer) Invalidate(ctx context.Context, req *CacheInvalidateRequest) (*CacheInvalidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invalidate not implemented")
+}
+func (*UnimplementedRelayerCacheAdminServer) Export(req *CacheExportRequest, srv RelayerCacheAdmin_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedRelayerCacheAdminServer) Import(srv RelayerCacheAdmin_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+
+func RegisterRelayerCacheAdminServer(s grpc1.Server, srv RelayerCacheAdminServer) {
+	s.RegisterService(&_RelayerCacheAdmin_serviceDesc, srv)
+}
+
+func _RelayerCacheAdmin_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/ListEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).ListEntries(ctx, req.(*CacheListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCacheAdmin_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).GetEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/GetEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).GetEntry(ctx, req.(*CacheEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCacheAdmin_Invalidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheInvalidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).Invalidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/Invalidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).Invalidate(ctx, req.(*CacheInvalidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelayerCacheAdmin_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CacheExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelayerCacheAdminServer).Export(m, &relayerCacheAdminExportServer{stream})
+}
+
+type RelayerCacheAdmin_ExportServer interface {
+	Send(*CacheSnapshotEntry) error
+	grpc.ServerStream
+}
+
+type relayerCacheAdminExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *relayerCacheAdminExportServer) Send(m *CacheSnapshotEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RelayerCacheAdmin_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RelayerCacheAdminServer).Import(&relayerCacheAdminImportServer{stream})
+}
+
+type RelayerCacheAdmin_ImportServer interface {
+	SendAndClose(*CacheImportResponse) error
+	Recv() (*CacheSnapshotEntry, error)
+	grpc.ServerStream
+}
+
+type relayerCacheAdminImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *relayerCacheAdminImportServer) SendAndClose(m *CacheImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *relayerCacheAdminImportServer) Recv() (*CacheSnapshotEntry, error) {
+	m := new(CacheSnapshotEntry)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _RelayerCacheAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelayerCacheAdmin",
+	HandlerType: (*RelayerCacheAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEntries",
+			Handler:    _RelayerCacheAdmin_ListEntries_Handler,
+		},
+		{
+			MethodName: "GetEntry",
+			Handler:    _RelayerCacheAdmin_GetEntry_Handler,
+		},
+		{
+			MethodName: "Invalidate",
+			Handler:    _RelayerCacheAdmin_Invalidate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _RelayerCacheAdmin_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _RelayerCacheAdmin_Import_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lavanet/lava/pairing/relayCache.proto",
+}
+
+func (m *CacheRelayReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheRelayReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheRelayReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SeenBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OptionalMetadata) > 0 {
+		for iNdEx := len(m.OptionalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Reply != nil {
+		{
+			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CacheMisses != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheMisses))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CacheHits != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.CacheHits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayCacheGet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheGet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SeenBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SharedStateId) > 0 {
+		i -= len(m.SharedStateId)
+		copy(dAtA[i:], m.SharedStateId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.SharedStateId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RequestedBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.RequestedBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestHash) > 0 {
+		i -= len(m.RequestHash)
+		copy(dAtA[i:], m.RequestHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.RequestHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayCacheSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SeenBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RequestedBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.RequestedBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SharedStateId) > 0 {
+		i -= len(m.SharedStateId)
+		copy(dAtA[i:], m.SharedStateId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.SharedStateId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OptionalMetadata) > 0 {
+		for iNdEx := len(m.OptionalMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionalMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelayCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestHash) > 0 {
+		i -= len(m.RequestHash)
+		copy(dAtA[i:], m.RequestHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.RequestHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHashEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHashEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHashesUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHashesUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHashesUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.FinalizedBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheEntryMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheEntryMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheEntryMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SeenBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.SeenBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequestedBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.RequestedBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheInvalidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheInvalidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheInvalidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RequestHash) > 0 {
+		i -= len(m.RequestHash)
+		copy(dAtA[i:], m.RequestHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.RequestHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheInvalidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheInvalidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheInvalidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invalidated != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Invalidated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheSnapshotEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheSnapshotEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheSnapshotEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRelayCache(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRelayCache(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheImportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheImportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheImportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Imported != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelayCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CacheRelayReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reply != nil {
+		l = m.Reply.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if len(m.OptionalMetadata) > 0 {
+		for _, e := range m.OptionalMetadata {
+			l = e.Size()
+			n += 1 + l + sovRelayCache(uint64(l))
+		}
+	}
+	if m.SeenBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.SeenBlock))
+	}
+	return n
+}
+
+func (m *CacheUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CacheHits != 0 {
+		n += 1 + sovRelayCache(uint64(m.CacheHits))
+	}
+	if m.CacheMisses != 0 {
+		n += 1 + sovRelayCache(uint64(m.CacheMisses))
+	}
+	return n
+}
+
+func (m *CacheHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayCacheGet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.RequestedBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.RequestedBlock))
+	}
+	l = len(m.SharedStateId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.SeenBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.SeenBlock))
+	}
+	return n
+}
+
+func (m *RelayCacheSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if len(m.OptionalMetadata) > 0 {
+		for _, e := range m.OptionalMetadata {
+			l = e.Size()
+			n += 1 + l + sovRelayCache(uint64(l))
+		}
+	}
+	l = len(m.SharedStateId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.RequestedBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.RequestedBlock))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.SeenBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.SeenBlock))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovRelayCache(uint64(m.AverageBlockTime))
+	}
+	return n
+}
+
+func (m *BlockHashEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovRelayCache(uint64(m.Block))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHashesUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if len(m.Hashes) > 0 {
+		for _, e := range m.Hashes {
+			l = e.Size()
+			n += 1 + l + sovRelayCache(uint64(l))
+		}
+	}
+	if m.FinalizedBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.FinalizedBlock))
+	}
+	return n
+}
+
+func (m *CacheEntryMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.RequestedBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.RequestedBlock))
+	}
+	if m.SeenBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.SeenBlock))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovRelayCache(uint64(m.Size_))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovRelayCache(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *CacheListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRelayCache(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *CacheListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRelayCache(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CacheEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *CacheInvalidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.RequestHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.ToBlock))
+	}
+	return n
+}
+
+func (m *CacheInvalidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Invalidated != 0 {
+		n += 1 + sovRelayCache(uint64(m.Invalidated))
+	}
+	return n
+}
+
+func (m *CacheExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *CacheSnapshotEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovRelayCache(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRelayCache(uint64(l))
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *CacheImportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Imported != 0 {
+		n += 1 + sovRelayCache(uint64(m.Imported))
+	}
+	return n
+}
+
+func sovRelayCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelayCache(x uint64) (n int) {
+	return sovRelayCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheRelayReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheRelayReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reply == nil {
+				m.Reply = &RelayReply{}
+			}
+			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalMetadata = append(m.OptionalMetadata, Metadata{})
+			if err := m.OptionalMetadata[len(m.OptionalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+			}
+			m.SeenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeenBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheHits", wireType)
+			}
+			m.CacheHits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheHits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMisses", wireType)
+			}
+			m.CacheMisses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheMisses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayPrivateData{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheGet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheGet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestHash = append(m.RequestHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestHash == nil {
+				m.RequestHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBlock", wireType)
+			}
+			m.RequestedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedStateId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedStateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+			}
+			m.SeenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeenBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestHash = append(m.RequestHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestHash == nil {
+				m.RequestHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &RelayReply{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalMetadata = append(m.OptionalMetadata, Metadata{})
+			if err := m.OptionalMetadata[len(m.OptionalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedStateId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedStateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBlock", wireType)
+			}
+			m.RequestedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+			}
+			m.SeenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeenBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHashEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHashEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovRelayCache(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRelayCache(x uint64) (n int) {
-	return sovRelayCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
+func (m *BlockHashesUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,15 +3689,47 @@ func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CacheRelayReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockHashesUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CacheRelayReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockHashesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1112,28 +3746,231 @@ func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, BlockHashEntry{})
+			if err := m.Hashes[len(m.Hashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlock", wireType)
+			}
+			m.FinalizedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheEntryMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheEntryMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheEntryMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBlock", wireType)
+			}
+			m.RequestedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+			}
+			m.SeenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeenBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reply == nil {
-				m.Reply = &RelayReply{}
-			}
-			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMetadata", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var msglen int
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1143,31 +3980,16 @@ func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Size_ |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRelayCache
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRelayCache
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionalMetadata = append(m.OptionalMetadata, Metadata{})
-			if err := m.OptionalMetadata[len(m.OptionalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			m.SeenBlock = 0
+			m.Ttl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1177,7 +3999,7 @@ func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeenBlock |= int64(b&0x7F) << shift
+				m.Ttl |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1203,7 +4025,7 @@ func (m *CacheRelayReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CacheUsage) Unmarshal(dAtA []byte) error {
+func (m *CacheListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1226,17 +4048,17 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CacheUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CacheUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheHits", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.CacheHits = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1246,16 +4068,29 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CacheHits |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMisses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.CacheMisses = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1265,7 +4100,7 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CacheMisses |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1291,7 +4126,7 @@ func (m *CacheUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CacheHash) Unmarshal(dAtA []byte) error {
+func (m *CacheListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1314,15 +4149,15 @@ func (m *CacheHash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CacheHash: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CacheHash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1349,18 +4184,66 @@ func (m *CacheHash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RelayPrivateData{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, CacheEntryMetadata{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1370,23 +4253,25 @@ func (m *CacheHash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1409,7 +4294,7 @@ func (m *CacheHash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
+func (m *CacheInvalidateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1432,17 +4317,17 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayCacheGet: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheInvalidateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayCacheGet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheInvalidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1452,29 +4337,27 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestHash = append(m.RequestHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequestHash == nil {
-				m.RequestHash = []byte{}
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1501,16 +4384,16 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
+			m.RequestHash = append(m.RequestHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestHash == nil {
+				m.RequestHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
 			}
-			var v int
+			m.FromBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1520,36 +4403,16 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FromBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBlock", wireType)
-			}
-			m.RequestedBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharedStateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
 			}
-			var stringLen uint64
+			m.ToBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1559,61 +4422,66 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ToBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRelayCache
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharedStateId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRelayCache
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRelayCache
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheInvalidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheInvalidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheInvalidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
 			}
-			m.SeenBlock = 0
+			m.Invalidated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1623,7 +4491,7 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeenBlock |= int64(b&0x7F) << shift
+				m.Invalidated |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1649,7 +4517,7 @@ func (m *RelayCacheGet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
+func (m *CacheExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,17 +4540,17 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayCacheSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayCacheSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1692,63 +4560,77 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestHash = append(m.RequestHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequestHash == nil {
-				m.RequestHash = []byte{}
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRelayCache
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheSnapshotEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheSnapshotEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheSnapshotEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1775,36 +4657,13 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &RelayReply{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Finalized = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1831,16 +4690,15 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalMetadata = append(m.OptionalMetadata, Metadata{})
-			if err := m.OptionalMetadata[len(m.OptionalMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharedStateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1850,99 +4708,81 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRelayCache
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharedStateId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBlock", wireType)
-			}
-			m.RequestedBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRelayCache
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRelayCache
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeenBlock", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheImportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
 			}
-			m.SeenBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRelayCache
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SeenBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheImportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
 			}
-			m.AverageBlockTime = 0
+			m.Imported = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRelayCache
@@ -1952,7 +4792,7 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= int64(b&0x7F) << shift
+				m.Imported |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -127,6 +127,7 @@ const (
 	SERVICERS_FIELD
 	DESCRIPTION_FIELD
 	TYPE_FIELD
+	OVERUSE_LIMIT_FIELD
 )
 
 // TestAddInvalid Plan tests plan verification before addition
@@ -145,6 +146,7 @@ func TestAddInvalidPlan(t *testing.T) {
 		{"InvalidServicersToPairTest", 5},
 		{"InvalidDescriptionTest", 6},
 		{"InvalidTypeTest", 7},
+		{"InvalidOveruseLimitTest", 8},
 	}
 
 	for _, tt := range tests {
@@ -167,6 +169,10 @@ func TestAddInvalidPlan(t *testing.T) {
 				plans[0].Description = strings.Repeat("a", types.MAX_LEN_PLAN_DESCRIPTION+1)
 			case TYPE_FIELD:
 				plans[0].Type = strings.Repeat("a", types.MAX_LEN_PLAN_TYPE+1)
+			case OVERUSE_LIMIT_FIELD:
+				plans[0].AllowOveruse = false
+				plans[0].OveruseRate = 0
+				plans[0].OveruseCuLimit = 100
 			}
 
 			err := testkeeper.SimulatePlansAddProposal(ts.Ctx, ts.Keepers.Plans, plans, false)
@@ -30,6 +30,11 @@ func (p Plan) ValidatePlan() error {
 		return sdkerrors.Wrap(ErrInvalidPlanOveruse, "plan can't forbid CU overuse and have a non-zero overuse rate")
 	}
 
+	// check that if overuse is not allowed then there is no overuse limit
+	if !p.GetAllowOveruse() && p.GetOveruseCuLimit() != 0 {
+		return sdkerrors.Wrap(ErrInvalidPlanOveruse, "plan can't forbid CU overuse and have a non-zero overuse CU limit")
+	}
+
 	// check that the plan's description length is below the max length
 	if len(p.GetDescription()) > MAX_LEN_PLAN_DESCRIPTION {
 		return sdkerrors.Wrap(ErrInvalidPlanDescription, "plan's description is too long")
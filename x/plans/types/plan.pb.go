@@ -86,6 +86,7 @@ type Plan struct {
 	PlanPolicy               Policy     `protobuf:"bytes,14,opt,name=plan_policy,json=planPolicy,proto3" json:"plan_policy"`
 	ProjectsLimit            uint64     `protobuf:"varint,15,opt,name=projects_limit,json=projectsLimit,proto3" json:"projects_limit"`
 	AllowedBuyers            []string   `protobuf:"bytes,16,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers"`
+	OveruseCuLimit           uint64     `protobuf:"varint,17,opt,name=overuse_cu_limit,json=overuseCuLimit,proto3" json:"overuse_cu_limit"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -198,6 +199,13 @@ func (m *Plan) GetAllowedBuyers() []string {
 	return nil
 }
 
+func (m *Plan) GetOveruseCuLimit() uint64 {
+	if m != nil {
+		return m.OveruseCuLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("lavanet.lava.plans.Geolocation", Geolocation_name, Geolocation_value)
 	proto.RegisterType((*Plan)(nil), "lavanet.lava.plans.Plan")
@@ -206,45 +214,46 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/plans/plan.proto", fileDescriptor_64c3707a3b09a2e5) }
 
 var fileDescriptor_64c3707a3b09a2e5 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0x6e, 0xda, 0xb4, 0x4b, 0x9d, 0x75, 0x33, 0x86, 0x43, 0xa8, 0x20, 0xa9, 0x90, 0x40, 0x15,
-	0x87, 0x44, 0x63, 0x12, 0x12, 0x17, 0x04, 0x1d, 0x63, 0x52, 0x35, 0x89, 0x2a, 0xd3, 0x84, 0x04,
-	0x48, 0x91, 0xeb, 0x5a, 0xc5, 0xe0, 0xc5, 0x51, 0xe2, 0x8e, 0xed, 0x15, 0x38, 0xf1, 0x18, 0x3c,
-	0xca, 0x8e, 0x3b, 0x72, 0x8a, 0x50, 0x77, 0xcb, 0x4b, 0x0c, 0xd9, 0xce, 0x50, 0x0b, 0x88, 0x8b,
-	0x3f, 0x7f, 0xbf, 0xef, 0xfb, 0xb5, 0xbf, 0x3f, 0x31, 0xb8, 0xcf, 0xf1, 0x29, 0x4e, 0xa9, 0x8c,
-	0x14, 0x46, 0x19, 0xc7, 0x69, 0xa1, 0xcf, 0x30, 0xcb, 0x85, 0x14, 0x08, 0xd5, 0x72, 0xa8, 0x30,
-	0xd4, 0x72, 0xff, 0xce, 0x5c, 0xcc, 0x85, 0x96, 0x23, 0x75, 0x33, 0xce, 0xbe, 0x4f, 0x44, 0x71,
-	0x22, 0x8a, 0x68, 0x8a, 0x0b, 0x1a, 0x9d, 0xee, 0x4c, 0xa9, 0xc4, 0x3b, 0x11, 0x11, 0xac, 0xfe,
-	0xa5, 0xfe, 0xa3, 0xb5, 0x3f, 0x2a, 0x32, 0x4a, 0x22, 0x9c, 0xb1, 0x84, 0x08, 0xce, 0x29, 0x91,
-	0x4c, 0xdc, 0xf8, 0x82, 0x7f, 0x15, 0x24, 0x38, 0x23, 0xe7, 0xc6, 0xf0, 0xe0, 0x6b, 0x1b, 0xd8,
-	0x13, 0x8e, 0x53, 0x14, 0x80, 0x36, 0x4b, 0x67, 0xf4, 0xcc, 0xb3, 0x06, 0xd6, 0xb0, 0x3b, 0xea,
-	0x56, 0x65, 0x60, 0x02, 0xb1, 0x01, 0x65, 0x98, 0x72, 0x41, 0x3e, 0x7b, 0xad, 0x81, 0x35, 0xb4,
-	0x8d, 0x41, 0x07, 0x62, 0x03, 0xe8, 0x39, 0x68, 0x67, 0x39, 0x23, 0xd4, 0xb3, 0x07, 0xd6, 0xd0,
-	0x7d, 0x72, 0x37, 0x34, 0x3d, 0x84, 0xaa, 0x87, 0xb0, 0xee, 0x21, 0xdc, 0x13, 0x2c, 0x1d, 0xf5,
-	0x2e, 0xca, 0xa0, 0xa1, 0xf2, 0xb5, 0x3f, 0x36, 0x80, 0x9e, 0x82, 0x1e, 0xe6, 0x5c, 0x7c, 0x49,
-	0xc4, 0x29, 0xcd, 0x17, 0x05, 0xf5, 0x9c, 0x81, 0x35, 0x74, 0x46, 0xb7, 0xaa, 0x32, 0x58, 0x17,
-	0xe2, 0x4d, 0x4d, 0xdf, 0x18, 0x86, 0x76, 0xc1, 0x66, 0x2d, 0x24, 0x39, 0x96, 0xd4, 0xeb, 0xea,
-	0xfa, 0x60, 0x55, 0x06, 0x6b, 0xf1, 0xd8, 0xbd, 0x49, 0xc7, 0x92, 0xa2, 0x1d, 0xe0, 0xce, 0x68,
-	0x41, 0x72, 0x96, 0xa9, 0x69, 0x79, 0xae, 0x6e, 0x7a, 0xbb, 0x2a, 0x83, 0xd5, 0x70, 0xbc, 0x4a,
-	0xd0, 0x3d, 0x60, 0xcb, 0xf3, 0x8c, 0x7a, 0x9b, 0xda, 0xeb, 0x54, 0x65, 0xa0, 0x79, 0xac, 0x4f,
-	0xf4, 0x01, 0xf4, 0x71, 0x9a, 0x2e, 0x30, 0x4f, 0x66, 0xac, 0x20, 0x62, 0x91, 0xca, 0x24, 0xa3,
-	0x39, 0xa1, 0xa9, 0xc4, 0x73, 0xea, 0xf5, 0x74, 0x4d, 0x7e, 0x55, 0x06, 0xff, 0x71, 0xc5, 0x9e,
-	0xd1, 0x5e, 0xd5, 0xd2, 0xe4, 0xb7, 0x82, 0x26, 0xc0, 0x55, 0xcb, 0x4b, 0xcc, 0xee, 0xbc, 0x2d,
-	0x3d, 0xe1, 0x7e, 0xf8, 0xf7, 0xf7, 0x14, 0x4e, 0xb4, 0x63, 0x74, 0xbb, 0x1e, 0xf1, 0x6a, 0x5a,
-	0x0c, 0x14, 0x31, 0x06, 0xf4, 0x0c, 0x6c, 0x65, 0xb9, 0xf8, 0x44, 0x89, 0x2c, 0x12, 0xce, 0x4e,
-	0x98, 0xf4, 0xb6, 0x75, 0x8d, 0xa8, 0x2a, 0x83, 0x3f, 0x94, 0xb8, 0x77, 0xc3, 0x0f, 0x15, 0x55,
-	0xa9, 0x7a, 0x01, 0x74, 0x96, 0x4c, 0x17, 0xe7, 0x34, 0x2f, 0x3c, 0x38, 0x68, 0x0d, 0xbb, 0x26,
-	0x75, 0x5d, 0x89, 0x7b, 0x35, 0x1f, 0x69, 0x3a, 0xb6, 0x1d, 0x00, 0xdd, 0xb1, 0xed, 0x34, 0x61,
-	0x6b, 0x6c, 0x3b, 0x6d, 0xd8, 0x19, 0xdb, 0x4e, 0x07, 0x6e, 0x8c, 0x6d, 0x67, 0x03, 0x3a, 0x8f,
-	0xdf, 0x03, 0xf7, 0x80, 0x0a, 0x2e, 0x08, 0xd6, 0x03, 0xdf, 0x00, 0xad, 0x83, 0xc3, 0x23, 0xd8,
-	0x50, 0x97, 0xe3, 0xa3, 0x3d, 0x68, 0xa1, 0x0e, 0x68, 0xee, 0x1f, 0xc3, 0xa6, 0x09, 0xec, 0x43,
-	0xdb, 0x5c, 0xde, 0x42, 0x47, 0x29, 0x2f, 0x5f, 0x43, 0xa8, 0xf1, 0x08, 0x0e, 0x34, 0x1e, 0xc3,
-	0x17, 0xc8, 0x01, 0xcd, 0x83, 0x43, 0x78, 0x7d, 0xdd, 0x1a, 0xed, 0x7d, 0x5f, 0xfa, 0xd6, 0xc5,
-	0xd2, 0xb7, 0x2e, 0x97, 0xbe, 0xf5, 0x73, 0xe9, 0x5b, 0xdf, 0xae, 0xfc, 0xc6, 0xe5, 0x95, 0xdf,
-	0xf8, 0x71, 0xe5, 0x37, 0xde, 0x3d, 0x9c, 0x33, 0xf9, 0x71, 0x31, 0x0d, 0x89, 0x38, 0x89, 0xd6,
-	0xde, 0xcc, 0x59, 0xfd, 0x6a, 0xd4, 0x92, 0x8b, 0x69, 0x47, 0xbf, 0x9a, 0xdd, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xfc, 0x16, 0x46, 0xe9, 0xe9, 0x03, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0xb4, 0x4b, 0x9d, 0x75, 0xf3, 0xcc, 0x0e, 0xa1, 0x82, 0xa4, 0x42, 0x02, 0x55,
+	0x1c, 0x12, 0x8d, 0x49, 0x48, 0x5c, 0x26, 0x68, 0x19, 0x93, 0xaa, 0x49, 0x54, 0x99, 0x26, 0x24,
+	0x40, 0x8a, 0x1c, 0xd7, 0x2a, 0x81, 0x2c, 0x8e, 0x12, 0x67, 0x6c, 0xff, 0x82, 0x9f, 0xc1, 0x4f,
+	0x99, 0x38, 0xed, 0xc8, 0x29, 0x42, 0xdd, 0x2d, 0x7f, 0x62, 0xc8, 0x76, 0x8a, 0x5a, 0x86, 0xb8,
+	0xe4, 0xf3, 0xf7, 0xbe, 0xef, 0xc5, 0xef, 0xf9, 0xd9, 0xe0, 0x61, 0x8c, 0xcf, 0x71, 0x42, 0xb9,
+	0x27, 0xd0, 0x4b, 0x63, 0x9c, 0xe4, 0xf2, 0xeb, 0xa6, 0x19, 0xe3, 0x0c, 0xa1, 0x5a, 0x76, 0x05,
+	0xba, 0x52, 0xee, 0xef, 0xce, 0xd9, 0x9c, 0x49, 0xd9, 0x13, 0x2b, 0xe5, 0xec, 0xdb, 0x84, 0xe5,
+	0x67, 0x2c, 0xf7, 0x42, 0x9c, 0x53, 0xef, 0x7c, 0x2f, 0xa4, 0x1c, 0xef, 0x79, 0x84, 0x45, 0xf5,
+	0x9f, 0xfa, 0x4f, 0xd6, 0x36, 0xca, 0x53, 0x4a, 0x3c, 0x9c, 0x46, 0x01, 0x61, 0x71, 0x4c, 0x09,
+	0x8f, 0xd8, 0xd2, 0xe7, 0xfc, 0xab, 0x20, 0x16, 0x47, 0xe4, 0x52, 0x19, 0x1e, 0xfd, 0x68, 0x03,
+	0x7d, 0x1a, 0xe3, 0x04, 0x39, 0xa0, 0x1d, 0x25, 0x33, 0x7a, 0x61, 0x69, 0x03, 0x6d, 0xd8, 0x1d,
+	0x75, 0xab, 0xd2, 0x51, 0x01, 0x5f, 0x81, 0x30, 0x84, 0x31, 0x23, 0x5f, 0xac, 0xd6, 0x40, 0x1b,
+	0xea, 0xca, 0x20, 0x03, 0xbe, 0x02, 0x74, 0x00, 0xda, 0x69, 0x16, 0x11, 0x6a, 0xe9, 0x03, 0x6d,
+	0x68, 0x3e, 0xbb, 0xef, 0xaa, 0x1e, 0x5c, 0xd1, 0x83, 0x5b, 0xf7, 0xe0, 0x8e, 0x59, 0x94, 0x8c,
+	0x7a, 0x57, 0xa5, 0xd3, 0x10, 0xf9, 0xd2, 0xef, 0x2b, 0x40, 0xcf, 0x41, 0x0f, 0xc7, 0x31, 0xfb,
+	0x1a, 0xb0, 0x73, 0x9a, 0x15, 0x39, 0xb5, 0x8c, 0x81, 0x36, 0x34, 0x46, 0x3b, 0x55, 0xe9, 0xac,
+	0x0b, 0xfe, 0xa6, 0xa4, 0x6f, 0x15, 0x43, 0xfb, 0x60, 0xb3, 0x16, 0x82, 0x0c, 0x73, 0x6a, 0x75,
+	0x65, 0x7d, 0xb0, 0x2a, 0x9d, 0xb5, 0xb8, 0x6f, 0x2e, 0xd3, 0x31, 0xa7, 0x68, 0x0f, 0x98, 0x33,
+	0x9a, 0x93, 0x2c, 0x4a, 0xc5, 0x69, 0x59, 0xa6, 0x6c, 0x7a, 0xbb, 0x2a, 0x9d, 0xd5, 0xb0, 0xbf,
+	0x4a, 0xd0, 0x03, 0xa0, 0xf3, 0xcb, 0x94, 0x5a, 0x9b, 0xd2, 0x6b, 0x54, 0xa5, 0x23, 0xb9, 0x2f,
+	0xbf, 0xe8, 0x23, 0xe8, 0xe3, 0x24, 0x29, 0x70, 0x1c, 0xcc, 0xa2, 0x9c, 0xb0, 0x22, 0xe1, 0x41,
+	0x4a, 0x33, 0x42, 0x13, 0x8e, 0xe7, 0xd4, 0xea, 0xc9, 0x9a, 0xec, 0xaa, 0x74, 0xfe, 0xe3, 0xf2,
+	0x2d, 0xa5, 0xbd, 0xae, 0xa5, 0xe9, 0x1f, 0x05, 0x4d, 0x81, 0x29, 0x86, 0x17, 0xa8, 0xd9, 0x59,
+	0x5b, 0xf2, 0x84, 0xfb, 0xee, 0xdd, 0xfb, 0xe4, 0x4e, 0xa5, 0x63, 0x74, 0xaf, 0x3e, 0xe2, 0xd5,
+	0x34, 0x1f, 0x08, 0xa2, 0x0c, 0xe8, 0x05, 0xd8, 0x4a, 0x33, 0xf6, 0x99, 0x12, 0x9e, 0x07, 0x71,
+	0x74, 0x16, 0x71, 0x6b, 0x5b, 0xd6, 0x88, 0xaa, 0xd2, 0xf9, 0x4b, 0xf1, 0x7b, 0x4b, 0x7e, 0x2c,
+	0xa8, 0x48, 0x95, 0x03, 0xa0, 0xb3, 0x20, 0x2c, 0x2e, 0x69, 0x96, 0x5b, 0x70, 0xd0, 0x1a, 0x76,
+	0x55, 0xea, 0xba, 0xe2, 0xf7, 0x6a, 0x3e, 0x92, 0x14, 0x1d, 0x00, 0xb8, 0x9c, 0x09, 0x29, 0xea,
+	0x7d, 0x77, 0xe4, 0xbe, 0xbb, 0x55, 0xe9, 0xdc, 0xd1, 0xfc, 0xad, 0x3a, 0x32, 0x2e, 0xe4, 0xd6,
+	0x13, 0xdd, 0x00, 0xd0, 0x9c, 0xe8, 0x46, 0x13, 0xb6, 0x26, 0xba, 0xd1, 0x86, 0x9d, 0x89, 0x6e,
+	0x74, 0xe0, 0xc6, 0x44, 0x37, 0x36, 0xa0, 0xf1, 0xf4, 0x03, 0x30, 0x8f, 0x28, 0x8b, 0x19, 0xc1,
+	0x72, 0x60, 0x1b, 0xa0, 0x75, 0x74, 0x7c, 0x02, 0x1b, 0x62, 0x71, 0x7a, 0x32, 0x86, 0x1a, 0xea,
+	0x80, 0xe6, 0xe1, 0x29, 0x6c, 0xaa, 0xc0, 0x21, 0xd4, 0xd5, 0xe2, 0x1d, 0x34, 0x84, 0xf2, 0xea,
+	0x0d, 0x84, 0x12, 0x4f, 0xe0, 0x40, 0xe2, 0x29, 0x7c, 0x89, 0x0c, 0xd0, 0x3c, 0x3a, 0x86, 0xb7,
+	0xb7, 0xad, 0xd1, 0xf8, 0xfb, 0xc2, 0xd6, 0xae, 0x16, 0xb6, 0x76, 0xbd, 0xb0, 0xb5, 0x5f, 0x0b,
+	0x5b, 0xfb, 0x76, 0x63, 0x37, 0xae, 0x6f, 0xec, 0xc6, 0xcf, 0x1b, 0xbb, 0xf1, 0xfe, 0xf1, 0x3c,
+	0xe2, 0x9f, 0x8a, 0xd0, 0x25, 0xec, 0xcc, 0x5b, 0x7b, 0x73, 0x17, 0xf5, 0xab, 0x13, 0x97, 0x24,
+	0x0f, 0x3b, 0xf2, 0xd5, 0xed, 0xff, 0x1e, 0x00, 0x87, 0x00, 0x0a, 0xa7, 0x29, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -304,6 +313,9 @@ func (this *Plan) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.OveruseCuLimit != that1.OveruseCuLimit {
+		return false
+	}
 	return true
 }
 func (m *Plan) Marshal() (dAtA []byte, err error) {
@@ -326,6 +338,13 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OveruseCuLimit != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.OveruseCuLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if len(m.AllowedBuyers) > 0 {
 		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedBuyers[iNdEx])
@@ -465,6 +484,9 @@ func (m *Plan) Size() (n int) {
 			n += 2 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.OveruseCuLimit != 0 {
+		n += 2 + sovPlan(uint64(m.OveruseCuLimit))
+	}
 	return n
 }
 
@@ -793,6 +815,25 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedBuyers = append(m.AllowedBuyers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseCuLimit", wireType)
+			}
+			m.OveruseCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetProjectCuBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cu-budget [project-id] [cu-budget]",
+		Short: "Set the monthly CU budget of a project",
+		Long: `The set-cu-budget command allows the project's subscription consumer to limit the CU the project may use each month, on top of the project's policies.
+		A budget of 0 removes the limit. The new budget will be applied from the next epoch.`,
+		Example: `required flags: --from <subscription-consumer>
+
+		lavad tx project set-cu-budget [project-id] 100000 --from <subscription-consumer>`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			projectID := args[0]
+			cuBudget, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProjectCuBudget(
+				clientCtx.GetFromAddress().String(),
+				projectID,
+				cuBudget,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
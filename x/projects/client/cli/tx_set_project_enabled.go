@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/projects/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetProjectEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-enabled [project-id] [true/false]",
+		Short: "Enable or disable a project",
+		Long: `The set-enabled command allows the project admin to enable or disable the project.
+		A disabled project cannot get pairing and its relays are not paid. The change is effective immediately.`,
+		Example: `required flags: --from <admin-key> (the project's subscription address is also considered admin)
+
+		lavad tx project set-enabled [project-id] false --from <admin-key>`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			projectID := args[0]
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProjectEnabled(
+				clientCtx.GetFromAddress().String(),
+				projectID,
+				enabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
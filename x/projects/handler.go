@@ -30,6 +30,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSetSubscriptionPolicy:
 			res, err := msgServer.SetSubscriptionPolicy(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProjectEnabled:
+			res, err := msgServer.SetProjectEnabled(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProjectCuBudget:
+			res, err := msgServer.SetProjectCuBudget(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -38,6 +39,12 @@ import (
 //   -> unregisterKey(all-keys, project, nextEpoch) (see below)
 //   -> DelEntry(project, nextEpoch)
 //
+// upon TransferSubscriptionProjects(sub, new-sub)
+//   -> for each project: FindEntry(project-next, epoch-next)
+//   -> drop the old subscription's key, move dev-keys: AppendEntry(dev-key, epoch-next)
+//   -> AppendEntry(new-project, epoch-next), DelEntry(project, epoch-next)
+//   -> add the new subscription's key to its admin project (if free)
+//
 // upon registerKey(project, epoch)
 //   -> if admin: add to project
 //   -> if devel:
@@ -173,6 +180,103 @@ func (k Keeper) DeleteProject(ctx sdk.Context, creator, projectID string) error
 	return k.projectsFS.DelEntry(ctx, project.Index, nextEpoch)
 }
 
+// TransferSubscriptionProjects moves all the projects of a subscription to the subscription's
+// new consumer, keeping their names, keys and policies. The old consumer's key is removed and
+// the new consumer's key is added to the admin project (unless it already belongs to a project)
+// (takes effect at the beginning of next epoch)
+func (k Keeper) TransferSubscriptionProjects(ctx sdk.Context, subAddr, newSubAddr string) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: TransferSubscriptionProjects failed to get next epoch", err,
+			utils.Attribute{Key: "subscription", Value: subAddr},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	var projects []types.Project
+	for _, projectID := range k.GetAllProjectsForSubscription(ctx, subAddr) {
+		var project types.Project
+		if found := k.projectsFS.FindEntry(ctx, projectID, nextEpoch, &project); !found {
+			// already deleted (takes effect next epoch)
+			continue
+		}
+
+		newIndex := types.ProjectIndex(newSubAddr, strings.TrimPrefix(project.Index, subAddr+"-"))
+		var existing types.Project
+		if found := k.projectsFS.FindEntry(ctx, newIndex, nextEpoch, &existing); found {
+			return utils.LavaFormatWarning("transfer subscription projects failed",
+				fmt.Errorf("project name already exist for new subscription"),
+				utils.Attribute{Key: "subscription", Value: newSubAddr},
+				utils.Attribute{Key: "project", Value: newIndex},
+			)
+		}
+
+		projects = append(projects, project)
+	}
+
+	// the new subscription's key may already be a developer key (of a transferred project or another one)
+	var devkeyData types.ProtoDeveloperData
+	newSubKeyFound := k.developerKeysFS.FindEntry(ctx, newSubAddr, nextEpoch, &devkeyData)
+
+	for _, project := range projects {
+		oldIndex := project.Index
+		project.Index = types.ProjectIndex(newSubAddr, strings.TrimPrefix(oldIndex, subAddr+"-"))
+		project.Subscription = newSubAddr
+
+		keys := project.ProjectKeys
+		project.ProjectKeys = []types.ProjectKey{}
+		for _, projectKey := range keys {
+			if projectKey.IsType(types.ProjectKey_DEVELOPER) {
+				if projectKey.Key == subAddr {
+					err = k.developerKeysFS.DelEntry(ctx, projectKey.Key, nextEpoch)
+				} else {
+					err = k.developerKeysFS.AppendEntry(ctx, projectKey.Key, nextEpoch, &types.ProtoDeveloperData{ProjectID: project.Index})
+				}
+				if err != nil {
+					return utils.LavaFormatError("transfer subscription projects failed", err,
+						utils.Attribute{Key: "project", Value: oldIndex},
+						utils.Attribute{Key: "key", Value: projectKey.Key},
+					)
+				}
+			}
+			if projectKey.Key != subAddr {
+				project.ProjectKeys = append(project.ProjectKeys, projectKey)
+			}
+		}
+
+		if project.Index == types.ProjectIndex(newSubAddr, types.ADMIN_PROJECT_NAME) && !newSubKeyFound {
+			err = k.developerKeysFS.AppendEntry(ctx, newSubAddr, nextEpoch, &types.ProtoDeveloperData{ProjectID: project.Index})
+			if err != nil {
+				return utils.LavaFormatError("transfer subscription projects failed", err,
+					utils.Attribute{Key: "project", Value: project.Index},
+					utils.Attribute{Key: "key", Value: newSubAddr},
+				)
+			}
+			project.AppendKey(types.ProjectDeveloperKey(newSubAddr))
+		}
+
+		err = k.projectsFS.AppendEntry(ctx, project.Index, nextEpoch, &project)
+		if err != nil {
+			return utils.LavaFormatError("transfer subscription projects failed", err,
+				utils.Attribute{Key: "project", Value: project.Index},
+				utils.Attribute{Key: "block", Value: nextEpoch},
+			)
+		}
+
+		err = k.projectsFS.DelEntry(ctx, oldIndex, nextEpoch)
+		if err != nil {
+			return utils.LavaFormatError("transfer subscription projects failed", err,
+				utils.Attribute{Key: "project", Value: oldIndex},
+				utils.Attribute{Key: "block", Value: nextEpoch},
+			)
+		}
+	}
+
+	return nil
+}
+
 // registerKey adds a key to a project. For developer keys it also updates the
 // developer key registry (that maps them to projects). The block argument is
 // expected to be current block height (takes effect immediately).
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func (k msgServer) SetProjectEnabled(goCtx context.Context, msg *types.MsgSetProjectEnabled) (*types.MsgSetProjectEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	err := k.Keeper.SetProjectEnabled(ctx, msg.GetProject(), msg.GetCreator(), msg.GetEnabled())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetProjectEnabledResponse{}, nil
+}
@@ -273,6 +273,168 @@ func (k Keeper) ChargeComputeUnitsToProject(ctx sdk.Context, project types.Proje
 		k.projectsFS.ModifyEntry(ctx, project.Index, block, &proj)
 	}
 
+	k.notifyCuBudgetUsage(ctx, project, cu)
+
+	return nil
+}
+
+// notifyCuBudgetUsage emits events when a charge of CU to the project crosses the
+// warning threshold or the end of the project's monthly CU budget.
+func (k Keeper) notifyCuBudgetUsage(ctx sdk.Context, project types.Project, cu uint64) {
+	if project.CuBudget == 0 {
+		return
+	}
+
+	usedBefore := project.UsedCu
+	usedAfter := usedBefore + cu
+	warnThreshold := project.CuBudget * types.PROJECT_CU_BUDGET_WARN_PERCENT / 100
+
+	details := map[string]string{
+		"project":   project.Index,
+		"cu_budget": strconv.FormatUint(project.CuBudget, 10),
+		"used_cu":   strconv.FormatUint(usedAfter, 10),
+		"block":     strconv.FormatInt(ctx.BlockHeight(), 10),
+	}
+
+	if usedBefore < warnThreshold && usedAfter >= warnThreshold {
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectCuBudgetWarnEventName,
+			details, "project used most of its CU budget")
+	}
+
+	if usedBefore < project.CuBudget && usedAfter >= project.CuBudget {
+		utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProjectCuBudgetUsedEventName,
+			details, "project used all of its CU budget")
+	}
+}
+
+// SetProjectEnabled enables or disables a project. The change is effective immediately,
+// retroactively at the start of this epoch. The adminKey must be valid (and specifically,
+// not already marked for deletion by next epoch).
+func (k Keeper) SetProjectEnabled(ctx sdk.Context, projectID, adminKey string, enabled bool) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	epoch, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: SetProjectEnabled failed to get EpochStart", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	project, _, err := k.getProjectForBlock(ctx, projectID, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to set project enabled", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: SetProjectEnabled failed to get NextEpoch", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	projectNextEpoch, realBlockNextEpoch, err := k.getProjectForBlock(ctx, projectID, nextEpoch)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to set project enabled (peek)", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	// admin key check is done respective of next epoch (like in AddKeysToProject)
+	if !projectNextEpoch.IsAdminKey(adminKey) {
+		return utils.LavaFormatWarning("failed to set project enabled",
+			fmt.Errorf("requesting key must be admin key"),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "key", Value: adminKey},
+		)
+	}
+
+	project.Enabled = enabled
+	err = k.projectsFS.AppendEntry(ctx, projectID, epoch, &project)
+	if err != nil {
+		return utils.LavaFormatError("failed to set project enabled (append)", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	// if there is a next epoch version (e.g. keys were deleted in this epoch) then
+	// update it too, so the change will not be reverted in the next epoch
+	if realBlockNextEpoch == nextEpoch {
+		projectNextEpoch.Enabled = enabled
+		err = k.projectsFS.AppendEntry(ctx, projectID, nextEpoch, &projectNextEpoch)
+		if err != nil {
+			return utils.LavaFormatError("failed to set project enabled (future)", err,
+				utils.Attribute{Key: "project", Value: projectID},
+				utils.Attribute{Key: "block", Value: ctxBlock},
+			)
+		}
+	}
+
+	details := map[string]string{
+		"creator": adminKey,
+		"project": projectID,
+		"enabled": strconv.FormatBool(enabled),
+		"block":   strconv.FormatUint(ctxBlock, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SetProjectEnabledEventName, details, "set project enabled successfully")
+
+	return nil
+}
+
+// SetProjectCuBudget sets the monthly CU budget of a project. The change will take
+// effect in the beginning of the next epoch. The key must be the project's
+// subscription key.
+func (k Keeper) SetProjectCuBudget(ctx sdk.Context, projectID, key string, cuBudget uint64) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: SetProjectCuBudget failed to get NextEpoch", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	projectNextEpoch, _, err := k.getProjectForBlock(ctx, projectID, nextEpoch)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to set project CU budget (peek)", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	if key != projectNextEpoch.GetSubscription() {
+		return utils.LavaFormatWarning("failed to set project CU budget",
+			fmt.Errorf("requesting key must be subscription key"),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "key", Value: key},
+		)
+	}
+
+	projectNextEpoch.CuBudget = cuBudget
+	err = k.projectsFS.AppendEntry(ctx, projectID, nextEpoch, &projectNextEpoch)
+	if err != nil {
+		return utils.LavaFormatError("critical: failed to set project CU budget",
+			fmt.Errorf("append entry: %w", err),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	details := map[string]string{
+		"creator":   key,
+		"project":   projectID,
+		"cu_budget": strconv.FormatUint(cuBudget, 10),
+		"block":     strconv.FormatUint(ctxBlock, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SetProjectCuBudgetEventName, details, "set project CU budget successfully")
+
 	return nil
 }
 
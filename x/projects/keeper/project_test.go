@@ -9,6 +9,7 @@ import (
 
 	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 	"github.com/lavanet/lava/x/projects/types"
@@ -1317,3 +1318,137 @@ func TestMaxKeysInProject(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+// TestSetProjectEnabled checks that only admin keys can enable/disable a project, that
+// the change is effective immediately, and that it is not reverted by a pending next
+// epoch version of the project
+func TestSetProjectEnabled(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 1, 2) // 1 sub, 1 adm, 2 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	_, adm1Addr := ts.Account("adm1")
+	_, dev1Addr := ts.Account("dev1")
+	_, dev2Addr := ts.Account("dev2")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, "free", 1, false, false)
+	require.NoError(t, err)
+
+	res, err := ts.QuerySubscriptionListProjects(sub1Addr)
+	require.NoError(t, err)
+	projectID := res.Projects[0]
+
+	err = ts.TxProjectAddKeys(projectID, sub1Addr,
+		types.ProjectAdminKey(adm1Addr),
+		types.ProjectDeveloperKey(dev1Addr),
+		types.ProjectDeveloperKey(dev2Addr),
+	)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// developer key cannot disable the project
+	err = ts.TxProjectSetEnabled(projectID, dev1Addr, false)
+	require.Error(t, err)
+
+	// admin key disables the project: effective immediately
+	err = ts.TxProjectSetEnabled(projectID, adm1Addr, false)
+	require.NoError(t, err)
+	devRes, err := ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.False(t, devRes.Project.Enabled)
+
+	ts.AdvanceEpoch()
+	devRes, err = ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.False(t, devRes.Project.Enabled)
+
+	// delete a key (creates a next epoch version) and then enable the project
+	err = ts.TxProjectDelKeys(projectID, sub1Addr, types.ProjectDeveloperKey(dev2Addr))
+	require.NoError(t, err)
+	err = ts.TxProjectSetEnabled(projectID, sub1Addr, true)
+	require.NoError(t, err)
+	devRes, err = ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.True(t, devRes.Project.Enabled)
+
+	// the project should remain enabled in the next epoch (and the key deleted)
+	ts.AdvanceEpoch()
+	devRes, err = ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.True(t, devRes.Project.Enabled)
+	_, err = ts.QueryProjectDeveloper(dev2Addr)
+	require.Error(t, err)
+}
+
+// TestSetProjectCuBudget checks that only the subscription key can set a project's CU
+// budget, that it is effective from the next epoch, and that charging CU to the project
+// emits events when 80% and 100% of the budget are used
+func TestSetProjectCuBudget(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 1, 0) // 1 sub, 1 adm, 0 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	_, adm1Addr := ts.Account("adm1")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, "free", 1, false, false)
+	require.NoError(t, err)
+
+	res, err := ts.QuerySubscriptionListProjects(sub1Addr)
+	require.NoError(t, err)
+	projectID := res.Projects[0]
+
+	err = ts.TxProjectAddKeys(projectID, sub1Addr, types.ProjectAdminKey(adm1Addr))
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// admin key (that is not the subscription key) cannot set the budget
+	err = ts.TxProjectSetCuBudget(projectID, adm1Addr, 1000)
+	require.Error(t, err)
+
+	err = ts.TxProjectSetCuBudget(projectID, sub1Addr, 1000)
+	require.NoError(t, err)
+
+	// budget is pending until the next epoch
+	infoRes, err := ts.QueryProjectInfo(projectID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), infoRes.Project.CuBudget)
+	require.Equal(t, uint64(1000), infoRes.PendingProject.CuBudget)
+
+	ts.AdvanceEpoch()
+
+	infoRes, err = ts.QueryProjectInfo(projectID)
+	require.NoError(t, err)
+	project := *infoRes.Project
+	require.Equal(t, uint64(1000), project.CuBudget)
+
+	countEvents := func(name string) int {
+		count := 0
+		for _, event := range ts.Ctx.EventManager().Events() {
+			if event.Type == utils.EventPrefix+name {
+				count++
+			}
+		}
+		return count
+	}
+
+	block := ts.BlockHeight()
+	charge := func(cu uint64) {
+		project, err = ts.GetProjectForBlock(projectID, block)
+		require.NoError(t, err)
+		err = ts.Keepers.Projects.ChargeComputeUnitsToProject(ts.Ctx, project, block, cu)
+		require.NoError(t, err)
+	}
+
+	charge(700)
+	require.Equal(t, 0, countEvents(types.ProjectCuBudgetWarnEventName))
+	charge(100)
+	require.Equal(t, 1, countEvents(types.ProjectCuBudgetWarnEventName))
+	require.Equal(t, 0, countEvents(types.ProjectCuBudgetUsedEventName))
+	charge(300)
+	require.Equal(t, 1, countEvents(types.ProjectCuBudgetWarnEventName))
+	require.Equal(t, 1, countEvents(types.ProjectCuBudgetUsedEventName))
+	charge(100)
+	require.Equal(t, 1, countEvents(types.ProjectCuBudgetUsedEventName))
+}
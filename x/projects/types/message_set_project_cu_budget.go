@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetProjectCuBudget = "set_project_cu_budget"
+
+var _ sdk.Msg = &MsgSetProjectCuBudget{}
+
+func NewMsgSetProjectCuBudget(creator, project string, cuBudget uint64) *MsgSetProjectCuBudget {
+	return &MsgSetProjectCuBudget{
+		Creator:  creator,
+		Project:  project,
+		CuBudget: cuBudget,
+	}
+}
+
+func (msg *MsgSetProjectCuBudget) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProjectCuBudget) Type() string {
+	return TypeMsgSetProjectCuBudget
+}
+
+func (msg *MsgSetProjectCuBudget) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetProjectCuBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProjectCuBudget) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Project == "" {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "empty project index")
+	}
+
+	return nil
+}
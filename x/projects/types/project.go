@@ -112,3 +112,32 @@ func (project *Project) DeleteKey(key ProjectKey) bool {
 func (project *Project) IsAdminKey(key string) bool {
 	return project.Subscription == key || project.GetKey(key).IsType(ProjectKey_ADMIN)
 }
+
+// ApplyCuBudget caps the allowed CU (per epoch and total) of the project by its
+// monthly CU budget. A zero budget means that the project has no budget.
+func (project *Project) ApplyCuBudget(allowedCUEpoch, allowedCUTotal uint64) (uint64, uint64) {
+	if project.CuBudget == 0 {
+		return allowedCUEpoch, allowedCUTotal
+	}
+
+	allowedCUTotal = project.ApplyCuBudgetToTotal(allowedCUTotal)
+
+	budgetLeft := uint64(0)
+	if project.UsedCu < project.CuBudget {
+		budgetLeft = project.CuBudget - project.UsedCu
+	}
+	if budgetLeft < allowedCUEpoch {
+		allowedCUEpoch = budgetLeft
+	}
+
+	return allowedCUEpoch, allowedCUTotal
+}
+
+// ApplyCuBudgetToTotal caps only the allowed total CU of the project by its
+// monthly CU budget. A zero budget means that the project has no budget.
+func (project *Project) ApplyCuBudgetToTotal(allowedCUTotal uint64) uint64 {
+	if project.CuBudget != 0 && (allowedCUTotal == 0 || project.CuBudget < allowedCUTotal) {
+		return project.CuBudget
+	}
+	return allowedCUTotal
+}
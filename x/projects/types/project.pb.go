@@ -61,6 +61,7 @@ type Project struct {
 	UsedCu             uint64        `protobuf:"varint,7,opt,name=used_cu,json=usedCu,proto3" json:"used_cu,omitempty"`
 	SubscriptionPolicy *types.Policy `protobuf:"bytes,8,opt,name=subscription_policy,json=subscriptionPolicy,proto3" json:"subscription_policy"`
 	Snapshot           uint64        `protobuf:"varint,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	CuBudget           uint64        `protobuf:"varint,10,opt,name=cu_budget,json=cuBudget,proto3" json:"cu_budget"`
 }
 
 func (m *Project) Reset()         { *m = Project{} }
@@ -152,6 +153,13 @@ func (m *Project) GetSnapshot() uint64 {
 	return 0
 }
 
+func (m *Project) GetCuBudget() uint64 {
+	if m != nil {
+		return m.CuBudget
+	}
+	return 0
+}
+
 type ProjectKey struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
 	Kinds uint32 `protobuf:"varint,4,opt,name=kinds,proto3" json:"kinds"`
@@ -330,41 +338,43 @@ func init() {
 }
 
 var fileDescriptor_9027839604ae2915 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8f, 0xd2, 0x40,
-	0x14, 0x67, 0x68, 0x81, 0xf6, 0xc1, 0x26, 0xcd, 0x88, 0xd9, 0x4a, 0x4c, 0x8b, 0x78, 0x69, 0x3c,
-	0x94, 0x04, 0x2f, 0x5e, 0x45, 0x30, 0x61, 0x55, 0x20, 0x8d, 0xf1, 0xb0, 0x17, 0x52, 0xe8, 0x84,
-	0xad, 0x40, 0xdb, 0x30, 0x65, 0xb3, 0xbd, 0xf9, 0x11, 0xfc, 0x18, 0x26, 0x7e, 0x09, 0x8f, 0x7b,
-	0xdc, 0xa3, 0x27, 0x62, 0xe0, 0xc6, 0xa7, 0x30, 0xd3, 0x19, 0x96, 0xd6, 0x6c, 0xb2, 0x5e, 0x3a,
-	0xf3, 0xde, 0xfc, 0xde, 0xbf, 0xfe, 0x7e, 0x0f, 0x5e, 0x2e, 0xdd, 0x6b, 0x37, 0x20, 0x71, 0x9b,
-	0x9d, 0xed, 0x68, 0x1d, 0x7e, 0x25, 0xb3, 0x98, 0x1e, 0x2f, 0x76, 0xb4, 0x0e, 0xe3, 0x10, 0x3f,
-	0x15, 0x20, 0x9b, 0x9d, 0xf6, 0x11, 0xd4, 0xa8, 0xcf, 0xc3, 0x79, 0x98, 0x22, 0xda, 0xec, 0xc6,
-	0xc1, 0x0d, 0x33, 0x9f, 0x71, 0xe9, 0x06, 0xb4, 0x1d, 0x85, 0x4b, 0x7f, 0x96, 0x70, 0x40, 0xeb,
-	0xa7, 0x04, 0x95, 0x31, 0xcf, 0x81, 0xeb, 0x50, 0xf2, 0x03, 0x8f, 0xdc, 0xe8, 0xa8, 0x89, 0x2c,
-	0xd5, 0xe1, 0x06, 0x6e, 0x41, 0x8d, 0x6e, 0xa6, 0x74, 0xb6, 0xf6, 0xa3, 0xd8, 0x0f, 0x03, 0xbd,
-	0x98, 0x3e, 0xe6, 0x7c, 0x58, 0x87, 0x0a, 0x09, 0xdc, 0xe9, 0x92, 0x78, 0xba, 0xdc, 0x44, 0x96,
-	0xe2, 0x1c, 0x4d, 0x7c, 0x09, 0x35, 0xd1, 0xe2, 0x64, 0x41, 0x12, 0xaa, 0x97, 0x9a, 0x92, 0x55,
-	0xed, 0xbc, 0xb0, 0x1f, 0x1c, 0xc2, 0x16, 0x9d, 0x7c, 0x20, 0x49, 0xb7, 0x7e, 0xbb, 0x35, 0x0b,
-	0x87, 0xad, 0x99, 0x0b, 0x77, 0xaa, 0xd1, 0x3d, 0x82, 0xe2, 0x11, 0xd4, 0x5c, 0x6f, 0xe5, 0x07,
-	0x13, 0x3e, 0x91, 0x5e, 0x6e, 0x22, 0xab, 0xda, 0x69, 0xfc, 0x93, 0x9b, 0xcd, 0x6c, 0x8f, 0x53,
-	0x44, 0x57, 0x63, 0x09, 0xb3, 0x31, 0x4e, 0x35, 0xb5, 0xf8, 0x33, 0x3e, 0x87, 0xca, 0x86, 0x12,
-	0x6f, 0x32, 0xdb, 0xe8, 0x95, 0x26, 0xb2, 0x64, 0xa7, 0xcc, 0xcc, 0x77, 0x1b, 0xec, 0xc1, 0x93,
-	0xec, 0xbc, 0xc7, 0x82, 0xca, 0xa3, 0x05, 0xcf, 0x0f, 0x5b, 0xf3, 0xa1, 0x50, 0x07, 0x67, 0x9d,
-	0xa2, 0x7c, 0x03, 0x14, 0x1a, 0xb8, 0x11, 0xbd, 0x0a, 0x63, 0x5d, 0x4d, 0xeb, 0xdf, 0xdb, 0x17,
-	0xb2, 0x22, 0x69, 0x72, 0xeb, 0x1b, 0x02, 0x38, 0xfd, 0x23, 0xfc, 0x0c, 0xa4, 0x05, 0x49, 0x38,
-	0x5d, 0xdd, 0xca, 0x61, 0x6b, 0x32, 0xd3, 0x61, 0x1f, 0x6c, 0x42, 0x69, 0xe1, 0x07, 0x1e, 0x4d,
-	0xf9, 0x38, 0xeb, 0xaa, 0x87, 0xad, 0xc9, 0x1d, 0x0e, 0x3f, 0x5a, 0xaf, 0x40, 0xfe, 0x9c, 0x44,
-	0x04, 0x2b, 0x20, 0x0f, 0x47, 0xc3, 0xbe, 0x56, 0xc0, 0x2a, 0x94, 0xde, 0xf6, 0x3e, 0x0d, 0x86,
-	0x1a, 0xc2, 0x67, 0xa0, 0xf6, 0xfa, 0x5f, 0xfa, 0x1f, 0x47, 0xe3, 0xbe, 0xa3, 0x15, 0x2f, 0x64,
-	0xa5, 0xa8, 0x49, 0xa2, 0x85, 0x37, 0x80, 0xc7, 0x4c, 0x39, 0x3d, 0x72, 0x4d, 0x96, 0x61, 0x44,
-	0xd6, 0x3d, 0x37, 0x76, 0xf1, 0x73, 0x50, 0x05, 0x33, 0x83, 0x9e, 0x90, 0xcf, 0xc9, 0xc1, 0xe3,
-	0x5b, 0xbf, 0x10, 0x54, 0x45, 0xf3, 0x69, 0x0c, 0x06, 0x39, 0x70, 0x57, 0x44, 0xc0, 0xd3, 0x7b,
-	0x56, 0x48, 0x52, 0x5e, 0x48, 0x03, 0xc8, 0x72, 0xaf, 0xcb, 0xff, 0xab, 0x23, 0x99, 0xe9, 0x28,
-	0xaf, 0x9b, 0x0e, 0x94, 0x05, 0x81, 0xa5, 0xc7, 0x08, 0x74, 0x04, 0x92, 0x8f, 0xd0, 0x7d, 0xff,
-	0x63, 0x67, 0xa0, 0xdb, 0x9d, 0x81, 0xee, 0x76, 0x06, 0xfa, 0xb3, 0x33, 0xd0, 0xf7, 0xbd, 0x51,
-	0xb8, 0xdb, 0x1b, 0x85, 0xdf, 0x7b, 0xa3, 0x70, 0x69, 0xcd, 0xfd, 0xf8, 0x6a, 0x33, 0xb5, 0x67,
-	0xe1, 0xaa, 0x9d, 0xdb, 0xbb, 0x9b, 0xd3, 0x2e, 0xc7, 0x49, 0x44, 0xe8, 0xb4, 0x9c, 0x2e, 0xdf,
-	0xeb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0xd2, 0x34, 0xbb, 0xf1, 0x03, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8f, 0x9a, 0x40,
+	0x14, 0x77, 0x04, 0x15, 0x9e, 0x9a, 0x90, 0xa9, 0xcd, 0x52, 0xd3, 0x80, 0xb5, 0x17, 0xb2, 0x07,
+	0x4c, 0xec, 0xa5, 0xd7, 0x52, 0x6d, 0xe2, 0xb6, 0x55, 0x43, 0x9a, 0x1e, 0xf6, 0x62, 0x10, 0x26,
+	0x2e, 0x55, 0x81, 0x08, 0x6c, 0x96, 0x5b, 0x3f, 0x42, 0x3f, 0x46, 0x3f, 0x46, 0x8f, 0x7b, 0xdc,
+	0x5b, 0x7b, 0x22, 0x8d, 0xde, 0xfc, 0x14, 0x0d, 0xcc, 0xb8, 0x4a, 0xb3, 0xc9, 0xee, 0x85, 0x79,
+	0xbf, 0x37, 0xbf, 0xf7, 0x8f, 0xf7, 0x1b, 0x78, 0xbd, 0xb2, 0xae, 0x2d, 0x8f, 0x44, 0xbd, 0xec,
+	0xec, 0x05, 0x1b, 0xff, 0x1b, 0xb1, 0xa3, 0xf0, 0x60, 0xe8, 0xc1, 0xc6, 0x8f, 0x7c, 0xfc, 0x9c,
+	0x91, 0xf4, 0xec, 0xd4, 0x0f, 0xa4, 0x76, 0x6b, 0xe1, 0x2f, 0xfc, 0x9c, 0xd1, 0xcb, 0x2c, 0x4a,
+	0x6e, 0xab, 0xc5, 0x8c, 0x2b, 0xcb, 0x0b, 0x7b, 0x81, 0xbf, 0x72, 0xed, 0x84, 0x12, 0xba, 0xbf,
+	0x39, 0xa8, 0x4d, 0x69, 0x0e, 0xdc, 0x82, 0x8a, 0xeb, 0x39, 0xe4, 0x46, 0x46, 0x1d, 0xa4, 0x89,
+	0x26, 0x05, 0xb8, 0x0b, 0x8d, 0x30, 0x9e, 0x87, 0xf6, 0xc6, 0x0d, 0x22, 0xd7, 0xf7, 0xe4, 0x72,
+	0x7e, 0x59, 0xf0, 0x61, 0x19, 0x6a, 0xc4, 0xb3, 0xe6, 0x2b, 0xe2, 0xc8, 0x7c, 0x07, 0x69, 0x82,
+	0x79, 0x80, 0xf8, 0x12, 0x1a, 0xac, 0xc5, 0xd9, 0x92, 0x24, 0xa1, 0x5c, 0xe9, 0x70, 0x5a, 0xbd,
+	0xff, 0x4a, 0x7f, 0x70, 0x08, 0x9d, 0x75, 0xf2, 0x91, 0x24, 0x46, 0xeb, 0x36, 0x55, 0x4b, 0xfb,
+	0x54, 0x2d, 0x84, 0x9b, 0xf5, 0xe0, 0x9e, 0x11, 0xe2, 0x09, 0x34, 0x2c, 0x67, 0xed, 0x7a, 0x33,
+	0x3a, 0x91, 0x5c, 0xed, 0x20, 0xad, 0xde, 0x6f, 0xff, 0x97, 0x3b, 0x9b, 0x59, 0x9f, 0xe6, 0x0c,
+	0x43, 0xca, 0x12, 0x9e, 0xc6, 0x98, 0xf5, 0x1c, 0xd1, 0x6b, 0x7c, 0x06, 0xb5, 0x38, 0x24, 0xce,
+	0xcc, 0x8e, 0xe5, 0x5a, 0x07, 0x69, 0xbc, 0x59, 0xcd, 0xe0, 0xfb, 0x18, 0x3b, 0xf0, 0xec, 0x74,
+	0xde, 0x43, 0x41, 0xe1, 0xd1, 0x82, 0x67, 0xfb, 0x54, 0x7d, 0x28, 0xd4, 0xc4, 0xa7, 0x4e, 0x56,
+	0xbe, 0x0d, 0x42, 0xe8, 0x59, 0x41, 0x78, 0xe5, 0x47, 0xb2, 0x98, 0xd7, 0xbf, 0xc7, 0xf8, 0x1c,
+	0x44, 0x3b, 0x9e, 0xcd, 0x63, 0x67, 0x41, 0x22, 0x19, 0xb2, 0x4b, 0xa3, 0xb9, 0x4f, 0xd5, 0xa3,
+	0xd3, 0x14, 0xec, 0xd8, 0xc8, 0xad, 0x0b, 0x5e, 0xe0, 0x24, 0xbe, 0xfb, 0x1d, 0x01, 0x1c, 0xff,
+	0x27, 0x7e, 0x01, 0xdc, 0x92, 0x24, 0x74, 0xb5, 0x46, 0x6d, 0x9f, 0xaa, 0x19, 0x34, 0xb3, 0x0f,
+	0x56, 0xa1, 0xb2, 0x74, 0x3d, 0x27, 0xcc, 0x77, 0xd7, 0x34, 0xc4, 0x7d, 0xaa, 0x52, 0x87, 0x49,
+	0x8f, 0xee, 0x39, 0xf0, 0x5f, 0x92, 0x80, 0x60, 0x01, 0xf8, 0xf1, 0x64, 0x3c, 0x94, 0x4a, 0x58,
+	0x84, 0xca, 0xbb, 0xc1, 0xe7, 0xd1, 0x58, 0x42, 0xb8, 0x09, 0xe2, 0x60, 0xf8, 0x75, 0xf8, 0x69,
+	0x32, 0x1d, 0x9a, 0x52, 0xf9, 0x82, 0x17, 0xca, 0x12, 0xc7, 0x5a, 0x78, 0x0b, 0x78, 0x9a, 0xa9,
+	0x6c, 0x40, 0xae, 0xc9, 0xca, 0x0f, 0xc8, 0x66, 0x60, 0x45, 0x16, 0x7e, 0x09, 0x22, 0xdb, 0xe2,
+	0x68, 0xc0, 0xa4, 0x76, 0x74, 0xd0, 0xf8, 0xee, 0x2f, 0x04, 0x75, 0xd6, 0x7c, 0x1e, 0x83, 0x81,
+	0xf7, 0xac, 0x35, 0x61, 0xf4, 0xdc, 0x3e, 0x15, 0x1d, 0x57, 0x14, 0xdd, 0x08, 0x4e, 0x75, 0x22,
+	0xf3, 0x4f, 0xd5, 0x1c, 0x9f, 0x69, 0xae, 0xa8, 0xb1, 0x3e, 0x54, 0xd9, 0xb2, 0x2b, 0x8f, 0x2d,
+	0xdb, 0x64, 0x4c, 0x3a, 0x82, 0xf1, 0xe1, 0xe7, 0x56, 0x41, 0xb7, 0x5b, 0x05, 0xdd, 0x6d, 0x15,
+	0xf4, 0x77, 0xab, 0xa0, 0x1f, 0x3b, 0xa5, 0x74, 0xb7, 0x53, 0x4a, 0x7f, 0x76, 0x4a, 0xe9, 0x52,
+	0x5b, 0xb8, 0xd1, 0x55, 0x3c, 0xd7, 0x6d, 0x7f, 0xdd, 0x2b, 0xbc, 0xd1, 0x9b, 0xe3, 0xbb, 0x8f,
+	0x92, 0x80, 0x84, 0xf3, 0x6a, 0xfe, 0x50, 0xdf, 0xfc, 0x1b, 0x00, 0x08, 0xaa, 0x68, 0xca, 0x1d,
+	0x04, 0x00, 0x00,
 }
 
 func (this *Project) Equal(that interface{}) bool {
@@ -415,6 +425,9 @@ func (this *Project) Equal(that interface{}) bool {
 	if this.Snapshot != that1.Snapshot {
 		return false
 	}
+	if this.CuBudget != that1.CuBudget {
+		return false
+	}
 	return true
 }
 func (this *ProjectKey) Equal(that interface{}) bool {
@@ -526,6 +539,11 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CuBudget != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.CuBudget))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Snapshot != 0 {
 		i = encodeVarintProject(dAtA, i, uint64(m.Snapshot))
 		i--
@@ -780,6 +798,9 @@ func (m *Project) Size() (n int) {
 	if m.Snapshot != 0 {
 		n += 1 + sovProject(uint64(m.Snapshot))
 	}
+	if m.CuBudget != 0 {
+		n += 1 + sovProject(uint64(m.CuBudget))
+	}
 	return n
 }
 
@@ -1101,6 +1122,25 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuBudget", wireType)
+			}
+			m.CuBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
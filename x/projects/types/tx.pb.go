@@ -413,6 +413,198 @@ func (m *MsgSetSubscriptionPolicyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSubscriptionPolicyResponse proto.InternalMessageInfo
 
+type MsgSetProjectEnabled struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetProjectEnabled) Reset()         { *m = MsgSetProjectEnabled{} }
+func (m *MsgSetProjectEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectEnabled) ProtoMessage()    {}
+func (*MsgSetProjectEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f8e20515314f9d, []int{8}
+}
+func (m *MsgSetProjectEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectEnabled.Merge(m, src)
+}
+func (m *MsgSetProjectEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectEnabled proto.InternalMessageInfo
+
+func (m *MsgSetProjectEnabled) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetProjectEnabled) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgSetProjectEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetProjectEnabledResponse struct {
+}
+
+func (m *MsgSetProjectEnabledResponse) Reset()         { *m = MsgSetProjectEnabledResponse{} }
+func (m *MsgSetProjectEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectEnabledResponse) ProtoMessage()    {}
+func (*MsgSetProjectEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f8e20515314f9d, []int{9}
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectEnabledResponse proto.InternalMessageInfo
+
+type MsgSetProjectCuBudget struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project  string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	CuBudget uint64 `protobuf:"varint,3,opt,name=cu_budget,json=cuBudget,proto3" json:"cu_budget,omitempty"`
+}
+
+func (m *MsgSetProjectCuBudget) Reset()         { *m = MsgSetProjectCuBudget{} }
+func (m *MsgSetProjectCuBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectCuBudget) ProtoMessage()    {}
+func (*MsgSetProjectCuBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f8e20515314f9d, []int{10}
+}
+func (m *MsgSetProjectCuBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectCuBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectCuBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectCuBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectCuBudget.Merge(m, src)
+}
+func (m *MsgSetProjectCuBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectCuBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectCuBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectCuBudget proto.InternalMessageInfo
+
+func (m *MsgSetProjectCuBudget) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetProjectCuBudget) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgSetProjectCuBudget) GetCuBudget() uint64 {
+	if m != nil {
+		return m.CuBudget
+	}
+	return 0
+}
+
+type MsgSetProjectCuBudgetResponse struct {
+}
+
+func (m *MsgSetProjectCuBudgetResponse) Reset()         { *m = MsgSetProjectCuBudgetResponse{} }
+func (m *MsgSetProjectCuBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectCuBudgetResponse) ProtoMessage()    {}
+func (*MsgSetProjectCuBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f8e20515314f9d, []int{11}
+}
+func (m *MsgSetProjectCuBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectCuBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectCuBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectCuBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectCuBudgetResponse.Merge(m, src)
+}
+func (m *MsgSetProjectCuBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectCuBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectCuBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectCuBudgetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddKeys)(nil), "lavanet.lava.projects.MsgAddKeys")
 	proto.RegisterType((*MsgAddKeysResponse)(nil), "lavanet.lava.projects.MsgAddKeysResponse")
@@ -422,40 +614,50 @@ func init() {
 	proto.RegisterType((*MsgSetPolicyResponse)(nil), "lavanet.lava.projects.MsgSetPolicyResponse")
 	proto.RegisterType((*MsgSetSubscriptionPolicy)(nil), "lavanet.lava.projects.MsgSetSubscriptionPolicy")
 	proto.RegisterType((*MsgSetSubscriptionPolicyResponse)(nil), "lavanet.lava.projects.MsgSetSubscriptionPolicyResponse")
+	proto.RegisterType((*MsgSetProjectEnabled)(nil), "lavanet.lava.projects.MsgSetProjectEnabled")
+	proto.RegisterType((*MsgSetProjectEnabledResponse)(nil), "lavanet.lava.projects.MsgSetProjectEnabledResponse")
+	proto.RegisterType((*MsgSetProjectCuBudget)(nil), "lavanet.lava.projects.MsgSetProjectCuBudget")
+	proto.RegisterType((*MsgSetProjectCuBudgetResponse)(nil), "lavanet.lava.projects.MsgSetProjectCuBudgetResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/projects/tx.proto", fileDescriptor_a4f8e20515314f9d) }
 
 var fileDescriptor_a4f8e20515314f9d = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xbf, 0x0b, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x8d, 0xb4, 0xf6, 0xb5, 0x53, 0x68, 0x25, 0x64, 0x48, 0x63, 0xba, 0x44, 0x84,
-	0x04, 0xe2, 0xe0, 0x6c, 0x71, 0xb2, 0x14, 0x4a, 0x3a, 0x08, 0x82, 0x48, 0x9a, 0x1e, 0x31, 0x1a,
-	0x73, 0x21, 0x77, 0x2d, 0xcd, 0x28, 0x38, 0x3a, 0x38, 0xfb, 0x17, 0x75, 0xec, 0xe8, 0x24, 0xd2,
-	0xfe, 0x23, 0x92, 0xe6, 0x2e, 0xfd, 0x61, 0x7f, 0x68, 0x27, 0xa7, 0xbc, 0xbb, 0xf7, 0x7d, 0xef,
-	0x3e, 0xb9, 0xef, 0xf1, 0x40, 0x8f, 0xfd, 0x85, 0x9f, 0x60, 0xe6, 0x14, 0x5f, 0x27, 0xcd, 0xc8,
-	0x07, 0x1c, 0x30, 0xea, 0xb0, 0xa5, 0x9d, 0x66, 0x84, 0x11, 0xa5, 0xcb, 0xf3, 0x76, 0xf1, 0xb5,
-	0x45, 0x5e, 0xeb, 0x9f, 0x2f, 0xe3, 0x41, 0x59, 0xab, 0xf5, 0x8e, 0x45, 0xb1, 0x9f, 0x50, 0x27,
-	0x25, 0x71, 0x14, 0xe4, 0x5c, 0xd0, 0x09, 0x49, 0x48, 0x76, 0xa1, 0x53, 0x44, 0xe5, 0xae, 0xf9,
-	0x15, 0x01, 0x8c, 0x68, 0xf8, 0x62, 0x36, 0x1b, 0xe2, 0x9c, 0x2a, 0x2a, 0x34, 0x82, 0x0c, 0xfb,
-	0x8c, 0x64, 0x2a, 0x32, 0x90, 0xd5, 0xf4, 0xc4, 0xb2, 0xc8, 0xf0, 0x03, 0xd5, 0x5a, 0x99, 0xe1,
-	0x4b, 0xe5, 0x15, 0xb4, 0x79, 0xf8, 0xee, 0x23, 0xce, 0xa9, 0x2a, 0x1b, 0xb2, 0xd5, 0x72, 0x1f,
-	0xdb, 0x67, 0x7f, 0xc6, 0x1e, 0x97, 0xc1, 0x10, 0xe7, 0x83, 0x07, 0xab, 0x9f, 0x3d, 0xc9, 0x6b,
-	0xa5, 0xd5, 0x0e, 0x35, 0x3b, 0xa0, 0xec, 0x69, 0x3c, 0x4c, 0x53, 0x92, 0x50, 0x2c, 0x20, 0x5f,
-	0xe2, 0xf8, 0x3f, 0x82, 0xe4, 0x34, 0x15, 0xe4, 0x02, 0xda, 0x23, 0x1a, 0x4e, 0x30, 0x1b, 0xef,
-	0x6e, 0xfd, 0x2e, 0x4a, 0x17, 0xea, 0xa5, 0x67, 0xaa, 0x6c, 0x20, 0xab, 0xe5, 0x6a, 0x27, 0x7c,
-	0x85, 0xab, 0x76, 0xd9, 0xdf, 0xe3, 0x4a, 0xf3, 0x11, 0x74, 0x0e, 0xcf, 0xad, 0x78, 0xbe, 0x20,
-	0x50, 0xcb, 0xc4, 0x64, 0x3e, 0xa5, 0x41, 0x16, 0xa5, 0x2c, 0x22, 0xc9, 0x4d, 0x38, 0x0d, 0x1e,
-	0x8a, 0x6b, 0x50, 0x6b, 0x86, 0x6c, 0x35, 0xbd, 0x6a, 0x7d, 0x17, 0x9e, 0x09, 0xc6, 0x25, 0x0a,
-	0x81, 0xea, 0x7e, 0x97, 0x41, 0x1e, 0xd1, 0x50, 0x79, 0x0d, 0x0d, 0xf1, 0x10, 0x2f, 0x39, 0xb3,
-	0x7f, 0x1d, 0xda, 0x93, 0x9b, 0x12, 0x71, 0x40, 0xd1, 0x58, 0x3c, 0x9e, 0x2b, 0x8d, 0xb9, 0xe4,
-	0x5a, 0xe3, 0x13, 0xd3, 0x95, 0xb7, 0xd0, 0xdc, 0x3b, 0xde, 0xbf, 0x5c, 0x57, 0x89, 0xb4, 0xa7,
-	0x7f, 0x21, 0xaa, 0xda, 0x7f, 0x46, 0xd0, 0x3d, 0x6f, 0xa0, 0x73, 0xb5, 0xcd, 0x9f, 0x05, 0xda,
-	0xf3, 0x7f, 0x2c, 0x10, 0x0c, 0x83, 0xc1, 0x6a, 0xa3, 0xa3, 0xf5, 0x46, 0x47, 0xbf, 0x36, 0x3a,
-	0xfa, 0xb6, 0xd5, 0xa5, 0xf5, 0x56, 0x97, 0x7e, 0x6c, 0x75, 0xe9, 0x8d, 0x15, 0x46, 0xec, 0xfd,
-	0x7c, 0x6a, 0x07, 0xe4, 0x93, 0x73, 0x34, 0x7d, 0x96, 0x07, 0xb3, 0x2d, 0x4f, 0x31, 0x9d, 0xd6,
-	0x77, 0xc3, 0xe6, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xd1, 0x97, 0xef, 0x01, 0x05,
-	0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xa6, 0x34, 0xc9, 0x4b, 0x17, 0xac, 0x04, 0x59, 0x07, 0x38, 0xc6, 0x5d, 0x5c,
+	0x81, 0x6c, 0x29, 0x45, 0x62, 0x26, 0xc0, 0x42, 0x15, 0xa9, 0x72, 0x07, 0x24, 0x24, 0x54, 0xf9,
+	0xc7, 0xc9, 0x0d, 0x18, 0x9f, 0xe5, 0xb3, 0xab, 0x78, 0x44, 0x62, 0x83, 0x81, 0x3f, 0xab, 0x63,
+	0x47, 0x26, 0x84, 0x92, 0x7f, 0x04, 0xd9, 0xbe, 0xbb, 0x34, 0xd4, 0x69, 0x9a, 0x4c, 0x9d, 0xfc,
+	0xce, 0xef, 0xfb, 0xbe, 0xef, 0x73, 0xbf, 0x74, 0xa0, 0x86, 0xce, 0x85, 0x13, 0xe1, 0xd4, 0x2a,
+	0xbe, 0x56, 0x9c, 0x90, 0xcf, 0xd8, 0x4b, 0xa9, 0x95, 0x4e, 0xcd, 0x38, 0x21, 0x29, 0x91, 0xfb,
+	0x2c, 0x6f, 0x16, 0x5f, 0x93, 0xe7, 0xd1, 0x41, 0x7d, 0x19, 0x0b, 0xaa, 0x5a, 0x34, 0x58, 0x16,
+	0x85, 0x4e, 0x44, 0xad, 0x98, 0x84, 0x13, 0x2f, 0x67, 0x82, 0x5e, 0x40, 0x02, 0x52, 0x86, 0x56,
+	0x11, 0x55, 0x7f, 0xf5, 0x9f, 0x12, 0xc0, 0x98, 0x06, 0xaf, 0x7d, 0xff, 0x18, 0xe7, 0x54, 0x56,
+	0xa0, 0xe5, 0x25, 0xd8, 0x49, 0x49, 0xa2, 0x48, 0x9a, 0x64, 0x74, 0x6c, 0x3e, 0x2c, 0x32, 0xac,
+	0xa1, 0xb2, 0x53, 0x65, 0xd8, 0x50, 0x7e, 0x0f, 0xfb, 0x2c, 0x3c, 0xfb, 0x82, 0x73, 0xaa, 0x34,
+	0xb5, 0xa6, 0xd1, 0x1d, 0x3e, 0x33, 0x6b, 0x27, 0x63, 0x9e, 0x54, 0xc1, 0x31, 0xce, 0x47, 0xbb,
+	0x97, 0x7f, 0x06, 0x0d, 0xbb, 0x1b, 0x8b, 0x3f, 0x54, 0xef, 0x81, 0xbc, 0xa0, 0xb1, 0x31, 0x8d,
+	0x49, 0x44, 0x31, 0x87, 0x7c, 0x8b, 0xc3, 0x7b, 0x04, 0xc9, 0x68, 0x04, 0xe4, 0x05, 0xec, 0x8f,
+	0x69, 0x70, 0x8a, 0xd3, 0x93, 0x72, 0xd5, 0xb7, 0xa2, 0x1c, 0xc2, 0x5e, 0xb5, 0x67, 0x4a, 0x53,
+	0x93, 0x8c, 0xee, 0x10, 0xfd, 0xc7, 0x57, 0xec, 0xaa, 0x59, 0xf9, 0xdb, 0x4c, 0xa9, 0x3f, 0x82,
+	0xde, 0xf5, 0xbe, 0x82, 0xe7, 0xbb, 0x04, 0x4a, 0x95, 0x38, 0xcd, 0x5c, 0xea, 0x25, 0x93, 0x38,
+	0x9d, 0x90, 0x68, 0x2d, 0x1c, 0x82, 0x36, 0x5f, 0x06, 0x65, 0x47, 0x6b, 0x1a, 0x1d, 0x5b, 0x8c,
+	0xb7, 0xc2, 0xd3, 0x41, 0x5b, 0x45, 0x21, 0x50, 0x7d, 0x31, 0x85, 0xaa, 0xd3, 0xbb, 0xc8, 0x71,
+	0x43, 0xec, 0x6f, 0xb5, 0x84, 0x0a, 0xb4, 0x70, 0x55, 0x5e, 0x42, 0xb6, 0x6d, 0x3e, 0xd4, 0x55,
+	0x78, 0x52, 0xd7, 0x45, 0x50, 0x9c, 0x43, 0x7f, 0x29, 0xff, 0x26, 0x1b, 0x65, 0x7e, 0x80, 0xd3,
+	0xad, 0x30, 0x1e, 0x43, 0xc7, 0xcb, 0xce, 0xdc, 0xd2, 0xa0, 0x04, 0xd9, 0xb5, 0xdb, 0x1e, 0x33,
+	0xd4, 0x07, 0xf0, 0xb4, 0xb6, 0x13, 0x47, 0x19, 0xfe, 0x78, 0x00, 0xcd, 0x31, 0x0d, 0xe4, 0x0f,
+	0xd0, 0xe2, 0x37, 0x73, 0xd5, 0x51, 0x5d, 0x5c, 0x17, 0x74, 0xb8, 0x56, 0xc2, 0x1b, 0x14, 0xc6,
+	0xfc, 0x36, 0xdd, 0x62, 0xcc, 0x24, 0xe8, 0x70, 0xad, 0x44, 0x18, 0x7f, 0x82, 0xce, 0xe2, 0x0a,
+	0x1c, 0xac, 0xae, 0x13, 0x22, 0xf4, 0xfc, 0x0e, 0x22, 0x61, 0xff, 0x4d, 0x82, 0x7e, 0xfd, 0x89,
+	0xb6, 0x6e, 0xb5, 0xb9, 0x59, 0x80, 0x5e, 0x6d, 0x58, 0x20, 0x18, 0x32, 0x78, 0x78, 0xf3, 0xa8,
+	0xae, 0x99, 0xc5, 0x92, 0x18, 0x1d, 0x6d, 0x20, 0x16, 0x6d, 0xa7, 0x20, 0xd7, 0x9c, 0xcd, 0x17,
+	0x77, 0xb1, 0xe2, 0x6a, 0xf4, 0x72, 0x13, 0x35, 0xef, 0x3c, 0x1a, 0x5d, 0xce, 0x54, 0xe9, 0x6a,
+	0xa6, 0x4a, 0x7f, 0x67, 0xaa, 0xf4, 0x6b, 0xae, 0x36, 0xae, 0xe6, 0x6a, 0xe3, 0xf7, 0x5c, 0x6d,
+	0x7c, 0x34, 0x82, 0x49, 0x7a, 0x9e, 0xb9, 0xa6, 0x47, 0xbe, 0x5a, 0x4b, 0xef, 0xcf, 0xf4, 0xda,
+	0xeb, 0x96, 0xc7, 0x98, 0xba, 0x7b, 0xe5, 0x73, 0x73, 0xf4, 0x6f, 0x00, 0x07, 0x7c, 0x98, 0x4a,
+	0x03, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +676,8 @@ type MsgClient interface {
 	DelKeys(ctx context.Context, in *MsgDelKeys, opts ...grpc.CallOption) (*MsgDelKeysResponse, error)
 	SetPolicy(ctx context.Context, in *MsgSetPolicy, opts ...grpc.CallOption) (*MsgSetPolicyResponse, error)
 	SetSubscriptionPolicy(ctx context.Context, in *MsgSetSubscriptionPolicy, opts ...grpc.CallOption) (*MsgSetSubscriptionPolicyResponse, error)
+	SetProjectEnabled(ctx context.Context, in *MsgSetProjectEnabled, opts ...grpc.CallOption) (*MsgSetProjectEnabledResponse, error)
+	SetProjectCuBudget(ctx context.Context, in *MsgSetProjectCuBudget, opts ...grpc.CallOption) (*MsgSetProjectCuBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -520,12 +724,32 @@ func (c *msgClient) SetSubscriptionPolicy(ctx context.Context, in *MsgSetSubscri
 	return out, nil
 }
 
+func (c *msgClient) SetProjectEnabled(ctx context.Context, in *MsgSetProjectEnabled, opts ...grpc.CallOption) (*MsgSetProjectEnabledResponse, error) {
+	out := new(MsgSetProjectEnabledResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Msg/SetProjectEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProjectCuBudget(ctx context.Context, in *MsgSetProjectCuBudget, opts ...grpc.CallOption) (*MsgSetProjectCuBudgetResponse, error) {
+	out := new(MsgSetProjectCuBudgetResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Msg/SetProjectCuBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddKeys(context.Context, *MsgAddKeys) (*MsgAddKeysResponse, error)
 	DelKeys(context.Context, *MsgDelKeys) (*MsgDelKeysResponse, error)
 	SetPolicy(context.Context, *MsgSetPolicy) (*MsgSetPolicyResponse, error)
 	SetSubscriptionPolicy(context.Context, *MsgSetSubscriptionPolicy) (*MsgSetSubscriptionPolicyResponse, error)
+	SetProjectEnabled(context.Context, *MsgSetProjectEnabled) (*MsgSetProjectEnabledResponse, error)
+	SetProjectCuBudget(context.Context, *MsgSetProjectCuBudget) (*MsgSetProjectCuBudgetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -544,6 +768,12 @@ func (*UnimplementedMsgServer) SetPolicy(ctx context.Context, req *MsgSetPolicy)
 func (*UnimplementedMsgServer) SetSubscriptionPolicy(ctx context.Context, req *MsgSetSubscriptionPolicy) (*MsgSetSubscriptionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionPolicy not implemented")
 }
+func (*UnimplementedMsgServer) SetProjectEnabled(ctx context.Context, req *MsgSetProjectEnabled) (*MsgSetProjectEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectEnabled not implemented")
+}
+func (*UnimplementedMsgServer) SetProjectCuBudget(ctx context.Context, req *MsgSetProjectCuBudget) (*MsgSetProjectCuBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectCuBudget not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -621,6 +851,42 @@ func _Msg_SetSubscriptionPolicy_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetProjectEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProjectEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProjectEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Msg/SetProjectEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProjectEnabled(ctx, req.(*MsgSetProjectEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProjectCuBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProjectCuBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProjectCuBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Msg/SetProjectCuBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProjectCuBudget(ctx, req.(*MsgSetProjectCuBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.projects.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -641,6 +907,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSubscriptionPolicy",
 			Handler:    _Msg_SetSubscriptionPolicy_Handler,
 		},
+		{
+			MethodName: "SetProjectEnabled",
+			Handler:    _Msg_SetProjectEnabled_Handler,
+		},
+		{
+			MethodName: "SetProjectCuBudget",
+			Handler:    _Msg_SetProjectCuBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/projects/tx.proto",
@@ -940,61 +1214,196 @@ func (m *MsgSetSubscriptionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetProjectEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddKeys) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetProjectEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Project)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ProjectKeys) > 0 {
-		for _, e := range m.ProjectKeys {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetProjectEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelKeys) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetProjectCuBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectCuBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectCuBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CuBudget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CuBudget))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Project)
-	if l > 0 {
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProjectCuBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectCuBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectCuBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProjectKeys) > 0 {
+		for _, e := range m.ProjectKeys {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if len(m.ProjectKeys) > 0 {
@@ -1061,29 +1470,285 @@ func (m *MsgSetSubscriptionPolicy) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSubscriptionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProjectEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetProjectEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProjectCuBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CuBudget != 0 {
+		n += 1 + sovTx(uint64(m.CuBudget))
+	}
+	return n
+}
+
+func (m *MsgSetProjectCuBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectKeys = append(m.ProjectKeys, ProjectKey{})
+			if err := m.ProjectKeys[len(m.ProjectKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetSubscriptionPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddKeys) Unmarshal(dAtA []byte) error {
+func (m *MsgDelKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1106,10 +1771,10 @@ func (m *MsgAddKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1231,7 +1896,7 @@ func (m *MsgAddKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1254,10 +1919,10 @@ func (m *MsgAddKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1281,7 +1946,7 @@ func (m *MsgAddKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelKeys) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1304,10 +1969,10 @@ func (m *MsgDelKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1376,7 +2041,7 @@ func (m *MsgDelKeys) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1403,8 +2068,10 @@ func (m *MsgDelKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectKeys = append(m.ProjectKeys, ProjectKey{})
-			if err := m.ProjectKeys[len(m.ProjectKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &types.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1429,7 +2096,7 @@ func (m *MsgDelKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1452,10 +2119,10 @@ func (m *MsgDelKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1479,7 +2146,7 @@ func (m *MsgDelKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,10 +2169,10 @@ func (m *MsgSetPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1542,7 +2209,7 @@ func (m *MsgSetPolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1570,7 +2237,7 @@ func (m *MsgSetPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1629,7 +2296,7 @@ func (m *MsgSetPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1652,10 +2319,10 @@ func (m *MsgSetPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1679,7 +2346,7 @@ func (m *MsgSetPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProjectEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,10 +2369,10 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProjectEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProjectEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1742,7 +2409,7 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1770,13 +2437,133 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetProjectEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetProjectEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetProjectEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetProjectCuBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetProjectCuBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetProjectCuBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1786,28 +2573,75 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types.Policy{}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuBudget", wireType)
+			}
+			m.CuBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1829,7 +2663,7 @@ func (m *MsgSetSubscriptionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProjectCuBudgetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1852,10 +2686,10 @@ func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProjectCuBudgetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSubscriptionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProjectCuBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
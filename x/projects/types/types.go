@@ -19,4 +19,13 @@ const (
 	SetAdminPolicyEventName        = "set_admin_policy_event"
 	SetSubscriptionPolicyEventName = "set_subscription_policy_event"
 	ProjectResetFailEventName      = "project_reset_failed"
+	SetProjectEnabledEventName     = "set_project_enabled_event"
+	SetProjectCuBudgetEventName    = "set_project_cu_budget_event"
+	ProjectCuBudgetWarnEventName   = "project_cu_budget_warning"
+	ProjectCuBudgetUsedEventName   = "project_cu_budget_exhausted"
+)
+
+const (
+	// percentage of the project's CU budget at which a warning event is emitted
+	PROJECT_CU_BUDGET_WARN_PERCENT = 80
 )
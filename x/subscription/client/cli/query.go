@@ -31,6 +31,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdList())
 	cmd.AddCommand(CmdNextToMonthExpiry())
+	cmd.AddCommand(CmdOveruse())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -32,6 +32,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddProject())
 	cmd.AddCommand(CmdDelProject())
 	cmd.AddCommand(CmdAutoRenewal())
+	cmd.AddCommand(CmdOveruseDeposit())
+	cmd.AddCommand(CmdTransferSubscription())
+	cmd.AddCommand(CmdAcceptSubscriptionTransfer())
+	cmd.AddCommand(CmdTopUpSubscription())
+	cmd.AddCommand(CmdCancelSubscription())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
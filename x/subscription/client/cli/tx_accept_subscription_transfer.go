@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAcceptSubscriptionTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-transfer [consumer]",
+		Short: "Accept the transfer of a subscription",
+		Long: `The accept-transfer command allows the new consumer of a subscription transfer request to accept it.
+The subscription, its projects and its credit move to the new consumer at the beginning of the next epoch.`,
+		Example: `required flags: --from <new_consumer>
+		lavad tx subscription accept-transfer <consumer_address> --from <new_consumer>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptSubscriptionTransfer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancelSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [optional: consumer]",
+		Short: "Cancel a subscription and refund its unused credit",
+		Long: `The cancel command allows the subscription consumer or creator to cancel the subscription.
+The providers are paid for the CU used in the current month, and the rest of the credit (the unused months
+and the unspent share of the current month) is refunded to the subscription creator. An advance purchase is
+refunded to its buyer. The subscription and its projects are removed at the beginning of the next epoch.
+The consumer is the subscription's consumer (default: the creator).`,
+		Example: `required flags: --from <subscription_consumer/creator>
+		lavad tx subscription cancel --from <subscription_consumer>
+		lavad tx subscription cancel <consumer_address> --from <subscription_creator>`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			consumer := creator
+			if len(args) > 0 {
+				consumer = args[0]
+			}
+
+			msg := types.NewMsgCancelSubscription(
+				creator,
+				consumer,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdOveruseDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "overuse-deposit [amount] [optional: consumer]",
+		Short: "Deposit funds for the CU overuse of a subscription",
+		Long: `The overuse-deposit command allows the subscription creator to pre-fund the CU overuse of a subscription.
+When the subscription's plan allows overuse, CU used beyond the monthly allowance are charged at the plan's overuse rate,
+from the deposit first and then from the creator's account. The remaining deposit is returned to the creator when the subscription expires.
+The consumer is the subscription's consumer (default: the creator).`,
+		Example: `required flags: --from <creator-address>
+		lavad tx subscription overuse-deposit 1000000ulava --from <creator_address>
+		lavad tx subscription overuse-deposit 1000000ulava <consumer_address> --from <creator_address>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			consumer := creator
+			if len(args) > 1 {
+				consumer = args[1]
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOveruseDeposit(
+				creator,
+				consumer,
+				amount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commontypes "github.com/lavanet/lava/common/types"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdTopUpSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up [consumer] [duration] [optional: credit]",
+		Short: "Extend the duration and/or add credit to a subscription",
+		Long: `The top-up command allows any account to fund an existing subscription. The duration (in months)
+extends the subscription at its current plan's price, and the credit is added to the subscription's credit
+that pays its providers. The creator is charged for both.`,
+		Example: `required flags: --from <creator-address>
+		lavad tx subscription top-up <consumer_address> 3 --from <creator_address>
+		lavad tx subscription top-up <consumer_address> 0 1000000ulava --from <creator_address>`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			credit := sdk.NewInt64Coin(commontypes.TokenDenom, 0)
+			if len(args) > 2 {
+				credit, err = sdk.ParseCoinNormalized(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgTopUpSubscription(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				duration,
+				credit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdTransferSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [new-consumer]",
+		Short: "Request to transfer a subscription to a new consumer",
+		Long: `The transfer command allows the subscription consumer to request to transfer its subscription
+(with its projects and credit) to a new consumer address. The transfer takes place once the new consumer
+accepts it (using the accept-transfer command). A new request replaces an older one.`,
+		Example: `required flags: --from <subscription_consumer>
+		lavad tx subscription transfer <new_consumer_address> --from <subscription_consumer>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferSubscription(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,6 +16,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.InitCuTrackers(ctx, genState.CuTrackerFS)
 	k.InitCuTrackerTimers(ctx, genState.CuTrackerTS)
 	k.SetAllAdjustment(ctx, genState.Adjustments)
+	k.SetAllOveruse(ctx, genState.Overuses)
+	k.SetAllOveruseDeposit(ctx, genState.OveruseDeposits)
+	k.SetAllSubscriptionTransfer(ctx, genState.PendingTransfers)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -27,6 +30,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CuTrackerFS = k.ExportCuTrackers(ctx)
 	genesis.CuTrackerTS = k.ExportCuTrackerTimers(ctx)
 	genesis.Adjustments = k.GetAllAdjustment(ctx)
+	genesis.Overuses = k.GetAllOveruse(ctx)
+	genesis.OveruseDeposits = k.GetAllOveruseDeposit(ctx)
+	genesis.PendingTransfers = k.GetAllSubscriptionTransfer(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -13,7 +13,10 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-
+		PendingTransfers: []types.SubscriptionTransfer{
+			{Consumer: "0", NewConsumer: "1", Block: 10},
+			{Consumer: "2", NewConsumer: "3", Block: 20},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,5 +28,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.PendingTransfers, got.PendingTransfers)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
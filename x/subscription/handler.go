@@ -30,6 +30,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgAutoRenewal:
 			res, err := msgServer.AutoRenewal(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOveruseDeposit:
+			res, err := msgServer.OveruseDeposit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferSubscription:
+			res, err := msgServer.TransferSubscription(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptSubscriptionTransfer:
+			res, err := msgServer.AcceptSubscriptionTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTopUpSubscription:
+			res, err := msgServer.TopUpSubscription(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelSubscription:
+			res, err := msgServer.CancelSubscription(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
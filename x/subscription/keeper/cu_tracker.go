@@ -129,11 +129,24 @@ func (k Keeper) RewardAndResetCuTracker(ctx sdk.Context, cuTrackerTimerKeyBytes
 	if !timerData.Validate() {
 		return
 	}
+
 	trackedCuList, totalCuTracked := k.GetSubTrackedCuInfo(ctx, sub, timerData.Block)
 
+	// the month of a cancelled subscription (or of a plan change) rewards its providers only with
+	// the share of the month's credit that matches the CU they claimed
+	rewardCredit := timerData.Credit.Amount
+	if timerData.MonthCuTotal > 0 && totalCuTracked < timerData.MonthCuTotal {
+		rewardCredit = rewardCredit.Mul(sdk.NewIntFromUint64(totalCuTracked)).Quo(sdk.NewIntFromUint64(timerData.MonthCuTotal))
+	}
+
+	// the funds charged for the month's overuse are paid to the providers with the month's credit
+	overuseCredit := k.takeOveruseCredit(ctx, sub, timerData.Block)
+	timerData.Credit = timerData.Credit.AddAmount(overuseCredit)
+	rewardCredit = rewardCredit.Add(overuseCredit)
+
 	if len(trackedCuList) == 0 || totalCuTracked == 0 {
 		// no tracked CU for this sub, return the credit to the sub
-		k.returnCreditToSub(ctx, sub, timerData.Credit.Amount)
+		k.returnTimerCredit(ctx, sub, timerData, timerData.Credit.Amount)
 		return
 	}
 
@@ -141,7 +154,7 @@ func (k Keeper) RewardAndResetCuTracker(ctx sdk.Context, cuTrackerTimerKeyBytes
 	// So, even if the plan changed during the month, we still take the original plan, based on the given block.
 	block := trackedCuList[0].block
 
-	totalTokenAmount := timerData.Credit.Amount
+	totalTokenAmount := rewardCredit
 	if totalTokenAmount.Quo(sdk.NewIntFromUint64(totalCuTracked)).GT(sdk.NewIntFromUint64(LIMIT_TOKEN_PER_CU)) {
 		totalTokenAmount = sdk.NewIntFromUint64(LIMIT_TOKEN_PER_CU * totalCuTracked)
 	}
@@ -239,7 +252,7 @@ func (k Keeper) RewardAndResetCuTracker(ctx sdk.Context, cuTrackerTimerKeyBytes
 
 	updatedCredit := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
 	if timerData.Credit.Amount.GT(totalTokenRewarded) {
-		updatedCredit = k.returnCreditToSub(ctx, sub, timerData.Credit.Amount.Sub(totalTokenRewarded))
+		updatedCredit = k.returnTimerCredit(ctx, sub, timerData, timerData.Credit.Amount.Sub(totalTokenRewarded))
 	}
 
 	utils.LogLavaEvent(ctx, k.Logger(ctx), types.RemainingCreditEventName, map[string]string{
@@ -258,6 +271,37 @@ func (k Keeper) CalcTotalMonthlyReward(ctx sdk.Context, totalAmount math.Int, tr
 	return totalMonthlyReward
 }
 
+// returnTimerCredit returns the credit left in a CU tracker timer to the subscription, or refunds it
+// to the timer's refund address (if set)
+func (k Keeper) returnTimerCredit(ctx sdk.Context, sub string, timerData types.CuTrackerTimerData, credit math.Int) sdk.Coin {
+	if timerData.RefundAddress == "" {
+		return k.returnCreditToSub(ctx, sub, credit)
+	}
+
+	refund := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), credit)
+	refundAcct, err := sdk.AccAddressFromBech32(timerData.RefundAddress)
+	if err == nil {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAcct, sdk.NewCoins(refund))
+	}
+	if err != nil {
+		utils.LavaFormatError("failed refunding the unused credit of the month", err,
+			utils.Attribute{Key: "sub", Value: sub},
+			utils.Attribute{Key: "refund_address", Value: timerData.RefundAddress},
+			utils.Attribute{Key: "refund", Value: refund.String()},
+		)
+		return k.returnCreditToSub(ctx, sub, credit)
+	}
+
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.RefundCreditEventName, map[string]string{
+		"sub":            sub,
+		"refund_address": timerData.RefundAddress,
+		"refund":         refund.String(),
+		"block":          strconv.FormatInt(ctx.BlockHeight(), 10),
+	}, "unused credit of the month refunded")
+
+	return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+}
+
 func (k Keeper) returnCreditToSub(ctx sdk.Context, sub string, credit math.Int) sdk.Coin {
 	var latestSub types.Subscription
 	latestEntryBlock, _, _, found := k.subsFS.FindEntryDetailed(ctx, sub, uint64(ctx.BlockHeight()), &latestSub)
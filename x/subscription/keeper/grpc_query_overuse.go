@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Overuse(goCtx context.Context, req *types.QueryOveruseRequest) (*types.QueryOveruseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sub, found := k.GetSubscription(ctx, req.Consumer)
+	if !found {
+		return nil, fmt.Errorf("could not find subscription with address %s", req.Consumer)
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return nil, fmt.Errorf("could not find plan %s of subscription %s", sub.PlanIndex, req.Consumer)
+	}
+
+	denom := k.stakingKeeper.BondDenom(ctx)
+	res := types.QueryOveruseResponse{
+		AllowOveruse:        plan.AllowOveruse,
+		OveruseRate:         plan.OveruseRate,
+		OveruseCuLimit:      plan.OveruseCuLimit,
+		MonthOveruseCharged: sdk.NewInt64Coin(denom, 0),
+		Deposit:             sdk.NewInt64Coin(denom, 0),
+	}
+
+	overuse, found := k.GetOveruse(ctx, sub.Consumer, sub.Block)
+	if found {
+		res.MonthOveruseCu = overuse.Cu
+		res.MonthOveruseCharged = overuse.Charged
+	}
+
+	deposit, found := k.GetOveruseDeposit(ctx, sub.Consumer)
+	if found {
+		res.Deposit = deposit.Amount
+	}
+
+	res.OveruseCuLeft = k.overuseCuLeft(ctx, sub, plan, overuse)
+
+	return &res, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) AcceptSubscriptionTransfer(goCtx context.Context, msg *types.MsgAcceptSubscriptionTransfer) (*types.MsgAcceptSubscriptionTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	err := k.Keeper.AcceptSubscriptionTransfer(ctx, msg.Creator, msg.Consumer)
+	return &types.MsgAcceptSubscriptionTransferResponse{}, err
+}
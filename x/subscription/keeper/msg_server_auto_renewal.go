@@ -85,6 +85,11 @@ func (k msgServer) AutoRenewal(goCtx context.Context, msg *types.MsgAutoRenewal)
 	prevCreator := sub.Creator
 	prevAutoRenewalNextPlan := sub.AutoRenewalNextPlan
 
+	// the overuse deposit belongs to the previous creator
+	if prevCreator != msg.Creator {
+		k.refundOveruseDeposit(ctx, msg.Consumer, prevCreator)
+	}
+
 	sub.Creator = msg.Creator
 	sub.AutoRenewalNextPlan = msg.Index
 	err := k.subsFS.AppendEntry(ctx, msg.Consumer, sub.Block, &sub)
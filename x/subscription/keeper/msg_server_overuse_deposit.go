@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) OveruseDeposit(goCtx context.Context, msg *types.MsgOveruseDeposit) (*types.MsgOveruseDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	err := k.Keeper.DepositOveruse(ctx, msg.Creator, msg.Consumer, msg.Amount)
+	return &types.MsgOveruseDepositResponse{}, err
+}
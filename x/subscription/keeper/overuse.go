@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// SetOveruse set a specific Overuse in the store from its consumer and block
+func (k Keeper) SetOveruse(ctx sdk.Context, overuse types.Overuse) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseKeyPrefix))
+	b := k.cdc.MustMarshal(&overuse)
+	store.Set(types.OveruseKey(overuse.Consumer, overuse.Block), b)
+}
+
+// GetOveruse returns the Overuse of a subscription in the month that started in the sub block
+func (k Keeper) GetOveruse(ctx sdk.Context, consumer string, block uint64) (val types.Overuse, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseKeyPrefix))
+	b := store.Get(types.OveruseKey(consumer, block))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOveruse removes an Overuse from the store
+func (k Keeper) RemoveOveruse(ctx sdk.Context, consumer string, block uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseKeyPrefix))
+	store.Delete(types.OveruseKey(consumer, block))
+}
+
+// GetAllOveruse returns all Overuse
+func (k Keeper) GetAllOveruse(ctx sdk.Context) (list []types.Overuse) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Overuse
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAllOveruse sets all overuses to the store
+func (k Keeper) SetAllOveruse(ctx sdk.Context, list []types.Overuse) {
+	for _, o := range list {
+		k.SetOveruse(ctx, o)
+	}
+}
+
+// SetOveruseDeposit set a specific OveruseDeposit in the store from its consumer
+func (k Keeper) SetOveruseDeposit(ctx sdk.Context, deposit types.OveruseDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseDepositKeyPrefix))
+	b := k.cdc.MustMarshal(&deposit)
+	store.Set([]byte(deposit.Consumer), b)
+}
+
+// GetOveruseDeposit returns the OveruseDeposit of a subscription
+func (k Keeper) GetOveruseDeposit(ctx sdk.Context, consumer string) (val types.OveruseDeposit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseDepositKeyPrefix))
+	b := store.Get([]byte(consumer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOveruseDeposit removes an OveruseDeposit from the store
+func (k Keeper) RemoveOveruseDeposit(ctx sdk.Context, consumer string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseDepositKeyPrefix))
+	store.Delete([]byte(consumer))
+}
+
+// GetAllOveruseDeposit returns all OveruseDeposit
+func (k Keeper) GetAllOveruseDeposit(ctx sdk.Context) (list []types.OveruseDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OveruseDepositKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OveruseDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAllOveruseDeposit sets all overuse deposits to the store
+func (k Keeper) SetAllOveruseDeposit(ctx sdk.Context, list []types.OveruseDeposit) {
+	for _, d := range list {
+		k.SetOveruseDeposit(ctx, d)
+	}
+}
+
+// GetOveruseCu returns the CU the subscription overused in its current month and the CU it can
+// still overuse. The latter is limited by the plan's overuse cap and by the overuse deposit
+func (k Keeper) GetOveruseCu(ctx sdk.Context, sub types.Subscription) (overusedCu uint64, overuseCuLeft uint64) {
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return 0, 0
+	}
+	overuse, _ := k.GetOveruse(ctx, sub.Consumer, sub.Block)
+	return overuse.Cu, k.overuseCuLeft(ctx, sub, plan, overuse)
+}
+
+func (k Keeper) overuseCuLeft(ctx sdk.Context, sub types.Subscription, plan planstypes.Plan, overuse types.Overuse) uint64 {
+	if !plan.AllowOveruse || plan.OveruseRate == 0 {
+		return 0
+	}
+
+	cuLeft := uint64(math.MaxUint64)
+	if plan.OveruseCuLimit != 0 {
+		if overuse.Cu >= plan.OveruseCuLimit {
+			return 0
+		}
+		cuLeft = plan.OveruseCuLimit - overuse.Cu
+	}
+
+	funds := k.availableOveruseFunds(ctx, sub)
+	fundsCu := funds.QuoRaw(int64(plan.OveruseRate))
+	if fundsCu.IsUint64() && fundsCu.Uint64() < cuLeft {
+		cuLeft = fundsCu.Uint64()
+	}
+	// the CU left is added to the month's CU allowance, keep the sum from overflowing
+	if cuLeft > math.MaxUint64-sub.MonthCuTotal {
+		cuLeft = math.MaxUint64 - sub.MonthCuTotal
+	}
+	return cuLeft
+}
+
+// availableOveruseFunds returns the funds overuse can be charged from. Only the overuse deposit is
+// used, so the creator's spending on overuse is bounded by the amount it set aside for it
+func (k Keeper) availableOveruseFunds(ctx sdk.Context, sub types.Subscription) sdkmath.Int {
+	deposit, found := k.GetOveruseDeposit(ctx, sub.Consumer)
+	if !found {
+		return sdkmath.ZeroInt()
+	}
+	return deposit.Amount.Amount
+}
+
+// chargeOveruse charges the subscription's overuse deposit for CU used beyond its monthly allowance at
+// the plan's overuse rate, and returns the CU charged. Only the CU within the plan's overuse cap and the
+// deposit are charged, the rest are not paid for. The funds are kept in the module and are added to the
+// credit of the month when its CU tracker pays the providers
+func (k Keeper) chargeOveruse(ctx sdk.Context, sub types.Subscription, plan planstypes.Plan, cu uint64) uint64 {
+	overuse, found := k.GetOveruse(ctx, sub.Consumer, sub.Block)
+	if !found {
+		overuse = types.Overuse{
+			Consumer: sub.Consumer,
+			Block:    sub.Block,
+			Charged:  sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdkmath.ZeroInt()),
+		}
+	}
+
+	cuLeft := k.overuseCuLeft(ctx, sub, plan, overuse)
+	if cu > cuLeft {
+		utils.LavaFormatDebug("subscription overuse exceeds the overuse cap or deposit",
+			utils.Attribute{Key: "consumer", Value: sub.Consumer},
+			utils.Attribute{Key: "overuse_cu", Value: cu},
+			utils.Attribute{Key: "overuse_cu_left", Value: cuLeft},
+		)
+		cu = cuLeft
+	}
+	if cu == 0 {
+		return 0
+	}
+
+	price := sdkmath.NewIntFromUint64(cu).Mul(sdkmath.NewIntFromUint64(plan.OveruseRate))
+	deposit, _ := k.GetOveruseDeposit(ctx, sub.Consumer)
+	deposit.Amount = deposit.Amount.SubAmount(price)
+	if deposit.Amount.IsZero() {
+		k.RemoveOveruseDeposit(ctx, sub.Consumer)
+	} else {
+		k.SetOveruseDeposit(ctx, deposit)
+	}
+
+	overuse.Cu += cu
+	overuse.Charged = overuse.Charged.AddAmount(price)
+	k.SetOveruse(ctx, overuse)
+
+	details := map[string]string{
+		"consumer":      sub.Consumer,
+		"creator":       sub.Creator,
+		"sub_block":     strconv.FormatUint(sub.Block, 10),
+		"cu":            strconv.FormatUint(cu, 10),
+		"overuse_rate":  strconv.FormatUint(plan.OveruseRate, 10),
+		"charged":       price.String(),
+		"deposit_left":  deposit.Amount.String(),
+		"month_cu":      strconv.FormatUint(overuse.Cu, 10),
+		"month_charged": overuse.Charged.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.OveruseChargedEventName, details, "subscription charged for CU overuse")
+	return cu
+}
+
+// takeOveruseCredit returns the funds charged for overuse in the month that started in the sub block and removes its record
+func (k Keeper) takeOveruseCredit(ctx sdk.Context, consumer string, block uint64) sdkmath.Int {
+	overuse, found := k.GetOveruse(ctx, consumer, block)
+	if !found {
+		return sdkmath.ZeroInt()
+	}
+	k.RemoveOveruse(ctx, consumer, block)
+	return overuse.Charged.Amount
+}
+
+// DepositOveruse adds funds from the subscription creator to the subscription's overuse deposit
+func (k Keeper) DepositOveruse(ctx sdk.Context, creator string, consumer string, amount sdk.Coin) error {
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		return utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	// the creator is charged for overuse when the deposit runs out, and gets the deposit back when the subscription expires
+	if sub.Creator != creator {
+		return utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("only the subscription creator can deposit for overuse"),
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if amount.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("invalid deposit denom"),
+			utils.Attribute{Key: "amount", Value: amount},
+		)
+	}
+
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid creator address", err,
+			utils.Attribute{Key: "creator", Value: creator},
+		)
+	}
+
+	err = k.chargeFromCreatorAccountToModule(ctx, creatorAcct, amount)
+	if err != nil {
+		return err
+	}
+
+	deposit, found := k.GetOveruseDeposit(ctx, consumer)
+	if !found {
+		deposit = types.OveruseDeposit{Consumer: consumer, Amount: sdk.NewCoin(amount.Denom, sdkmath.ZeroInt())}
+	}
+	deposit.Amount = deposit.Amount.Add(amount)
+	k.SetOveruseDeposit(ctx, deposit)
+
+	details := map[string]string{
+		"creator":  creator,
+		"consumer": consumer,
+		"amount":   amount.String(),
+		"deposit":  deposit.Amount.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.OveruseDepositEventName, details, "subscription overuse deposit added")
+	return nil
+}
+
+// refundOveruseDeposit returns the subscription's overuse deposit to its creator
+func (k Keeper) refundOveruseDeposit(ctx sdk.Context, consumer string, creator string) {
+	deposit, found := k.GetOveruseDeposit(ctx, consumer)
+	if !found {
+		return
+	}
+	k.RemoveOveruseDeposit(ctx, consumer)
+
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err == nil {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAcct, sdk.NewCoins(deposit.Amount))
+	}
+	if err != nil {
+		utils.LavaFormatError("critical: failed refunding overuse deposit", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "deposit", Value: deposit.Amount},
+		)
+		return
+	}
+
+	details := map[string]string{
+		"consumer": consumer,
+		"creator":  creator,
+		"amount":   deposit.Amount.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.OveruseDepositRefundEventName, details, "subscription overuse deposit refunded")
+}
@@ -74,12 +74,13 @@ func (k Keeper) CreateSubscription(
 	//   What: find plan, update duration (total and remaining), update credit,
 	//         charge fees, save subscription.
 	//
-	// Subscription upgrade:
-	//   When: if already exists and existing plan, and new plan's price is higher than current plan's
-	//		   or if plan index is different from existing
-	//   What: find subscription, verify new plan's price, upgrade, set duration, update credit,
+	// Subscription upgrade/downgrade:
+	//   When: if already exists and plan index is different from existing
+	//   What: find subscription, refund the unused credit, change plan, set duration, update credit,
 	//         charge fees, save subscription.
-	// Subscription downgrade: (TBD)
+	//   Note: a downgrade is allowed since the refund can't be gamed by switching plans: the credit of
+	//         the current month is only refunded after its providers were paid for the CU they claimed,
+	//         and each plan change buys at least a month of the new plan.
 
 	if !found {
 		sub, err = k.createNewSubscription(ctx, &plan, creator, consumer, block, autoRenewalFlag)
@@ -88,7 +89,7 @@ func (k Keeper) CreateSubscription(
 		}
 	} else {
 		// Allow renewal with the same plan ("same" means both plan index);
-		// If the plan index is different - upgrade if the price is higher or equal
+		// If the plan index is different - change the plan (upgrade or downgrade)
 		// If the plan index is the same but the plan block is different - advice using the "--advance-purchase" flag
 		if plan.Index != sub.PlanIndex {
 			if sub.Creator != creator && sub.Consumer != creator {
@@ -240,29 +241,6 @@ func (k Keeper) upgradeSubscriptionPlan(ctx sdk.Context, sub *types.Subscription
 		)
 	}
 
-	// We want to get the most up-to-date subscription (including next-epoch upgrade)
-	currentPlan, err := k.GetPlanFromSubscription(ctx, sub.Consumer, nextEpoch)
-	if err != nil {
-		return utils.LavaFormatError("failed to find plan for current subscription", err,
-			utils.LogAttr("consumer", sub.Consumer),
-			utils.LogAttr("block", block),
-			utils.LogAttr("nextEpoch", nextEpoch),
-		)
-	}
-
-	if newPlan.Price.Amount.LT(currentPlan.Price.Amount) {
-		return utils.LavaFormatError("New plan's price must be higher than the old plan", nil,
-			utils.LogAttr("consumer", sub.Consumer),
-			utils.LogAttr("currentPlan", currentPlan),
-			utils.LogAttr("newPlan", newPlan),
-			utils.LogAttr("block", block),
-			utils.LogAttr("nextEpoch", nextEpoch),
-		)
-	}
-
-	// Track CU for the previous subscription
-	k.addCuTrackerTimerForSubscription(ctx, block, sub)
-
 	if sub.DurationLeft == 0 {
 		// Subscription was already expired. Can't upgrade.
 		k.handleZeroDurationLeftForSubscription(ctx, block, sub)
@@ -270,6 +248,16 @@ func (k Keeper) upgradeSubscriptionPlan(ctx sdk.Context, sub *types.Subscription
 			utils.LogAttr("consumer", sub.Consumer))
 	}
 
+	// Pay the providers for the CU used with the previous plan, and refund the rest of its credit
+	oldPlanIndex := sub.PlanIndex
+	refund, err := k.refundUnusedCredit(ctx, block, sub)
+	if err != nil {
+		return utils.LavaFormatError("upgrade subscription failed, refund of unused credit failed", err,
+			utils.LogAttr("consumer", sub.Consumer),
+			utils.LogAttr("creator", sub.Creator),
+		)
+	}
+
 	sub.DurationTotal = 0
 
 	// The "old" subscription's duration is now expired
@@ -278,7 +266,6 @@ func (k Keeper) upgradeSubscriptionPlan(ctx sdk.Context, sub *types.Subscription
 	sub.PlanIndex = newPlan.Index
 	sub.PlanBlock = newPlan.Block
 	sub.MonthCuTotal = newPlan.PlanPolicy.TotalCuLimit
-	sub.Credit.Amount = math.ZeroInt()
 
 	err = k.resetSubscriptionDetailsAndAppendEntry(ctx, sub, nextEpoch, true)
 	if err != nil {
@@ -290,8 +277,9 @@ func (k Keeper) upgradeSubscriptionPlan(ctx sdk.Context, sub *types.Subscription
 
 	details := map[string]string{
 		"consumer": sub.Consumer,
-		"oldPlan":  sub.PlanIndex,
+		"oldPlan":  oldPlanIndex,
 		"newPlan":  newPlan.Index,
+		"refund":   refund.String(),
 	}
 	utils.LogLavaEvent(ctx, k.Logger(ctx), types.UpgradeSubscriptionEventName, details, "subscription upgraded")
 	return nil
@@ -414,6 +402,11 @@ func (k Keeper) advanceMonth(ctx sdk.Context, subkey []byte) {
 				return
 			}
 
+			// the overuse deposit belongs to the previous creator
+			if sub.Creator != newSubInfo.Creator {
+				k.refundOveruseDeposit(ctx, sub.Consumer, sub.Creator)
+			}
+
 			sub.Creator = newSubInfo.Creator
 			sub.PlanIndex = newSubInfo.PlanIndex
 			sub.PlanBlock = newSubInfo.PlanBlock
@@ -464,19 +457,39 @@ func (k Keeper) verifySubExists(ctx sdk.Context, consumer string, block uint64,
 }
 
 func (k Keeper) addCuTrackerTimerForSubscription(ctx sdk.Context, block uint64, sub *types.Subscription) {
+	k.addCuTrackerTimerWithCredit(ctx, block, sub, sub.Credit.Amount.QuoRaw(int64(sub.DurationLeft)))
+}
+
+// addCuTrackerTimerWithCredit sets the CU tracker timer of the subscription's month, that rewards
+// its providers with the given credit (which is deducted from the subscription's credit)
+func (k Keeper) addCuTrackerTimerWithCredit(ctx sdk.Context, block uint64, sub *types.Subscription, creditReward math.Int) {
+	k.addCuTrackerTimer(ctx, block, sub, types.CuTrackerTimerData{
+		Block:  sub.Block,
+		Credit: sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), creditReward),
+	})
+}
+
+// addCuTrackerTimerWithRefund sets the CU tracker timer of the subscription's month, that rewards its
+// providers with the share of the given credit that matches the CU they claim out of the month's CU, and
+// refunds the rest to the subscription's creator (the credit is deducted from the subscription's credit)
+func (k Keeper) addCuTrackerTimerWithRefund(ctx sdk.Context, block uint64, sub *types.Subscription, monthCredit math.Int) {
+	k.addCuTrackerTimer(ctx, block, sub, types.CuTrackerTimerData{
+		Block:         sub.Block,
+		Credit:        sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), monthCredit),
+		MonthCuTotal:  sub.MonthCuTotal,
+		RefundAddress: sub.Creator,
+	})
+}
+
+func (k Keeper) addCuTrackerTimer(ctx sdk.Context, block uint64, sub *types.Subscription, timerData types.CuTrackerTimerData) {
 	blocksToSave, err := k.epochstorageKeeper.BlocksToSave(ctx, block)
 	if err != nil {
 		utils.LavaFormatError("critical: failed assigning CU tracker callback, skipping", err,
 			utils.Attribute{Key: "block", Value: block},
 		)
 	} else {
-		creditReward := sub.Credit.Amount.QuoRaw(int64(sub.DurationLeft))
-		sub.Credit = sub.Credit.SubAmount(creditReward)
+		sub.Credit = sub.Credit.SubAmount(timerData.Credit.Amount)
 
-		timerData := types.CuTrackerTimerData{
-			Block:  sub.Block,
-			Credit: sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), creditReward),
-		}
 		marshaledTimerData, err := k.cdc.Marshal(&timerData)
 		if err != nil {
 			utils.LavaFormatError("critical: failed assigning CU tracker callback. can't marshal cu tracker timer data, skipping", err,
@@ -488,6 +501,42 @@ func (k Keeper) addCuTrackerTimerForSubscription(ctx sdk.Context, block uint64,
 	}
 }
 
+// monthCreditUsed returns the part of the subscription's current month credit that matches the CU it used this month
+func monthCreditUsed(sub types.Subscription) math.Int {
+	if sub.DurationLeft == 0 || sub.MonthCuTotal == 0 || sub.MonthCuLeft >= sub.MonthCuTotal {
+		return math.ZeroInt()
+	}
+	usedCu := sub.MonthCuTotal - sub.MonthCuLeft
+	return sub.Credit.Amount.QuoRaw(int64(sub.DurationLeft)).
+		Mul(math.NewIntFromUint64(usedCu)).Quo(math.NewIntFromUint64(sub.MonthCuTotal))
+}
+
+// refundUnusedCredit refunds the credit of the subscription's unused months to its creator. The credit of
+// its current month is held by the month's CU tracker timer: once the providers' window to claim payment for
+// the month closes, they are paid for the CU they claimed, and the rest is refunded to the creator. Credit
+// that other accounts added with top ups is part of the subscription's credit, so it is refunded to the
+// creator too
+func (k Keeper) refundUnusedCredit(ctx sdk.Context, block uint64, sub *types.Subscription) (sdk.Coin, error) {
+	k.addCuTrackerTimerWithRefund(ctx, block, sub, sub.Credit.Amount.QuoRaw(int64(sub.DurationLeft)))
+
+	refund := sub.Credit
+	if refund.IsPositive() {
+		creatorAcct, err := sdk.AccAddressFromBech32(sub.Creator)
+		if err != nil {
+			return refund, utils.LavaFormatWarning("invalid subscription creator address", err,
+				utils.Attribute{Key: "creator", Value: sub.Creator},
+			)
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAcct, sdk.NewCoins(refund))
+		if err != nil {
+			return refund, err
+		}
+	}
+
+	sub.Credit = sub.Credit.SubAmount(refund.Amount)
+	return refund, nil
+}
+
 func (k Keeper) handleZeroDurationLeftForSubscription(ctx sdk.Context, block uint64, sub *types.Subscription) {
 	// subscription duration has already reached zero before and should have
 	// been removed before. Extend duration by another month (without adding
@@ -674,10 +723,106 @@ func (k Keeper) CreateFutureSubscription(ctx sdk.Context,
 	return nil
 }
 
+// CancelSubscription cancels a subscription. The providers are paid for the CU they claim for the current
+// month (when its CU tracker timer expires), the rest of the credit is refunded to the subscription's creator
+// (and an advance purchase to its buyer), and the subscription and its projects are removed
+// (takes effect at the beginning of next epoch)
+func (k Keeper) CancelSubscription(ctx sdk.Context, creator string, consumer string) error {
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("Got an error while trying to get next epoch on CancelSubscription", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	// take the most updated subscription (including next-epoch upgrade)
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, nextEpoch, &sub); !found {
+		return utils.LavaFormatWarning("could not cancel subscription", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if creator != sub.Consumer && creator != sub.Creator {
+		return utils.LavaFormatWarning("could not cancel subscription", fmt.Errorf("creator is not the subscription's consumer or creator"),
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if sub.Block == nextEpoch {
+		// the subscription was upgraded or renewed in this epoch
+		return utils.LavaFormatWarning("could not cancel subscription", fmt.Errorf("subscription changes in the next epoch, try again later"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "nextEpoch", Value: nextEpoch},
+		)
+	}
+
+	if sub.DurationLeft == 0 {
+		return utils.LavaFormatWarning("could not cancel subscription", fmt.Errorf("subscription already expired"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	unusedMonths := sub.DurationLeft - 1
+	refund, err := k.refundUnusedCredit(ctx, block, &sub)
+	if err != nil {
+		return utils.LavaFormatError("cancel subscription failed, refund of unused credit failed", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "creator", Value: sub.Creator},
+		)
+	}
+
+	futureRefund := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), math.ZeroInt())
+	if sub.FutureSubscription != nil && sub.FutureSubscription.Credit.IsPositive() {
+		futureRefund = sub.FutureSubscription.Credit
+		futureCreatorAcct, err := sdk.AccAddressFromBech32(sub.FutureSubscription.Creator)
+		if err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, futureCreatorAcct, sdk.NewCoins(futureRefund))
+		}
+		if err != nil {
+			return utils.LavaFormatError("cancel subscription failed, refund of advance purchase failed", err,
+				utils.Attribute{Key: "consumer", Value: consumer},
+				utils.Attribute{Key: "creator", Value: sub.FutureSubscription.Creator},
+			)
+		}
+	}
+
+	// the subscription ends now instead of at its month expiry
+	tsKey := []byte(consumer)
+	if k.subsTS.HasTimerByBlockTime(ctx, sub.MonthExpiryTime, tsKey) {
+		k.subsTS.DelTimerByBlockTime(ctx, sub.MonthExpiryTime, tsKey)
+	}
+
+	k.RemoveExpiredSubscription(ctx, consumer, nextEpoch, sub.PlanIndex, sub.PlanBlock)
+
+	details := map[string]string{
+		"consumer":      consumer,
+		"creator":       sub.Creator,
+		"unused_months": strconv.FormatUint(unusedMonths, 10),
+		"refund":        refund.String(),
+		"future_refund": futureRefund.String(),
+		"block":         strconv.FormatUint(nextEpoch, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.CancelSubscriptionEventName, details, "subscription cancelled")
+	return nil
+}
+
 func (k Keeper) RemoveExpiredSubscription(ctx sdk.Context, consumer string, block uint64, planIndex string, planBlock uint64) {
+	// return the unused overuse deposit
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); found {
+		k.refundOveruseDeposit(ctx, consumer, sub.Creator)
+	}
+
 	// delete all projects before deleting
 	k.delAllProjectsFromSubscription(ctx, consumer)
 
+	// a pending transfer of the subscription can no longer be accepted
+	k.RemoveSubscriptionTransfer(ctx, consumer)
+
 	err := k.subsFS.DelEntry(ctx, consumer, block) // minus 1 to avoid deleting an upgraded subscription
 	if err != nil {
 		utils.LavaFormatError("deleting expired subscription failed", err,
@@ -759,17 +904,33 @@ func (k Keeper) delAllProjectsFromSubscription(ctx sdk.Context, consumer string)
 	}
 }
 
-func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer string, block, cuAmount uint64) (types.Subscription, error) {
+// ChargeComputeUnitsToSubscription charges CU to the subscription and returns the CU it paid for. The CU limits
+// are enforced per provider, so a relay can cross the month's allowance. When the plan allows overuse the CU beyond
+// it are charged as overuse, as much as the overuse cap and deposit allow, and the rest are not paid for. Otherwise
+// the relay is paid for in full and the month's CU left drop to zero
+func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer string, block, cuAmount uint64) (types.Subscription, uint64, error) {
 	var sub types.Subscription
 	if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); !found {
-		return sub, utils.LavaFormatError("can't charge cu to subscription",
+		return sub, 0, utils.LavaFormatError("can't charge cu to subscription",
 			fmt.Errorf("subscription not found"),
 			utils.Attribute{Key: "subscription", Value: consumer},
 			utils.Attribute{Key: "block", Value: block},
 		)
 	}
 
+	paidCu := cuAmount
 	if sub.MonthCuLeft < cuAmount {
+		overuseCu := cuAmount - sub.MonthCuLeft
+		plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+		if !found {
+			utils.LavaFormatError("critical: failed to find existing subscription plan", legacyerrors.ErrKeyNotFound,
+				utils.Attribute{Key: "consumer", Value: sub.Consumer},
+				utils.Attribute{Key: "planIndex", Value: sub.PlanIndex},
+				utils.Attribute{Key: "planBlock", Value: sub.PlanBlock},
+			)
+		} else if plan.AllowOveruse && plan.OveruseRate != 0 {
+			paidCu = sub.MonthCuLeft + k.chargeOveruse(ctx, sub, plan, overuseCu)
+		}
 		sub.MonthCuLeft = 0
 	} else {
 		sub.MonthCuLeft -= cuAmount
@@ -779,7 +940,8 @@ func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer strin
 		utils.LogAttr("sub", consumer),
 		utils.LogAttr("sub_block", sub.Block),
 		utils.LogAttr("charge_cu", cuAmount),
+		utils.LogAttr("paid_cu", paidCu),
 		utils.LogAttr("month_cu_left", sub.MonthCuLeft))
 	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
-	return sub, nil
+	return sub, paidCu, nil
 }
@@ -342,7 +342,7 @@ func TestMonthlyRechargeCU(t *testing.T) {
 			ts.AdvanceEpoch()
 
 			// charge the subscription
-			_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
+			_, _, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
 				ts.Ctx, tt.subscription, block1, tt.usedCuPerProject)
 			require.NoError(t, err)
 
@@ -473,7 +473,7 @@ func TestSubscriptionExpire(t *testing.T) {
 	_, found := ts.getSubscription(sub1Addr)
 	require.True(t, found)
 
-	_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
+	_, _, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
 		ts.Ctx, sub1Addr, block, 10)
 	require.NoError(t, err)
 
@@ -484,14 +484,14 @@ func TestSubscriptionExpire(t *testing.T) {
 	_, found = ts.getSubscription(sub1Addr)
 	require.False(t, found)
 
-	_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
+	_, _, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
 		ts.Ctx, sub1Addr, block, 10)
 	require.NoError(t, err)
 
 	ts.AdvanceBlockUntilStale()
 
 	// subscription no longer charge-able for previous usage
-	_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
+	_, _, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(
 		ts.Ctx, sub1Addr, block, 10)
 	require.Error(t, err)
 }
@@ -1411,30 +1411,54 @@ func TestSubscriptionUpgradeTwiceInSameEpoch(t *testing.T) {
 	})
 }
 
-func TestSubscriptionDowngradeFails(t *testing.T) {
+func TestSubscriptionDowngrade(t *testing.T) {
 	ts := newTester(t)
 	ts.SetupAccounts(1, 0, 0) // 1 sub, 0 adm, 0 dev
 
-	_, consumer := ts.Account("sub1")
+	consumerAcc, consumer := ts.Account("sub1")
 	freePlan := ts.Plan("free")
 	premiumPlan := ts.Plan("premium")
 
 	// Buy premium plan
-	_, err := ts.TxSubscriptionBuy(consumer, consumer, premiumPlan.Index, 1, false, false)
+	_, err := ts.TxSubscriptionBuy(consumer, consumer, premiumPlan.Index, 2, false, false)
 	require.NoError(t, err)
 	// Verify subscription found inside getSubscription
 	getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
 
 	ts.AdvanceEpochs(2)
 
-	// Buy premium plan
+	// Downgrade to the free plan: the unused premium month is refunded right away, and the
+	// current premium month once its payment window closes (no CU was used)
+	balance := ts.GetBalance(consumerAcc.Addr)
 	_, err = ts.TxSubscriptionBuy(consumer, consumer, freePlan.Index, 1, false, false)
-	require.Error(t, err)
+	require.NoError(t, err)
+	require.Equal(t, balance+premiumPlan.Price.Amount.Sub(freePlan.Price.Amount).Int64(), ts.GetBalance(consumerAcc.Addr))
 
 	ts.AdvanceEpoch()
 
 	sub := getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
-	require.Equal(t, premiumPlan.Index, sub.PlanIndex)
+	require.Equal(t, freePlan.Index, sub.PlanIndex)
+	require.Equal(t, uint64(1), sub.DurationLeft)
+	require.Equal(t, freePlan.Price, sub.Credit)
+
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+	require.Equal(t, balance+premiumPlan.Price.Amount.MulRaw(2).Sub(freePlan.Price.Amount).Int64(), ts.GetBalance(consumerAcc.Addr))
+
+	// switching plans back and forth gains nothing: once the payment windows close, the
+	// consumer is back to the same balance and subscription credit
+	balance = ts.GetBalance(consumerAcc.Addr)
+	_, err = ts.TxSubscriptionBuy(consumer, consumer, premiumPlan.Index, 1, false, false)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, err = ts.TxSubscriptionBuy(consumer, consumer, freePlan.Index, 1, false, false)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+
+	require.Equal(t, balance, ts.GetBalance(consumerAcc.Addr))
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+	require.Equal(t, freePlan.Index, sub.PlanIndex)
+	require.Equal(t, freePlan.Price, sub.Credit)
 }
 
 func TestSubscriptionCuExhaustAndUpgrade(t *testing.T) {
@@ -1551,8 +1575,11 @@ func TestSubscriptionCuExhaustAndUpgrade(t *testing.T) {
 	require.Len(t, rewards.Rewards, 1)
 	reward := rewards.Rewards[0]
 
-	// Verify that provider got rewarded for both subscriptions
-	expectedPrice := freePlan.Price.AddAmount(premiumPlan.Price.Amount).AddAmount(premiumPlusPlan.Price.Amount)
+	// Verify that provider got rewarded for the CU used with the upgraded plans (the rest of
+	// their credit was refunded on upgrade) and for the premium-plus month
+	freeReward := freePlan.Price.Amount.MulRaw(1000).QuoRaw(int64(freePlan.PlanPolicy.TotalCuLimit))
+	premiumReward := premiumPlan.Price.Amount.MulRaw(1000).QuoRaw(int64(premiumPlan.PlanPolicy.TotalCuLimit))
+	expectedPrice := premiumPlusPlan.Price.AddAmount(freeReward).AddAmount(premiumReward)
 	require.Equal(t, sdk.NewCoins(expectedPrice), reward.Amount)
 }
 
@@ -2649,3 +2676,329 @@ func TestUpgradedSubscriptionCredit(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, premiumPlanPrice.Amount.MulRaw(2).Equal(res.Sub.Credit.Amount))
 }
+
+// TestSubscriptionTransfer checks that an accepted transfer moves the subscription, its projects
+// and its credit to the new consumer in the next epoch, and that the old consumer's providers are paid
+func TestSubscriptionTransfer(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 1) // 2 sub, 0 adm, 1 dev
+
+	subAcc, sub1 := ts.Account("sub1")
+	_, sub2 := ts.Account("sub2")
+	_, dev1 := ts.Account("dev1")
+
+	_, err := ts.TxSubscriptionBuy(sub1, sub1, "free", 2, false, false)
+	require.NoError(t, err)
+
+	projectData := projectstypes.ProjectData{
+		Name:        "myproj",
+		Enabled:     true,
+		ProjectKeys: []projectstypes.ProjectKey{projectstypes.ProjectDeveloperKey(dev1)},
+	}
+	require.NoError(t, ts.TxSubscriptionAddProject(sub1, projectData))
+
+	spec := ts.AddSpec("testSpec", common.CreateMockSpec()).Spec("testSpec")
+
+	// Setup validator and provider
+	testBalance := int64(1000000)
+	testStake := int64(100000)
+	validationAcc, _ := ts.AddAccount(common.VALIDATOR, 0, testBalance)
+	ts.TxCreateValidator(validationAcc, math.NewInt(testBalance))
+	_, providerAddr := ts.AddAccount(common.PROVIDER, 0, testBalance)
+	err = ts.StakeProviderExtra(providerAddr, spec, testStake, nil, 0, "provider")
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// Send relay under the old consumer
+	cuSum := uint64(10000)
+	relaySession := &pairingtypes.RelaySession{
+		Provider:    providerAddr,
+		ContentHash: []byte(spec.ApiCollections[0].Apis[0].Name),
+		SessionId:   1,
+		SpecId:      spec.Index,
+		CuSum:       cuSum,
+		Epoch:       int64(ts.EpochStart(ts.BlockHeight())),
+		RelayNum:    1,
+	}
+	sig, err := sigs.Sign(subAcc.SK, *relaySession)
+	require.NoError(t, err)
+	relaySession.Sig = sig
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	// accept without a transfer request fails
+	require.Error(t, ts.TxSubscriptionAcceptTransfer(sub2, sub1))
+
+	require.NoError(t, ts.TxSubscriptionTransfer(sub1, sub2))
+
+	// only the new consumer can accept
+	require.Error(t, ts.TxSubscriptionAcceptTransfer(dev1, sub1))
+
+	oldSub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1)
+	require.NoError(t, ts.TxSubscriptionAcceptTransfer(sub2, sub1))
+
+	// the transfer request is used up
+	require.Error(t, ts.TxSubscriptionAcceptTransfer(sub2, sub1))
+
+	// nothing changes until next epoch
+	_, found := ts.getSubscription(sub2)
+	require.False(t, found)
+	project := getProjectAndFailTestIfNotFound(t, ts, dev1, ts.BlockHeight())
+	require.Equal(t, sub1, project.Subscription)
+
+	ts.AdvanceEpoch()
+
+	_, found = ts.getSubscription(sub1)
+	require.False(t, found)
+
+	// the old consumer's providers get the part of the month's credit that it used
+	creditReward := oldSub.Credit.Amount.QuoRaw(int64(oldSub.DurationLeft)).
+		MulRaw(int64(cuSum)).QuoRaw(int64(oldSub.MonthCuTotal))
+
+	newSub := getSubscriptionAndFailTestIfNotFound(t, ts, sub2)
+	require.Equal(t, sub2, newSub.Creator)
+	require.Equal(t, oldSub.PlanIndex, newSub.PlanIndex)
+	require.Equal(t, oldSub.MonthExpiryTime, newSub.MonthExpiryTime)
+	require.Equal(t, oldSub.DurationLeft, newSub.DurationLeft)
+	require.Equal(t, oldSub.MonthCuTotal-cuSum, newSub.MonthCuLeft)
+	require.Equal(t, oldSub.Credit.Amount.Sub(creditReward), newSub.Credit.Amount)
+
+	// the projects and their keys moved to the new consumer
+	project = getProjectAndFailTestIfNotFound(t, ts, dev1, ts.BlockHeight())
+	require.Equal(t, projectstypes.ProjectIndex(sub2, "myproj"), project.Index)
+	require.Equal(t, sub2, project.Subscription)
+
+	project = getProjectAndFailTestIfNotFound(t, ts, sub2, ts.BlockHeight())
+	require.Equal(t, projectstypes.ProjectIndex(sub2, projectstypes.ADMIN_PROJECT_NAME), project.Index)
+
+	_, err = ts.GetProjectForDeveloper(sub1, ts.BlockHeight())
+	require.Error(t, err)
+
+	// the old consumer's providers are paid after blocksToSave
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+	reward, err := ts.QueryDualstakingDelegatorRewards(providerAddr, providerAddr, spec.Index)
+	require.NoError(t, err)
+	require.Len(t, reward.Rewards, 1)
+	require.True(t, reward.Rewards[0].Amount.AmountOf(ts.BondDenom()).IsPositive())
+
+	// the subscription renews under the new consumer
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	newSub = getSubscriptionAndFailTestIfNotFound(t, ts, sub2)
+	require.Equal(t, oldSub.DurationLeft-1, newSub.DurationLeft)
+}
+
+func TestSubscriptionTopUp(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 1) // 1 sub, 0 adm, 1 dev
+
+	consumerAcc, consumer := ts.Account("sub1")
+	payerAcc, payer := ts.Account("dev1")
+
+	_, err := ts.TxSubscriptionBuy(consumer, consumer, "free", 1, false, false)
+	require.NoError(t, err)
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+
+	price := ts.Plan("free").Price
+	credit := sdk.NewCoin(ts.BondDenom(), math.NewInt(1000))
+	balance := ts.GetBalance(payerAcc.Addr)
+	consumerBalance := ts.GetBalance(consumerAcc.Addr)
+
+	// any account can extend the duration and add credit, and only the payer is charged
+	require.NoError(t, ts.TxSubscriptionTopUp(payer, consumer, 2, credit))
+
+	charged := price.Amount.MulRaw(2).Add(credit.Amount)
+	require.Equal(t, balance-charged.Int64(), ts.GetBalance(payerAcc.Addr))
+	require.Equal(t, consumerBalance, ts.GetBalance(consumerAcc.Addr))
+
+	toppedUp := getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+	require.Equal(t, sub.DurationLeft+2, toppedUp.DurationLeft)
+	require.Equal(t, sub.Credit.Amount.Add(charged), toppedUp.Credit.Amount)
+	require.Equal(t, sub.Creator, toppedUp.Creator)
+
+	// credit only
+	require.NoError(t, ts.TxSubscriptionTopUp(payer, consumer, 0, credit))
+	toppedUp = getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+	require.Equal(t, sub.Credit.Amount.Add(charged).Add(credit.Amount), toppedUp.Credit.Amount)
+
+	// duration beyond the limit
+	require.Error(t, ts.TxSubscriptionTopUp(payer, consumer, types.MAX_SUBSCRIPTION_DURATION, credit))
+
+	// wrong denom
+	require.Error(t, ts.TxSubscriptionTopUp(payer, consumer, 0, sdk.NewCoin("other", math.NewInt(10))))
+
+	// no subscription
+	require.Error(t, ts.TxSubscriptionTopUp(payer, payer, 1, credit))
+
+	// the subscription outlives its original duration
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	toppedUp = getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+	require.Equal(t, sub.DurationLeft+1, toppedUp.DurationLeft)
+}
+
+// TestSubscriptionTransferToppedUp checks that a subscription whose credit and duration were topped up
+// pays the old consumer's providers only the share of the month's credit that matches the CU it used
+func TestSubscriptionTransferToppedUp(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 1) // 2 sub, 0 adm, 1 dev
+
+	subAcc, sub1 := ts.Account("sub1")
+	_, sub2 := ts.Account("sub2")
+	_, payer := ts.Account("dev1")
+
+	_, err := ts.TxSubscriptionBuy(sub1, sub1, "free", 1, false, false)
+	require.NoError(t, err)
+
+	spec := ts.AddSpec("testSpec", common.CreateMockSpec()).Spec("testSpec")
+
+	// Setup validator and provider
+	testBalance := int64(1000000)
+	testStake := int64(100000)
+	validationAcc, _ := ts.AddAccount(common.VALIDATOR, 0, testBalance)
+	ts.TxCreateValidator(validationAcc, math.NewInt(testBalance))
+	_, providerAddr := ts.AddAccount(common.PROVIDER, 0, testBalance)
+	err = ts.StakeProviderExtra(providerAddr, spec, testStake, nil, 0, "provider")
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	cuSum := uint64(10000)
+	relaySession := &pairingtypes.RelaySession{
+		Provider:    providerAddr,
+		ContentHash: []byte(spec.ApiCollections[0].Apis[0].Name),
+		SessionId:   1,
+		SpecId:      spec.Index,
+		CuSum:       cuSum,
+		Epoch:       int64(ts.EpochStart(ts.BlockHeight())),
+		RelayNum:    1,
+	}
+	sig, err := sigs.Sign(subAcc.SK, *relaySession)
+	require.NoError(t, err)
+	relaySession.Sig = sig
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	// another account tops up the subscription after some of the month's CU was used
+	credit := sdk.NewCoin(ts.BondDenom(), math.NewInt(5000))
+	require.NoError(t, ts.TxSubscriptionTopUp(payer, sub1, 2, credit))
+	ts.AdvanceEpoch()
+
+	oldSub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1)
+	require.Equal(t, uint64(3), oldSub.DurationLeft)
+	require.Equal(t, oldSub.MonthCuTotal-cuSum, oldSub.MonthCuLeft)
+
+	require.NoError(t, ts.TxSubscriptionTransfer(sub1, sub2))
+	require.NoError(t, ts.TxSubscriptionAcceptTransfer(sub2, sub1))
+	ts.AdvanceEpoch()
+
+	// the credit reward is the used share of one month of the topped-up credit
+	creditReward := oldSub.Credit.Amount.QuoRaw(int64(oldSub.DurationLeft)).
+		MulRaw(int64(cuSum)).QuoRaw(int64(oldSub.MonthCuTotal))
+	require.True(t, creditReward.IsPositive())
+	require.True(t, creditReward.LT(oldSub.Credit.Amount.QuoRaw(int64(oldSub.DurationLeft))))
+
+	newSub := getSubscriptionAndFailTestIfNotFound(t, ts, sub2)
+	require.Equal(t, oldSub.DurationLeft, newSub.DurationLeft)
+	require.Equal(t, oldSub.MonthCuLeft, newSub.MonthCuLeft)
+	require.Equal(t, oldSub.Credit.Amount.Sub(creditReward), newSub.Credit.Amount)
+}
+
+// TestSubscriptionCancel checks that a cancelled subscription refunds its unused months right away, pays
+// its providers for the CU they claim for the current month (also after the cancellation) and refunds the rest
+// of the month's credit when the payment window closes, and is removed with its projects
+func TestSubscriptionCancel(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 1) // 1 sub, 0 adm, 1 dev
+
+	consumerAcc, consumer := ts.Account("sub1")
+	_, dev1 := ts.Account("dev1")
+	freePlan := ts.Plan("free")
+	premiumPlan := ts.Plan("premium")
+
+	_, err := ts.TxSubscriptionBuy(consumer, consumer, freePlan.Index, 3, false, false)
+	require.NoError(t, err)
+	_, err = ts.TxSubscriptionBuy(consumer, consumer, premiumPlan.Index, 1, false, true)
+	require.NoError(t, err)
+
+	// credit added by another account is refunded to the subscription's creator
+	topUp := sdk.NewCoin(ts.BondDenom(), math.NewInt(3000))
+	require.NoError(t, ts.TxSubscriptionTopUp(dev1, consumer, 0, topUp))
+
+	spec := ts.AddSpec("testSpec", common.CreateMockSpec()).Spec("testSpec")
+
+	// Setup validator and provider
+	testBalance := int64(1000000)
+	testStake := int64(100000)
+	validationAcc, _ := ts.AddAccount(common.VALIDATOR, 0, testBalance)
+	ts.TxCreateValidator(validationAcc, math.NewInt(testBalance))
+	_, providerAddr := ts.AddAccount(common.PROVIDER, 0, testBalance)
+	err = ts.StakeProviderExtra(providerAddr, spec, testStake, nil, 0, "provider")
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	cuSum := uint64(10000)
+	newRelaySession := func(sessionId uint64) *pairingtypes.RelaySession {
+		relaySession := &pairingtypes.RelaySession{
+			Provider:    providerAddr,
+			ContentHash: []byte(spec.ApiCollections[0].Apis[0].Name),
+			SessionId:   sessionId,
+			SpecId:      spec.Index,
+			CuSum:       cuSum,
+			Epoch:       int64(ts.EpochStart(ts.BlockHeight())),
+			RelayNum:    1,
+		}
+		sig, err := sigs.Sign(consumerAcc.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		return relaySession
+	}
+
+	_, err = ts.TxPairingRelayPayment(providerAddr, newRelaySession(1))
+	require.NoError(t, err)
+
+	// the provider claims this relay only after the cancellation
+	lateRelaySession := newRelaySession(2)
+
+	// only the consumer or the creator can cancel
+	require.Error(t, ts.TxSubscriptionCancel(dev1, consumer))
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+	balance := ts.GetBalance(consumerAcc.Addr)
+	require.NoError(t, ts.TxSubscriptionCancel(consumer, consumer))
+
+	// the unused months (including the top up) and the advance purchase are refunded right away
+	monthCredit := sub.Credit.Amount.QuoRaw(int64(sub.DurationLeft))
+	refund := sub.Credit.Amount.Sub(monthCredit).Add(premiumPlan.Price.Amount)
+	require.Equal(t, balance+refund.Int64(), ts.GetBalance(consumerAcc.Addr))
+
+	// the subscription and its projects are removed in the next epoch
+	getSubscriptionAndFailTestIfNotFound(t, ts, consumer)
+	ts.AdvanceEpoch()
+	_, found := ts.getSubscription(consumer)
+	require.False(t, found)
+	_, err = ts.GetProjectForDeveloper(consumer, ts.BlockHeight())
+	require.Error(t, err)
+
+	// the provider can still claim payment for relays of the month
+	_, err = ts.TxPairingRelayPayment(providerAddr, lateRelaySession)
+	require.NoError(t, err)
+
+	// the provider is paid for the claimed CU after blocksToSave, and the rest of the month's credit is refunded
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+	reward, err := ts.QueryDualstakingDelegatorRewards(providerAddr, providerAddr, spec.Index)
+	require.NoError(t, err)
+	require.Len(t, reward.Rewards, 1)
+	require.True(t, reward.Rewards[0].Amount.AmountOf(ts.BondDenom()).IsPositive())
+
+	creditReward := monthCredit.MulRaw(int64(2 * cuSum)).QuoRaw(int64(sub.MonthCuTotal))
+	refund = refund.Add(monthCredit.Sub(creditReward))
+	require.Equal(t, balance+refund.Int64(), ts.GetBalance(consumerAcc.Addr))
+
+	// the month expiry no longer applies and the consumer can buy again
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	_, found = ts.getSubscription(consumer)
+	require.False(t, found)
+	_, err = ts.TxSubscriptionBuy(consumer, consumer, freePlan.Index, 1, false, false)
+	require.NoError(t, err)
+}
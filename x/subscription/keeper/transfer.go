@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// SetSubscriptionTransfer set a specific pending SubscriptionTransfer in the store from its consumer
+func (k Keeper) SetSubscriptionTransfer(ctx sdk.Context, transfer types.SubscriptionTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	b := k.cdc.MustMarshal(&transfer)
+	store.Set([]byte(transfer.Consumer), b)
+}
+
+// GetSubscriptionTransfer returns the pending SubscriptionTransfer of a subscription
+func (k Keeper) GetSubscriptionTransfer(ctx sdk.Context, consumer string) (val types.SubscriptionTransfer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	b := store.Get([]byte(consumer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSubscriptionTransfer removes a pending SubscriptionTransfer from the store
+func (k Keeper) RemoveSubscriptionTransfer(ctx sdk.Context, consumer string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	store.Delete([]byte(consumer))
+}
+
+// GetAllSubscriptionTransfer returns all pending SubscriptionTransfer
+func (k Keeper) GetAllSubscriptionTransfer(ctx sdk.Context) (list []types.SubscriptionTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SubscriptionTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAllSubscriptionTransfer sets all pending subscription transfers to the store
+func (k Keeper) SetAllSubscriptionTransfer(ctx sdk.Context, list []types.SubscriptionTransfer) {
+	for _, t := range list {
+		k.SetSubscriptionTransfer(ctx, t)
+	}
+}
+
+// TransferSubscription requests to transfer the consumer's subscription to a new consumer.
+// The transfer takes place once the new consumer accepts it. A new request replaces an older one
+func (k Keeper) TransferSubscription(ctx sdk.Context, consumer string, newConsumer string) error {
+	if _, found := k.GetSubscription(ctx, consumer); !found {
+		return utils.LavaFormatWarning("could not transfer subscription", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if _, found := k.GetSubscription(ctx, newConsumer); found {
+		return utils.LavaFormatWarning("could not transfer subscription", fmt.Errorf("new consumer already has a subscription"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	transfer := types.SubscriptionTransfer{
+		Consumer:    consumer,
+		NewConsumer: newConsumer,
+		Block:       uint64(ctx.BlockHeight()),
+	}
+	k.SetSubscriptionTransfer(ctx, transfer)
+
+	details := map[string]string{
+		"consumer":     consumer,
+		"new_consumer": newConsumer,
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.TransferSubscriptionRequestEventName, details, "subscription transfer requested")
+	return nil
+}
+
+// AcceptSubscriptionTransfer transfers the subscription of a consumer to the new consumer of its
+// pending transfer. The subscription keeps its plan, expiry, CU left, duration and credit (minus
+// the part used by the old consumer this month) and its projects move to the new consumer.
+// (takes effect at the beginning of next epoch)
+func (k Keeper) AcceptSubscriptionTransfer(ctx sdk.Context, newConsumer string, consumer string) error {
+	transfer, found := k.GetSubscriptionTransfer(ctx, consumer)
+	if !found || transfer.NewConsumer != newConsumer {
+		return utils.LavaFormatWarning("could not accept subscription transfer", fmt.Errorf("transfer request not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("Got an error while trying to get next epoch on AcceptSubscriptionTransfer", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, nextEpoch, &sub); !found {
+		return utils.LavaFormatWarning("could not accept subscription transfer", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	if sub.Block == nextEpoch {
+		// the subscription was upgraded or renewed in this epoch
+		return utils.LavaFormatWarning("could not accept subscription transfer", fmt.Errorf("subscription changes in the next epoch, try again later"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "nextEpoch", Value: nextEpoch},
+		)
+	}
+
+	if sub.DurationLeft == 0 {
+		return utils.LavaFormatWarning("could not accept subscription transfer", fmt.Errorf("subscription already expired"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	var newSub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, newConsumer, nextEpoch, &newSub); found {
+		return utils.LavaFormatWarning("could not accept subscription transfer", fmt.Errorf("new consumer already has a subscription"),
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	err = k.projectsKeeper.TransferSubscriptionProjects(ctx, consumer, newConsumer)
+	if err != nil {
+		return utils.LavaFormatWarning("could not accept subscription transfer", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	// the old consumer's providers are paid for this month's CU so far, with the part of the
+	// month's credit that matches the CU it used (the rest remains with the subscription)
+	creditReward := monthCreditUsed(sub)
+	k.addCuTrackerTimerWithCredit(ctx, block, &sub, creditReward)
+
+	err = k.subsFS.DelEntry(ctx, consumer, nextEpoch)
+	if err != nil {
+		return utils.LavaFormatError("accept subscription transfer failed, delete subscription failed", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "block", Value: nextEpoch},
+		)
+	}
+
+	// a consumer that paid for its own subscription keeps paying for it under its new address
+	oldCreator := sub.Creator
+	if sub.Creator == consumer {
+		sub.Creator = newConsumer
+	}
+	if sub.FutureSubscription != nil && sub.FutureSubscription.Creator == consumer {
+		sub.FutureSubscription.Creator = newConsumer
+	}
+
+	sub.Consumer = newConsumer
+	sub.Block = nextEpoch
+
+	err = k.subsFS.AppendEntry(ctx, newConsumer, nextEpoch, &sub)
+	if err != nil {
+		return utils.LavaFormatError("accept subscription transfer failed, append subscription failed", err,
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+			utils.Attribute{Key: "block", Value: nextEpoch},
+		)
+	}
+
+	if k.subsTS.HasTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer)) {
+		k.subsTS.DelTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer))
+	} else {
+		utils.LavaFormatError("Delete timer failed: timer key was not found", nil,
+			utils.LogAttr("expiryTime", sub.MonthExpiryTime),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+	k.subsTS.AddTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(newConsumer), []byte{})
+
+	// the overuse deposit belongs to the creator
+	if oldCreator != sub.Creator {
+		k.refundOveruseDeposit(ctx, consumer, oldCreator)
+	} else if deposit, found := k.GetOveruseDeposit(ctx, consumer); found {
+		k.RemoveOveruseDeposit(ctx, consumer)
+		deposit.Consumer = newConsumer
+		k.SetOveruseDeposit(ctx, deposit)
+	}
+
+	k.RemoveSubscriptionTransfer(ctx, consumer)
+
+	details := map[string]string{
+		"consumer":      consumer,
+		"new_consumer":  newConsumer,
+		"creator":       sub.Creator,
+		"credit":        sub.Credit.String(),
+		"credit_reward": creditReward.String(),
+		"block":         strconv.FormatUint(nextEpoch, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.TransferSubscriptionEventName, details, "subscription transferred")
+	return nil
+}
+
+// TopUpSubscription adds credit and/or extends the duration of an existing subscription (at its
+// current plan's price). Any account can top up a subscription: like buying a subscription for
+// another consumer, the creator of the top up pays for it and the subscription's creator is unchanged
+func (k Keeper) TopUpSubscription(ctx sdk.Context, creator string, consumer string, duration uint64, credit sdk.Coin) error {
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid subscription creator address", err,
+			utils.Attribute{Key: "creator", Value: creator},
+		)
+	}
+
+	if credit.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return utils.LavaFormatWarning("could not top up subscription", fmt.Errorf("invalid credit denom"),
+			utils.Attribute{Key: "credit", Value: credit},
+		)
+	}
+
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("Got an error while trying to get next epoch on TopUpSubscription", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	// take the most updated subscription (including next-epoch upgrade)
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, nextEpoch, &sub); !found {
+		return utils.LavaFormatWarning("could not top up subscription", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	price := credit
+	if duration > 0 {
+		// The total duration may not exceed MAX_SUBSCRIPTION_DURATION, but allow an
+		// extra month to account for renewals before the end of current subscription
+		if sub.DurationLeft+duration > types.MAX_SUBSCRIPTION_DURATION+1 {
+			str := strconv.FormatInt(types.MAX_SUBSCRIPTION_DURATION, 10)
+			return utils.LavaFormatWarning("duration would exceed limit ("+str+" months)",
+				fmt.Errorf("subscription top up failed"),
+				utils.Attribute{Key: "duration", Value: sub.DurationLeft},
+			)
+		}
+
+		plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+		if !found {
+			return utils.LavaFormatError("critical: failed to find existing subscription plan", legacyerrors.ErrKeyNotFound,
+				utils.Attribute{Key: "consumer", Value: consumer},
+				utils.Attribute{Key: "planIndex", Value: sub.PlanIndex},
+				utils.Attribute{Key: "planBlock", Value: sub.PlanBlock},
+			)
+		}
+
+		durationPrice := plan.GetPrice()
+		durationPrice.Amount = durationPrice.Amount.MulRaw(int64(duration))
+		k.applyPlanDiscountIfEligible(duration, &plan, &durationPrice)
+
+		price = price.Add(durationPrice)
+		sub.DurationLeft += duration
+	}
+
+	if price.IsPositive() {
+		err = k.chargeFromCreatorAccountToModule(ctx, creatorAcct, price)
+		if err != nil {
+			return err
+		}
+	}
+
+	sub.Credit = sub.Credit.AddAmount(price.Amount)
+	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+
+	details := map[string]string{
+		"creator":       creator,
+		"consumer":      consumer,
+		"duration":      strconv.FormatUint(duration, 10),
+		"duration_left": strconv.FormatUint(sub.DurationLeft, 10),
+		"price":         price.String(),
+		"credit":        sub.Credit.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.TopUpSubscriptionEventName, details, "subscription topped up")
+	return nil
+}
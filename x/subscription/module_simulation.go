@@ -41,6 +41,26 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgAutoRenewal int = 100
 
+	opWeightMsgOveruseDeposit = "op_weight_msg_overuse_deposit"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgOveruseDeposit int = 100
+
+	opWeightMsgTransferSubscription = "op_weight_msg_transfer_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferSubscription int = 100
+
+	opWeightMsgAcceptSubscriptionTransfer = "op_weight_msg_accept_subscription_transfer"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptSubscriptionTransfer int = 100
+
+	opWeightMsgTopUpSubscription = "op_weight_msg_top_up_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTopUpSubscription int = 100
+
+	opWeightMsgCancelSubscription = "op_weight_msg_cancel_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelSubscription int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -113,6 +133,61 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		subscriptionsimulation.SimulateMsgAutoRenewal(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgOveruseDeposit int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgOveruseDeposit, &weightMsgOveruseDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgOveruseDeposit = defaultWeightMsgOveruseDeposit
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgOveruseDeposit,
+		subscriptionsimulation.SimulateMsgOveruseDeposit(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTransferSubscription int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTransferSubscription, &weightMsgTransferSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferSubscription = defaultWeightMsgTransferSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferSubscription,
+		subscriptionsimulation.SimulateMsgTransferSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptSubscriptionTransfer int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptSubscriptionTransfer, &weightMsgAcceptSubscriptionTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptSubscriptionTransfer = defaultWeightMsgAcceptSubscriptionTransfer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptSubscriptionTransfer,
+		subscriptionsimulation.SimulateMsgAcceptSubscriptionTransfer(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTopUpSubscription int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTopUpSubscription, &weightMsgTopUpSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgTopUpSubscription = defaultWeightMsgTopUpSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTopUpSubscription,
+		subscriptionsimulation.SimulateMsgTopUpSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancelSubscription int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCancelSubscription, &weightMsgCancelSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelSubscription = defaultWeightMsgCancelSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelSubscription,
+		subscriptionsimulation.SimulateMsgCancelSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -14,6 +14,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddProject{}, "subscription/AddProject", nil)
 	cdc.RegisterConcrete(&MsgDelProject{}, "subscription/DelProject", nil)
 	cdc.RegisterConcrete(&MsgAutoRenewal{}, "subscription/AutoRenewal", nil)
+	cdc.RegisterConcrete(&MsgOveruseDeposit{}, "subscription/OveruseDeposit", nil)
+	cdc.RegisterConcrete(&MsgTransferSubscription{}, "subscription/TransferSubscription", nil)
+	cdc.RegisterConcrete(&MsgAcceptSubscriptionTransfer{}, "subscription/AcceptSubscriptionTransfer", nil)
+	cdc.RegisterConcrete(&MsgTopUpSubscription{}, "subscription/TopUpSubscription", nil)
+	cdc.RegisterConcrete(&MsgCancelSubscription{}, "subscription/CancelSubscription", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +35,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAutoRenewal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOveruseDeposit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferSubscription{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptSubscriptionTransfer{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTopUpSubscription{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelSubscription{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
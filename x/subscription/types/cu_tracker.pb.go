@@ -69,8 +69,10 @@ func (m *TrackedCu) GetCu() uint64 {
 }
 
 type CuTrackerTimerData struct {
-	Block  uint64     `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
-	Credit types.Coin `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit"`
+	Block         uint64     `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Credit        types.Coin `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit"`
+	MonthCuTotal  uint64     `protobuf:"varint,3,opt,name=month_cu_total,json=monthCuTotal,proto3" json:"month_cu_total,omitempty"`
+	RefundAddress string     `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
 func (m *CuTrackerTimerData) Reset()         { *m = CuTrackerTimerData{} }
@@ -120,6 +122,20 @@ func (m *CuTrackerTimerData) GetCredit() types.Coin {
 	return types.Coin{}
 }
 
+func (m *CuTrackerTimerData) GetMonthCuTotal() uint64 {
+	if m != nil {
+		return m.MonthCuTotal
+	}
+	return 0
+}
+
+func (m *CuTrackerTimerData) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TrackedCu)(nil), "lavanet.lava.subscription.TrackedCu")
 	proto.RegisterType((*CuTrackerTimerData)(nil), "lavanet.lava.subscription.CuTrackerTimerData")
@@ -130,24 +146,27 @@ func init() {
 }
 
 var fileDescriptor_5974e118ddf7c543 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcd, 0x4a, 0x03, 0x31,
-	0x14, 0x85, 0x27, 0x43, 0x2d, 0x18, 0xc1, 0xc5, 0xd0, 0x45, 0x5b, 0x21, 0x96, 0xae, 0x8a, 0x48,
-	0x42, 0x75, 0xe1, 0xbe, 0x23, 0x3e, 0x40, 0xe9, 0xca, 0x8d, 0x24, 0xb7, 0x61, 0x0c, 0xed, 0xcc,
-	0x1d, 0xf2, 0x53, 0xf4, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0x74, 0x25, 0x32, 0xf3, 0x22, 0x32, 0x3f,
-	0x0b, 0xbb, 0x3a, 0x27, 0xc9, 0x17, 0xee, 0xb9, 0x87, 0xde, 0xed, 0xe5, 0x41, 0x16, 0xda, 0x8b,
-	0x46, 0x85, 0x0b, 0xca, 0x81, 0x35, 0xa5, 0x37, 0x58, 0x08, 0x08, 0x6f, 0xde, 0x4a, 0xd8, 0x69,
-	0xcb, 0x4b, 0x8b, 0x1e, 0x93, 0x49, 0xcf, 0xf2, 0x46, 0xf9, 0x7f, 0x76, 0xca, 0x00, 0x5d, 0x8e,
-	0x4e, 0x28, 0xe9, 0xb4, 0x38, 0x2c, 0x95, 0xf6, 0x72, 0x29, 0x00, 0x4d, 0xd1, 0x7d, 0x9d, 0x8e,
-	0x32, 0xcc, 0xb0, 0xb5, 0xa2, 0x71, 0xdd, 0xed, 0xfc, 0x86, 0x5e, 0x6e, 0xda, 0x09, 0xdb, 0x34,
-	0x24, 0xd7, 0x34, 0x86, 0x30, 0x26, 0x33, 0xb2, 0x18, 0xac, 0x63, 0x08, 0x73, 0xa0, 0x49, 0x1a,
-	0xba, 0x67, 0xbb, 0x31, 0xb9, 0xb6, 0xcf, 0xd2, 0xcb, 0x64, 0x44, 0x2f, 0xd4, 0x1e, 0x61, 0xd7,
-	0x83, 0xdd, 0x21, 0x79, 0xa2, 0x43, 0xb0, 0x7a, 0x6b, 0xfc, 0x38, 0x9e, 0x91, 0xc5, 0xd5, 0xc3,
-	0x84, 0x77, 0x79, 0x78, 0x93, 0x87, 0xf7, 0x79, 0x78, 0x8a, 0xa6, 0x58, 0x0d, 0x8e, 0x3f, 0xb7,
-	0xd1, 0xba, 0xc7, 0x57, 0x2f, 0xc7, 0x8a, 0x91, 0x53, 0xc5, 0xc8, 0x6f, 0xc5, 0xc8, 0x57, 0xcd,
-	0xa2, 0x53, 0xcd, 0xa2, 0xef, 0x9a, 0x45, 0xaf, 0xf7, 0x99, 0xf1, 0xef, 0x41, 0x71, 0xc0, 0x5c,
-	0x9c, 0x75, 0xf4, 0x71, 0xde, 0x92, 0xff, 0x2c, 0xb5, 0x53, 0xc3, 0x76, 0xa1, 0xc7, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x69, 0xac, 0xb1, 0xfe, 0x4f, 0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcf, 0x4e, 0x2a, 0x31,
+	0x18, 0xc5, 0xa7, 0x5c, 0x2e, 0x09, 0xbd, 0x57, 0x16, 0x0d, 0x8b, 0x01, 0x93, 0x4a, 0x88, 0x26,
+	0xc4, 0x98, 0x36, 0xe8, 0xc2, 0xb5, 0x8c, 0xf1, 0x01, 0x08, 0x2b, 0x37, 0xa4, 0xd3, 0xa9, 0xd0,
+	0xc0, 0xcc, 0x47, 0xfa, 0x87, 0xe8, 0x5b, 0xf8, 0x28, 0x3e, 0x06, 0x4b, 0x96, 0xae, 0x8c, 0x81,
+	0x17, 0x31, 0x33, 0x9d, 0x85, 0xac, 0xbe, 0xd3, 0x5f, 0x4f, 0xdb, 0xd3, 0x83, 0xaf, 0xd7, 0x62,
+	0x2b, 0x0a, 0xe5, 0x78, 0x39, 0xb9, 0xf5, 0xa9, 0x95, 0x46, 0x6f, 0x9c, 0x86, 0x82, 0x4b, 0x3f,
+	0x77, 0x46, 0xc8, 0x95, 0x32, 0x6c, 0x63, 0xc0, 0x01, 0xe9, 0xd5, 0x5e, 0x56, 0x4e, 0xf6, 0xdb,
+	0xdb, 0xa7, 0x12, 0x6c, 0x0e, 0x96, 0xa7, 0xc2, 0x2a, 0xbe, 0x1d, 0xa7, 0xca, 0x89, 0x31, 0x97,
+	0xa0, 0x8b, 0x70, 0xb4, 0xdf, 0x5d, 0xc0, 0x02, 0x2a, 0xc9, 0x4b, 0x15, 0xe8, 0xf0, 0x1c, 0xb7,
+	0x67, 0xd5, 0x0b, 0x59, 0xe2, 0x49, 0x07, 0x37, 0xa4, 0x8f, 0xd1, 0x00, 0x8d, 0x9a, 0xd3, 0x86,
+	0xf4, 0xc3, 0x0f, 0x84, 0x49, 0xe2, 0xc3, 0xbe, 0x99, 0xe9, 0x5c, 0x99, 0x47, 0xe1, 0x04, 0xe9,
+	0xe2, 0xbf, 0xe9, 0x1a, 0xe4, 0xaa, 0x76, 0x86, 0x05, 0xb9, 0xc7, 0x2d, 0x69, 0x54, 0xa6, 0x5d,
+	0xdc, 0x18, 0xa0, 0xd1, 0xbf, 0xdb, 0x1e, 0x0b, 0x81, 0x58, 0x19, 0x88, 0xd5, 0x81, 0x58, 0x02,
+	0xba, 0x98, 0x34, 0x77, 0x5f, 0x17, 0xd1, 0xb4, 0xb6, 0x93, 0x4b, 0xdc, 0xc9, 0xa1, 0x70, 0xcb,
+	0x79, 0xf9, 0x5b, 0x70, 0x62, 0x1d, 0xff, 0xa9, 0xee, 0xfd, 0x5f, 0xd1, 0xc4, 0xcf, 0x4a, 0x46,
+	0xae, 0x70, 0xc7, 0xa8, 0x17, 0x5f, 0x64, 0x73, 0x91, 0x65, 0x46, 0x59, 0x1b, 0x37, 0x07, 0x68,
+	0xd4, 0x9e, 0x9e, 0x05, 0xfa, 0x10, 0xe0, 0xe4, 0x69, 0x77, 0xa0, 0x68, 0x7f, 0xa0, 0xe8, 0xfb,
+	0x40, 0xd1, 0xfb, 0x91, 0x46, 0xfb, 0x23, 0x8d, 0x3e, 0x8f, 0x34, 0x7a, 0xbe, 0x59, 0x68, 0xb7,
+	0xf4, 0x29, 0x93, 0x90, 0xf3, 0x93, 0xc6, 0x5f, 0x4f, 0x3b, 0x77, 0x6f, 0x1b, 0x65, 0xd3, 0x56,
+	0x55, 0xcf, 0xdd, 0xcf, 0x00, 0xd6, 0xfa, 0x49, 0xf3, 0x9d, 0x01, 0x00, 0x00,
 }
 
 func (m *TrackedCu) Marshal() (dAtA []byte, err error) {
@@ -198,6 +217,18 @@ func (m *CuTrackerTimerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintCuTracker(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MonthCuTotal != 0 {
+		i = encodeVarintCuTracker(dAtA, i, uint64(m.MonthCuTotal))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -250,6 +281,13 @@ func (m *CuTrackerTimerData) Size() (n int) {
 	}
 	l = m.Credit.Size()
 	n += 1 + l + sovCuTracker(uint64(l))
+	if m.MonthCuTotal != 0 {
+		n += 1 + sovCuTracker(uint64(m.MonthCuTotal))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovCuTracker(uint64(l))
+	}
 	return n
 }
 
@@ -409,6 +447,57 @@ func (m *CuTrackerTimerData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuTotal", wireType)
+			}
+			m.MonthCuTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCuTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCuTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCuTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCuTracker(dAtA[iNdEx:])
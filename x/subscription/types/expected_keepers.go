@@ -22,6 +22,7 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderPool, recipientPool string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
@@ -40,6 +41,7 @@ type ProjectsKeeper interface {
 	DeleteProject(ctx sdk.Context, creator, index string) error
 	SnapshotSubscriptionProjects(ctx sdk.Context, subscriptionAddr string, block uint64)
 	GetAllProjectsForSubscription(ctx sdk.Context, subscription string) []string
+	TransferSubscriptionProjects(ctx sdk.Context, subscriptionAddr, newSubscriptionAddr string) error
 	// Methods imported from projectskeeper should be defined here
 }
 
@@ -27,12 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the subscription module's genesis state.
 type GenesisState struct {
-	Params      Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SubsFS      types.GenesisState  `protobuf:"bytes,2,opt,name=subsFS,proto3" json:"subsFS"`
-	SubsTS      types1.GenesisState `protobuf:"bytes,3,opt,name=subsTS,proto3" json:"subsTS"`
-	CuTrackerFS types.GenesisState  `protobuf:"bytes,4,opt,name=cuTrackerFS,proto3" json:"cuTrackerFS"`
-	CuTrackerTS types1.GenesisState `protobuf:"bytes,5,opt,name=cuTrackerTS,proto3" json:"cuTrackerTS"`
-	Adjustments []Adjustment        `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	Params           Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SubsFS           types.GenesisState     `protobuf:"bytes,2,opt,name=subsFS,proto3" json:"subsFS"`
+	SubsTS           types1.GenesisState    `protobuf:"bytes,3,opt,name=subsTS,proto3" json:"subsTS"`
+	CuTrackerFS      types.GenesisState     `protobuf:"bytes,4,opt,name=cuTrackerFS,proto3" json:"cuTrackerFS"`
+	CuTrackerTS      types1.GenesisState    `protobuf:"bytes,5,opt,name=cuTrackerTS,proto3" json:"cuTrackerTS"`
+	Adjustments      []Adjustment           `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	Overuses         []Overuse              `protobuf:"bytes,7,rep,name=overuses,proto3" json:"overuses"`
+	OveruseDeposits  []OveruseDeposit       `protobuf:"bytes,8,rep,name=overuse_deposits,json=overuseDeposits,proto3" json:"overuse_deposits"`
+	PendingTransfers []SubscriptionTransfer `protobuf:"bytes,9,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +113,27 @@ func (m *GenesisState) GetAdjustments() []Adjustment {
 	return nil
 }
 
+func (m *GenesisState) GetOveruses() []Overuse {
+	if m != nil {
+		return m.Overuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOveruseDeposits() []OveruseDeposit {
+	if m != nil {
+		return m.OveruseDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTransfers() []SubscriptionTransfer {
+	if m != nil {
+		return m.PendingTransfers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.subscription.GenesisState")
 }
@@ -119,29 +143,35 @@ func init() {
 }
 
 var fileDescriptor_dc6c60f9c112fe52 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x4e, 0xf3, 0x30,
-	0x18, 0x86, 0x93, 0xbf, 0xfd, 0x33, 0xa4, 0x4c, 0x11, 0x43, 0xe8, 0x10, 0x0a, 0x08, 0x28, 0x08,
-	0x39, 0x12, 0x1c, 0x00, 0x51, 0xa4, 0x30, 0x21, 0x55, 0x24, 0x13, 0x9b, 0x1b, 0x4c, 0x30, 0x90,
-	0x38, 0xb2, 0x1d, 0x54, 0x6e, 0xc1, 0xb1, 0x3a, 0x30, 0x74, 0x64, 0x42, 0xa8, 0xb9, 0x08, 0xb2,
-	0x9d, 0xb6, 0xb6, 0x50, 0x06, 0x98, 0xec, 0x48, 0xcf, 0xfb, 0xe4, 0xfd, 0x92, 0xcf, 0x3d, 0x7c,
-	0x86, 0x2f, 0xb0, 0x40, 0x3c, 0x14, 0x67, 0xc8, 0xaa, 0x09, 0x4b, 0x29, 0x2e, 0x39, 0x26, 0x45,
-	0x98, 0xa1, 0x02, 0x31, 0xcc, 0x40, 0x49, 0x09, 0x27, 0xde, 0x56, 0x03, 0x02, 0x71, 0x02, 0x1d,
-	0xec, 0x6f, 0x66, 0x24, 0x23, 0x92, 0x0a, 0xc5, 0x4d, 0x05, 0xfa, 0x07, 0xed, 0xe6, 0x12, 0x52,
-	0x98, 0x37, 0xe2, 0xfe, 0x71, 0x3b, 0x07, 0xef, 0x1e, 0x2b, 0xc6, 0x73, 0x54, 0xf0, 0x86, 0x3d,
-	0x32, 0xd8, 0x7b, 0x3c, 0x85, 0x82, 0x63, 0x9c, 0x50, 0xb4, 0x7a, 0x6a, 0xd0, 0x3d, 0x03, 0xe5,
-	0x38, 0x47, 0x54, 0x71, 0xf2, 0xaa, 0xa0, 0xdd, 0xf7, 0x8e, 0xbb, 0x71, 0xa5, 0xc6, 0x8c, 0x39,
-	0xe4, 0xc8, 0x3b, 0x77, 0x1d, 0x55, 0xce, 0xb7, 0x07, 0xf6, 0xb0, 0x77, 0xba, 0x03, 0x5a, 0xc7,
-	0x06, 0x63, 0x09, 0x8e, 0xba, 0xb3, 0xcf, 0x6d, 0xeb, 0xa6, 0x89, 0x79, 0x91, 0xeb, 0x08, 0x28,
-	0x8a, 0xfd, 0x7f, 0x52, 0x30, 0x34, 0x05, 0x46, 0x65, 0xa0, 0xbf, 0x7a, 0xe9, 0x51, 0x69, 0xef,
-	0x52, 0x79, 0x92, 0xd8, 0xef, 0x48, 0xcf, 0xbe, 0xe9, 0x59, 0xcf, 0xd3, 0x2a, 0x49, 0x62, 0x6f,
-	0xec, 0xf6, 0xd2, 0x2a, 0xa1, 0x30, 0x7d, 0x42, 0x34, 0x8a, 0xfd, 0xee, 0x9f, 0x1a, 0xe9, 0x0a,
-	0xef, 0x5a, 0x33, 0x26, 0xb1, 0xff, 0xff, 0xf7, 0xdd, 0xf4, 0xbc, 0xd0, 0xad, 0xff, 0x31, 0xf3,
-	0x9d, 0x41, 0xe7, 0xa7, 0xce, 0xf8, 0xe6, 0x17, 0x2b, 0x7a, 0xa9, 0xd3, 0xf2, 0xa3, 0x68, 0xb6,
-	0x08, 0xec, 0xf9, 0x22, 0xb0, 0xbf, 0x16, 0x81, 0xfd, 0x56, 0x07, 0xd6, 0xbc, 0x0e, 0xac, 0x8f,
-	0x3a, 0xb0, 0x6e, 0x4f, 0x32, 0xcc, 0x1f, 0xaa, 0x09, 0x48, 0x49, 0x1e, 0x1a, 0x8b, 0x31, 0x35,
-	0x37, 0x8e, 0xbf, 0x96, 0x88, 0x4d, 0x1c, 0xb9, 0x1d, 0x67, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xeb, 0x1a, 0x89, 0x93, 0x1d, 0x03, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6e, 0xd4, 0x30,
+	0x14, 0x87, 0x13, 0xda, 0x86, 0xe2, 0x22, 0x51, 0x2c, 0x16, 0x66, 0x16, 0xa1, 0x14, 0x01, 0x53,
+	0x84, 0x12, 0x09, 0x0e, 0x80, 0x28, 0x55, 0x58, 0x21, 0x2a, 0x92, 0x55, 0x37, 0x95, 0x93, 0x71,
+	0x83, 0x81, 0xc4, 0x91, 0x9f, 0x53, 0x95, 0x5b, 0x70, 0xac, 0x2e, 0x2b, 0x56, 0xac, 0x10, 0x9a,
+	0xb9, 0x08, 0x8a, 0xed, 0xcc, 0xc4, 0xa0, 0x0c, 0xa2, 0x2b, 0xff, 0xd1, 0xf7, 0xfb, 0xfc, 0x6c,
+	0xf9, 0xa1, 0xa7, 0x5f, 0xe8, 0x39, 0xad, 0x99, 0x8a, 0xbb, 0x31, 0x86, 0x36, 0x87, 0x42, 0xf2,
+	0x46, 0x71, 0x51, 0xc7, 0x25, 0xab, 0x19, 0x70, 0x88, 0x1a, 0x29, 0x94, 0xc0, 0xf7, 0x2d, 0x18,
+	0x75, 0x63, 0x34, 0x04, 0x27, 0xf7, 0x4a, 0x51, 0x0a, 0x4d, 0xc5, 0xdd, 0xcc, 0x04, 0x26, 0x4f,
+	0xc6, 0xcd, 0x0d, 0x95, 0xb4, 0xb2, 0xe2, 0xc9, 0xb3, 0x71, 0x8e, 0xce, 0x3e, 0xb5, 0xa0, 0x2a,
+	0x56, 0x2b, 0xcb, 0xae, 0xa9, 0x56, 0x9c, 0x33, 0xd9, 0x02, 0xb3, 0xe0, 0x74, 0x1c, 0x54, 0x92,
+	0xd6, 0x70, 0xc6, 0xa4, 0x25, 0x0f, 0x1c, 0xf2, 0x8c, 0x5f, 0xd0, 0x8e, 0x02, 0x25, 0x24, 0x5b,
+	0xae, 0x2c, 0xfa, 0xc8, 0x41, 0x15, 0xaf, 0x98, 0x34, 0x9c, 0x9e, 0x1a, 0x68, 0xff, 0xfb, 0x16,
+	0xba, 0xfd, 0xd6, 0xbc, 0x5c, 0xaa, 0xa8, 0x62, 0xf8, 0x15, 0x0a, 0xcc, 0x7d, 0x89, 0xbf, 0xe7,
+	0x4f, 0x77, 0x5e, 0x3c, 0x8c, 0x46, 0x5f, 0x32, 0x3a, 0xd6, 0xe0, 0xe1, 0xe6, 0xe5, 0xcf, 0x07,
+	0xde, 0x07, 0x1b, 0xc3, 0x09, 0x0a, 0x3a, 0x28, 0x49, 0xc9, 0x0d, 0x2d, 0x98, 0xba, 0x02, 0xa7,
+	0xe4, 0x68, 0x78, 0x74, 0xef, 0x31, 0x69, 0xfc, 0xc6, 0x78, 0xb2, 0x94, 0x6c, 0x68, 0xcf, 0x63,
+	0xd7, 0xb3, 0xba, 0xcf, 0xa8, 0x24, 0x4b, 0xf1, 0x31, 0xda, 0x29, 0xda, 0x4c, 0xd2, 0xe2, 0x33,
+	0x93, 0x49, 0x4a, 0x36, 0xaf, 0x55, 0xd1, 0x50, 0x81, 0xdf, 0x0d, 0x8c, 0x59, 0x4a, 0xb6, 0xfe,
+	0xbf, 0xb6, 0x61, 0xbe, 0xd3, 0xad, 0xbe, 0x0d, 0x90, 0x60, 0x6f, 0xe3, 0x6f, 0x9d, 0xf3, 0xe6,
+	0xaf, 0x97, 0x74, 0xaf, 0x1b, 0xe4, 0xf1, 0x11, 0xda, 0xb6, 0x3f, 0x0b, 0xc8, 0x4d, 0xed, 0xda,
+	0x5f, 0xe3, 0x7a, 0x6f, 0x50, 0x2b, 0x5a, 0x26, 0xf1, 0x09, 0xda, 0xb5, 0xf3, 0xd3, 0x19, 0x6b,
+	0x04, 0x70, 0x05, 0x64, 0x5b, 0xdb, 0x0e, 0xfe, 0x6d, 0x3b, 0x32, 0x09, 0x2b, 0xbd, 0x23, 0x9c,
+	0x5d, 0xc0, 0x39, 0xba, 0xdb, 0xb0, 0x7a, 0xc6, 0xeb, 0xf2, 0xb4, 0xff, 0xda, 0x40, 0x6e, 0x69,
+	0x79, 0xbc, 0x46, 0x9e, 0x0e, 0x16, 0x99, 0xcd, 0xd9, 0x23, 0x76, 0xad, 0xaf, 0xdf, 0x86, 0xc3,
+	0xe4, 0x72, 0x1e, 0xfa, 0x57, 0xf3, 0xd0, 0xff, 0x35, 0x0f, 0xfd, 0x6f, 0x8b, 0xd0, 0xbb, 0x5a,
+	0x84, 0xde, 0x8f, 0x45, 0xe8, 0x9d, 0x3c, 0x2f, 0xb9, 0xfa, 0xd8, 0xe6, 0x51, 0x21, 0xaa, 0xd8,
+	0x69, 0x8f, 0x8b, 0x3f, 0xba, 0xee, 0x6b, 0xc3, 0x20, 0x0f, 0x74, 0x8f, 0xbc, 0xfc, 0x3d, 0x00,
+	0xa5, 0x21, 0x77, 0x32, 0x76, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +194,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTransfers) > 0 {
+		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.OveruseDeposits) > 0 {
+		for iNdEx := len(m.OveruseDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OveruseDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Overuses) > 0 {
+		for iNdEx := len(m.Overuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Adjustments) > 0 {
 		for iNdEx := len(m.Adjustments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -264,6 +336,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Overuses) > 0 {
+		for _, e := range m.Overuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OveruseDeposits) > 0 {
+		for _, e := range m.OveruseDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTransfers) > 0 {
+		for _, e := range m.PendingTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +591,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overuses = append(m.Overuses, Overuse{})
+			if err := m.Overuses[len(m.Overuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OveruseDeposits = append(m.OveruseDeposits, OveruseDeposit{})
+			if err := m.OveruseDeposits[len(m.OveruseDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransfers = append(m.PendingTransfers, SubscriptionTransfer{})
+			if err := m.PendingTransfers[len(m.PendingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,16 @@
+package types
+
+import "strconv"
+
+const (
+	// OveruseKeyPrefix is the prefix to retrieve all Overuse
+	OveruseKeyPrefix = "Overuse/value/"
+
+	// OveruseDepositKeyPrefix is the prefix to retrieve all OveruseDeposit
+	OveruseDepositKeyPrefix = "OveruseDeposit/value/"
+)
+
+// OveruseKey encodes a key using the subscription's consumer address and the sub block of the month
+func OveruseKey(consumer string, block uint64) []byte {
+	return []byte(consumer + " " + strconv.FormatUint(block, 10))
+}
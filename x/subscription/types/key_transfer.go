@@ -0,0 +1,6 @@
+package types
+
+const (
+	// SubscriptionTransferKeyPrefix is the prefix to retrieve all pending SubscriptionTransfer
+	SubscriptionTransferKeyPrefix = "SubscriptionTransfer/value/"
+)
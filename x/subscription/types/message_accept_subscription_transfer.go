@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptSubscriptionTransfer = "accept_subscription_transfer"
+
+var _ sdk.Msg = &MsgAcceptSubscriptionTransfer{}
+
+func NewMsgAcceptSubscriptionTransfer(creator, consumer string) *MsgAcceptSubscriptionTransfer {
+	return &MsgAcceptSubscriptionTransfer{
+		Creator:  creator,
+		Consumer: consumer,
+	}
+}
+
+func (msg *MsgAcceptSubscriptionTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptSubscriptionTransfer) Type() string {
+	return TypeMsgAcceptSubscriptionTransfer
+}
+
+func (msg *MsgAcceptSubscriptionTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptSubscriptionTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptSubscriptionTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	return nil
+}
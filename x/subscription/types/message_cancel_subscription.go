@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelSubscription = "cancel_subscription"
+
+var _ sdk.Msg = &MsgCancelSubscription{}
+
+func NewMsgCancelSubscription(creator, consumer string) *MsgCancelSubscription {
+	return &MsgCancelSubscription{
+		Creator:  creator,
+		Consumer: consumer,
+	}
+}
+
+func (msg *MsgCancelSubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelSubscription) Type() string {
+	return TypeMsgCancelSubscription
+}
+
+func (msg *MsgCancelSubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelSubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	return nil
+}
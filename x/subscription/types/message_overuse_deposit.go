@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgOveruseDeposit = "overuse_deposit"
+
+var _ sdk.Msg = &MsgOveruseDeposit{}
+
+func NewMsgOveruseDeposit(creator, consumer string, amount sdk.Coin) *MsgOveruseDeposit {
+	return &MsgOveruseDeposit{
+		Creator:  creator,
+		Consumer: consumer,
+		Amount:   amount,
+	}
+}
+
+func (msg *MsgOveruseDeposit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOveruseDeposit) Type() string {
+	return TypeMsgOveruseDeposit
+}
+
+func (msg *MsgOveruseDeposit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgOveruseDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOveruseDeposit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidCoins, "invalid deposit amount (%s)", msg.Amount)
+	}
+
+	return nil
+}
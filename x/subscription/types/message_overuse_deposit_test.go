@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOveruseDeposit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgOveruseDeposit
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgOveruseDeposit{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+				Amount:   sdk.NewInt64Coin("ulava", 100),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgOveruseDeposit{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+				Amount:   sdk.NewInt64Coin("ulava", 100),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			msg: MsgOveruseDeposit{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Amount:   sdk.NewInt64Coin("ulava", 0),
+			},
+			err: legacyerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgOveruseDeposit{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Amount:   sdk.NewInt64Coin("ulava", 100),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTopUpSubscription = "top_up_subscription"
+
+var _ sdk.Msg = &MsgTopUpSubscription{}
+
+func NewMsgTopUpSubscription(creator, consumer string, duration uint64, credit sdk.Coin) *MsgTopUpSubscription {
+	return &MsgTopUpSubscription{
+		Creator:  creator,
+		Consumer: consumer,
+		Duration: duration,
+		Credit:   credit,
+	}
+}
+
+func (msg *MsgTopUpSubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTopUpSubscription) Type() string {
+	return TypeMsgTopUpSubscription
+}
+
+func (msg *MsgTopUpSubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTopUpSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTopUpSubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	if !msg.Credit.IsValid() {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidCoins, "invalid credit (%s)", msg.Credit)
+	}
+
+	if msg.Duration == 0 && msg.Credit.IsZero() {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "top up must add duration or credit")
+	}
+
+	if msg.Duration > MAX_SUBSCRIPTION_DURATION {
+		return sdkerrors.Wrapf(ErrInvalidParameter, "invalid top up duration (%d)", msg.Duration)
+	}
+
+	return nil
+}
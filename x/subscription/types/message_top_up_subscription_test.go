@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTopUpSubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgTopUpSubscription
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgTopUpSubscription{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+				Duration: 1,
+				Credit:   sdk.NewInt64Coin("ulava", 0),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgTopUpSubscription{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+				Duration: 1,
+				Credit:   sdk.NewInt64Coin("ulava", 0),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no duration and no credit",
+			msg: MsgTopUpSubscription{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Credit:   sdk.NewInt64Coin("ulava", 0),
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duration too long",
+			msg: MsgTopUpSubscription{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Duration: MAX_SUBSCRIPTION_DURATION + 1,
+				Credit:   sdk.NewInt64Coin("ulava", 0),
+			},
+			err: ErrInvalidParameter,
+		},
+		{
+			name: "valid duration",
+			msg: MsgTopUpSubscription{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Duration: 1,
+				Credit:   sdk.NewInt64Coin("ulava", 0),
+			},
+		},
+		{
+			name: "valid credit",
+			msg: MsgTopUpSubscription{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Credit:   sdk.NewInt64Coin("ulava", 100),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
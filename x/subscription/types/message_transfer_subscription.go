@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferSubscription = "transfer_subscription"
+
+var _ sdk.Msg = &MsgTransferSubscription{}
+
+func NewMsgTransferSubscription(creator, newConsumer string) *MsgTransferSubscription {
+	return &MsgTransferSubscription{
+		Creator:     creator,
+		NewConsumer: newConsumer,
+	}
+}
+
+func (msg *MsgTransferSubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferSubscription) Type() string {
+	return TypeMsgTransferSubscription
+}
+
+func (msg *MsgTransferSubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferSubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.NewConsumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid new consumer address (%s)", err)
+	}
+
+	if msg.NewConsumer == msg.Creator {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "cannot transfer subscription to its own consumer")
+	}
+
+	return nil
+}
@@ -0,0 +1,673 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/subscription/overuse.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Overuse struct {
+	Consumer string     `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Block    uint64     `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Cu       uint64     `protobuf:"varint,3,opt,name=cu,proto3" json:"cu,omitempty"`
+	Charged  types.Coin `protobuf:"bytes,4,opt,name=charged,proto3" json:"charged"`
+}
+
+func (m *Overuse) Reset()         { *m = Overuse{} }
+func (m *Overuse) String() string { return proto.CompactTextString(m) }
+func (*Overuse) ProtoMessage()    {}
+func (*Overuse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b38c47033c1a88f, []int{0}
+}
+func (m *Overuse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Overuse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Overuse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Overuse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Overuse.Merge(m, src)
+}
+func (m *Overuse) XXX_Size() int {
+	return m.Size()
+}
+func (m *Overuse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Overuse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Overuse proto.InternalMessageInfo
+
+func (m *Overuse) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *Overuse) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *Overuse) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func (m *Overuse) GetCharged() types.Coin {
+	if m != nil {
+		return m.Charged
+	}
+	return types.Coin{}
+}
+
+type OveruseDeposit struct {
+	Consumer string     `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *OveruseDeposit) Reset()         { *m = OveruseDeposit{} }
+func (m *OveruseDeposit) String() string { return proto.CompactTextString(m) }
+func (*OveruseDeposit) ProtoMessage()    {}
+func (*OveruseDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b38c47033c1a88f, []int{1}
+}
+func (m *OveruseDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OveruseDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OveruseDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OveruseDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OveruseDeposit.Merge(m, src)
+}
+func (m *OveruseDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OveruseDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OveruseDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OveruseDeposit proto.InternalMessageInfo
+
+func (m *OveruseDeposit) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *OveruseDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Overuse)(nil), "lavanet.lava.subscription.Overuse")
+	proto.RegisterType((*OveruseDeposit)(nil), "lavanet.lava.subscription.OveruseDeposit")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/subscription/overuse.proto", fileDescriptor_1b38c47033c1a88f)
+}
+
+var fileDescriptor_1b38c47033c1a88f = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbb, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0xe3, 0x50, 0x5a, 0x30, 0x52, 0x87, 0xa8, 0x43, 0xda, 0xc1, 0x54, 0x5d, 0xe8, 0x80,
+	0x6c, 0x15, 0x06, 0xc4, 0x5a, 0x10, 0x2b, 0x52, 0x47, 0x36, 0xdb, 0x58, 0xa9, 0x45, 0xe3, 0x13,
+	0xc5, 0x76, 0x04, 0x2f, 0xc0, 0xcc, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x22, 0x28, 0x17,
+	0x10, 0x5d, 0x10, 0xd3, 0xb9, 0xe8, 0xb3, 0xce, 0x27, 0xff, 0xf8, 0x6c, 0xc3, 0x0b, 0x6e, 0x94,
+	0x63, 0x75, 0x65, 0xd6, 0x0b, 0x2b, 0x73, 0x9d, 0x39, 0x0d, 0x86, 0x41, 0xa1, 0x72, 0x6f, 0x15,
+	0xcd, 0x72, 0x70, 0x10, 0x8d, 0x3b, 0x90, 0xd6, 0x95, 0xfe, 0x06, 0x27, 0x44, 0x82, 0x4d, 0xc1,
+	0x32, 0xc1, 0xad, 0x62, 0xc5, 0x42, 0x28, 0xc7, 0x17, 0x4c, 0x82, 0x36, 0xed, 0xd3, 0xc9, 0x28,
+	0x81, 0x04, 0x9a, 0x96, 0xd5, 0x5d, 0xbb, 0x9d, 0xbd, 0x22, 0x3c, 0xb8, 0x6f, 0x4f, 0x44, 0x13,
+	0x7c, 0x24, 0xc1, 0x58, 0x9f, 0xaa, 0x3c, 0x46, 0x53, 0x34, 0x3f, 0x5e, 0xfd, 0xcc, 0xd1, 0x08,
+	0x1f, 0x8a, 0x0d, 0xc8, 0xa7, 0x38, 0x9c, 0xa2, 0x79, 0x6f, 0xd5, 0x0e, 0xd1, 0x10, 0x87, 0xd2,
+	0xc7, 0x07, 0xcd, 0x2a, 0x94, 0x3e, 0xba, 0xc6, 0x03, 0xb9, 0xe6, 0x79, 0xa2, 0x1e, 0xe3, 0xde,
+	0x14, 0xcd, 0x4f, 0x2e, 0xc6, 0xb4, 0xb5, 0xa2, 0xb5, 0x15, 0xed, 0xac, 0xe8, 0x0d, 0x68, 0xb3,
+	0xec, 0x6d, 0x3f, 0x4e, 0x83, 0xd5, 0x37, 0x3f, 0x53, 0x78, 0xd8, 0x79, 0xdc, 0xaa, 0x0c, 0xac,
+	0x76, 0x7f, 0xea, 0x5c, 0xe1, 0x3e, 0x4f, 0xc1, 0x1b, 0x17, 0x87, 0xff, 0xbb, 0xd3, 0xe1, 0xcb,
+	0xbb, 0x6d, 0x49, 0xd0, 0xae, 0x24, 0xe8, 0xb3, 0x24, 0xe8, 0xad, 0x22, 0xc1, 0xae, 0x22, 0xc1,
+	0x7b, 0x45, 0x82, 0x87, 0xf3, 0x44, 0xbb, 0xb5, 0x17, 0x54, 0x42, 0xca, 0xf6, 0xe2, 0x78, 0xde,
+	0x0f, 0xc4, 0xbd, 0x64, 0xca, 0x8a, 0x7e, 0xf3, 0x7d, 0x97, 0x5f, 0x03, 0x00, 0xf3, 0x7a, 0x7a,
+	0xc7, 0xba, 0x01, 0x00, 0x00,
+}
+
+func (m *Overuse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Overuse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Overuse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Charged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOveruse(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Cu != 0 {
+		i = encodeVarintOveruse(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != 0 {
+		i = encodeVarintOveruse(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintOveruse(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OveruseDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OveruseDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OveruseDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOveruse(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintOveruse(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOveruse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOveruse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Overuse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovOveruse(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovOveruse(uint64(m.Block))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovOveruse(uint64(m.Cu))
+	}
+	l = m.Charged.Size()
+	n += 1 + l + sovOveruse(uint64(l))
+	return n
+}
+
+func (m *OveruseDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovOveruse(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOveruse(uint64(l))
+	return n
+}
+
+func sovOveruse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOveruse(x uint64) (n int) {
+	return sovOveruse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Overuse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOveruse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Overuse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Overuse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Charged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOveruse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OveruseDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOveruse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OveruseDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OveruseDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOveruse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOveruse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOveruse(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOveruse
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOveruse
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOveruse
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOveruse
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOveruse
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOveruse        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOveruse          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOveruse = fmt.Errorf("proto: unexpected end of group")
+)
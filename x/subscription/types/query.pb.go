@@ -634,6 +634,142 @@ func (m *QueryNextToMonthExpiryResponse) GetSubscriptions() []TimerExpiryInfo {
 	return nil
 }
 
+type QueryOveruseRequest struct {
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (m *QueryOveruseRequest) Reset()         { *m = QueryOveruseRequest{} }
+func (m *QueryOveruseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOveruseRequest) ProtoMessage()    {}
+func (*QueryOveruseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{12}
+}
+func (m *QueryOveruseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOveruseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOveruseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOveruseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOveruseRequest.Merge(m, src)
+}
+func (m *QueryOveruseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOveruseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOveruseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOveruseRequest proto.InternalMessageInfo
+
+func (m *QueryOveruseRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+type QueryOveruseResponse struct {
+	AllowOveruse        bool       `protobuf:"varint,1,opt,name=allow_overuse,json=allowOveruse,proto3" json:"allow_overuse,omitempty"`
+	OveruseRate         uint64     `protobuf:"varint,2,opt,name=overuse_rate,json=overuseRate,proto3" json:"overuse_rate,omitempty"`
+	OveruseCuLimit      uint64     `protobuf:"varint,3,opt,name=overuse_cu_limit,json=overuseCuLimit,proto3" json:"overuse_cu_limit,omitempty"`
+	MonthOveruseCu      uint64     `protobuf:"varint,4,opt,name=month_overuse_cu,json=monthOveruseCu,proto3" json:"month_overuse_cu,omitempty"`
+	MonthOveruseCharged types.Coin `protobuf:"bytes,5,opt,name=month_overuse_charged,json=monthOveruseCharged,proto3" json:"month_overuse_charged"`
+	OveruseCuLeft       uint64     `protobuf:"varint,6,opt,name=overuse_cu_left,json=overuseCuLeft,proto3" json:"overuse_cu_left,omitempty"`
+	Deposit             types.Coin `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *QueryOveruseResponse) Reset()         { *m = QueryOveruseResponse{} }
+func (m *QueryOveruseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOveruseResponse) ProtoMessage()    {}
+func (*QueryOveruseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e870698c9d8ccc09, []int{13}
+}
+func (m *QueryOveruseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOveruseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOveruseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOveruseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOveruseResponse.Merge(m, src)
+}
+func (m *QueryOveruseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOveruseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOveruseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOveruseResponse proto.InternalMessageInfo
+
+func (m *QueryOveruseResponse) GetAllowOveruse() bool {
+	if m != nil {
+		return m.AllowOveruse
+	}
+	return false
+}
+
+func (m *QueryOveruseResponse) GetOveruseRate() uint64 {
+	if m != nil {
+		return m.OveruseRate
+	}
+	return 0
+}
+
+func (m *QueryOveruseResponse) GetOveruseCuLimit() uint64 {
+	if m != nil {
+		return m.OveruseCuLimit
+	}
+	return 0
+}
+
+func (m *QueryOveruseResponse) GetMonthOveruseCu() uint64 {
+	if m != nil {
+		return m.MonthOveruseCu
+	}
+	return 0
+}
+
+func (m *QueryOveruseResponse) GetMonthOveruseCharged() types.Coin {
+	if m != nil {
+		return m.MonthOveruseCharged
+	}
+	return types.Coin{}
+}
+
+func (m *QueryOveruseResponse) GetOveruseCuLeft() uint64 {
+	if m != nil {
+		return m.OveruseCuLeft
+	}
+	return 0
+}
+
+func (m *QueryOveruseResponse) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.subscription.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.subscription.QueryParamsResponse")
@@ -647,6 +783,8 @@ func init() {
 	proto.RegisterType((*QueryNextToMonthExpiryRequest)(nil), "lavanet.lava.subscription.QueryNextToMonthExpiryRequest")
 	proto.RegisterType((*TimerExpiryInfo)(nil), "lavanet.lava.subscription.TimerExpiryInfo")
 	proto.RegisterType((*QueryNextToMonthExpiryResponse)(nil), "lavanet.lava.subscription.QueryNextToMonthExpiryResponse")
+	proto.RegisterType((*QueryOveruseRequest)(nil), "lavanet.lava.subscription.QueryOveruseRequest")
+	proto.RegisterType((*QueryOveruseResponse)(nil), "lavanet.lava.subscription.QueryOveruseResponse")
 }
 
 func init() {
@@ -654,64 +792,74 @@ func init() {
 }
 
 var fileDescriptor_e870698c9d8ccc09 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0xae, 0xe3, 0x3c, 0x3b, 0x69, 0x3b, 0x89, 0xd0, 0xc6, 0x02, 0x27, 0xd9, 0x52,
-	0xd2, 0x96, 0x74, 0x57, 0x4e, 0x40, 0xa1, 0x17, 0x2a, 0x25, 0xa2, 0x12, 0x28, 0xa0, 0xe0, 0x46,
-	0x45, 0xe2, 0xc0, 0x6a, 0xbd, 0x1d, 0x3b, 0x8b, 0xec, 0x9d, 0xed, 0xfc, 0x28, 0x8e, 0xaa, 0x5e,
-	0x38, 0xf6, 0x84, 0xe0, 0x2f, 0xe0, 0xdf, 0xe0, 0xc6, 0xad, 0x27, 0x54, 0x89, 0x0b, 0x27, 0x84,
-	0x12, 0xfe, 0x10, 0x34, 0x6f, 0x67, 0xcd, 0x6e, 0x53, 0xaf, 0x0d, 0x27, 0xef, 0x7c, 0xf3, 0xbd,
-	0xef, 0x7d, 0xf3, 0xe6, 0xcd, 0x93, 0xe1, 0xe6, 0x20, 0x78, 0x1a, 0xc4, 0x54, 0x7a, 0xfa, 0xd7,
-	0x13, 0xaa, 0x2b, 0x42, 0x1e, 0x25, 0x32, 0x62, 0xb1, 0xf7, 0x44, 0x51, 0x7e, 0xe6, 0x26, 0x9c,
-	0x49, 0x46, 0xd6, 0x0d, 0xcd, 0xd5, 0xbf, 0x6e, 0x9e, 0xd6, 0x5c, 0xeb, 0xb3, 0x3e, 0x43, 0x96,
-	0xa7, 0xbf, 0xd2, 0x80, 0xe6, 0xdb, 0x7d, 0xc6, 0xfa, 0x03, 0xea, 0x05, 0x49, 0xe4, 0x05, 0x71,
-	0xcc, 0x64, 0xa0, 0xc9, 0xc2, 0xec, 0xde, 0x09, 0x99, 0x18, 0x32, 0xe1, 0x75, 0x03, 0x41, 0xd3,
-	0x3c, 0xde, 0xd3, 0x76, 0x97, 0xca, 0xa0, 0xed, 0x25, 0x41, 0x3f, 0x8a, 0x91, 0x6c, 0xb8, 0xef,
-	0x4d, 0x76, 0x98, 0x04, 0x3c, 0x18, 0x66, 0x9a, 0xad, 0xbc, 0x66, 0xa6, 0x16, 0xb2, 0x28, 0xd3,
-	0xd9, 0x99, 0xac, 0x93, 0x5f, 0xa4, 0x6c, 0x67, 0x0d, 0xc8, 0x97, 0xda, 0xd7, 0x31, 0xa6, 0xe8,
-	0xd0, 0x27, 0x8a, 0x0a, 0xe9, 0x3c, 0x82, 0xd5, 0x02, 0x2a, 0x12, 0x16, 0x0b, 0x4a, 0xee, 0x43,
-	0x35, 0xb5, 0x62, 0x5b, 0x9b, 0xd6, 0xad, 0xfa, 0xee, 0x96, 0x3b, 0xb1, 0x5c, 0x6e, 0x1a, 0x7a,
-	0x50, 0x79, 0xf9, 0xe7, 0xc6, 0x5c, 0xc7, 0x84, 0x39, 0x6d, 0xa3, 0x7b, 0xa8, 0x38, 0xa7, 0xb1,
-	0x34, 0xe9, 0x48, 0x13, 0x6a, 0x21, 0x8b, 0x85, 0x1a, 0x52, 0x8e, 0xca, 0x4b, 0x9d, 0xf1, 0xda,
-	0xf9, 0x0a, 0xd6, 0x8a, 0x21, 0x63, 0x2f, 0x0b, 0x42, 0x75, 0x8d, 0x91, 0xed, 0x12, 0x23, 0x0f,
-	0x73, 0x0b, 0xb4, 0x63, 0x75, 0x74, 0xa4, 0xf3, 0x31, 0xd8, 0x28, 0x7c, 0x14, 0x09, 0x79, 0xcc,
-	0xd9, 0xb7, 0x34, 0x94, 0xd9, 0xf9, 0x89, 0x03, 0x8d, 0xbc, 0x86, 0x31, 0x55, 0xc0, 0x9c, 0x7d,
-	0x58, 0x7f, 0x43, 0xbc, 0x71, 0xd7, 0x84, 0x5a, 0x62, 0x30, 0xdb, 0xda, 0x5c, 0xd0, 0x27, 0xca,
-	0xd6, 0x0e, 0x81, 0x6b, 0xe3, 0xc0, 0xac, 0xe0, 0x01, 0x5c, 0xcf, 0x61, 0x46, 0xe4, 0x08, 0x96,
-	0x74, 0x46, 0x3f, 0x8a, 0x7b, 0x0c, 0x55, 0xea, 0xbb, 0xb7, 0x4b, 0x0e, 0xaa, 0x63, 0x3f, 0x8d,
-	0x7b, 0xec, 0xa1, 0xe4, 0x2a, 0x94, 0xa6, 0xf2, 0x35, 0x4d, 0xd1, 0xa8, 0xf3, 0xa2, 0x02, 0x2b,
-	0x45, 0x4a, 0x59, 0xdd, 0x09, 0x81, 0x4a, 0x32, 0x08, 0x62, 0x7b, 0x1e, 0x71, 0xfc, 0x26, 0xdb,
-	0x70, 0xf5, 0xb1, 0xe2, 0xd8, 0xb4, 0x7e, 0x97, 0xa9, 0xfe, 0xa9, 0xb4, 0x17, 0x36, 0xad, 0x5b,
-	0x95, 0xce, 0x4a, 0x06, 0x1f, 0x20, 0x4a, 0x6e, 0xc0, 0xf2, 0x98, 0x38, 0xa0, 0x3d, 0x69, 0x57,
-	0x90, 0xd6, 0xc8, 0xc0, 0x23, 0xda, 0x93, 0x64, 0x0b, 0x1a, 0x43, 0x16, 0xcb, 0x53, 0x9f, 0x8e,
-	0x92, 0x88, 0x9f, 0xd9, 0x57, 0x90, 0x53, 0x47, 0xec, 0x13, 0x84, 0xc8, 0xbb, 0xb0, 0x92, 0x52,
-	0x42, 0xe5, 0x4b, 0x26, 0x83, 0x81, 0x5d, 0x4d, 0x85, 0x10, 0x3d, 0x54, 0x27, 0x1a, 0x23, 0x0e,
-	0x2c, 0x8f, 0x59, 0x98, 0x6d, 0x31, 0xa7, 0x74, 0xa8, 0x30, 0x99, 0x0d, 0x8b, 0xe1, 0x40, 0x09,
-	0x49, 0xb9, 0x5d, 0xc3, 0x13, 0x65, 0x4b, 0x72, 0x13, 0xc6, 0xee, 0x4d, 0x8e, 0x25, 0x0c, 0x1f,
-	0x9f, 0x20, 0x4d, 0xb2, 0x07, 0x6f, 0x05, 0x4a, 0x32, 0x9f, 0xd3, 0x98, 0x7e, 0x17, 0x0c, 0xfc,
-	0x98, 0x8e, 0xa4, 0x8f, 0x15, 0xaa, 0xa3, 0xde, 0xaa, 0xde, 0xed, 0xa4, 0x9b, 0x5f, 0xd0, 0x91,
-	0x3c, 0xd6, 0x05, 0xfb, 0x06, 0x56, 0x7b, 0x4a, 0x2a, 0x4e, 0xfd, 0x42, 0x3b, 0x35, 0xb0, 0x69,
-	0xef, 0x96, 0xdc, 0xe5, 0x03, 0x8c, 0xca, 0xb7, 0x6e, 0x87, 0xf4, 0x2e, 0x61, 0xa4, 0x0d, 0xd5,
-	0x90, 0xd3, 0xc7, 0x91, 0xb4, 0x97, 0x51, 0x72, 0xdd, 0x4d, 0x87, 0x83, 0xab, 0x87, 0x83, 0x6b,
-	0x86, 0x83, 0x7b, 0xc8, 0xa2, 0xb8, 0x63, 0x88, 0x9f, 0x55, 0x6a, 0x70, 0xad, 0xee, 0x6c, 0xc0,
-	0x3b, 0xd8, 0x6f, 0xda, 0xe9, 0x09, 0xfb, 0xfc, 0xdf, 0x92, 0x67, 0x0d, 0x79, 0x0c, 0x57, 0x4f,
-	0xa2, 0x21, 0xe5, 0x29, 0xaa, 0x7b, 0xa6, 0xb4, 0x5b, 0x5e, 0xbf, 0xcb, 0xf9, 0x4b, 0x77, 0xe9,
-	0x8c, 0xa0, 0x35, 0x29, 0xa5, 0xe9, 0xf7, 0x47, 0xb0, 0x9c, 0x2f, 0x82, 0x30, 0x3d, 0x7f, 0xa7,
-	0xa4, 0x4e, 0xaf, 0x79, 0x34, 0x4d, 0x5f, 0x94, 0xd9, 0xfd, 0xad, 0x0a, 0x57, 0x30, 0x35, 0xf9,
-	0xd1, 0x82, 0x6a, 0x3a, 0x98, 0x48, 0x59, 0xf5, 0x2f, 0x4f, 0xc4, 0xa6, 0x3b, 0x2b, 0x3d, 0x3d,
-	0x8b, 0x73, 0xfb, 0xfb, 0xdf, 0xff, 0xfe, 0x69, 0xfe, 0x06, 0xd9, 0xf2, 0xa6, 0x8d, 0x75, 0xf2,
-	0xb3, 0x05, 0x8b, 0x66, 0xba, 0x91, 0xa9, 0x69, 0x8a, 0x93, 0xb3, 0xe9, 0xcd, 0xcc, 0x37, 0xbe,
-	0x3e, 0x44, 0x5f, 0x1e, 0xb9, 0x5b, 0xe2, 0x2b, 0x4c, 0x63, 0xbc, 0x67, 0xd9, 0xf5, 0x3e, 0x27,
-	0xbf, 0x58, 0xd0, 0xc8, 0x0f, 0x3a, 0xb2, 0x37, 0x2d, 0xf1, 0x1b, 0xc6, 0x6a, 0xf3, 0x83, 0xff,
-	0x16, 0x64, 0x2c, 0xdf, 0x47, 0xcb, 0xf7, 0xc8, 0x7e, 0x89, 0xe5, 0x41, 0x24, 0xa4, 0x9f, 0x4d,
-	0x58, 0xef, 0x59, 0x7e, 0xef, 0x39, 0x79, 0x61, 0x41, 0x45, 0x2b, 0x93, 0xf7, 0x67, 0xc9, 0x9f,
-	0x99, 0xdd, 0x99, 0x8d, 0x6c, 0x4c, 0x6e, 0xa3, 0xc9, 0x2d, 0xb2, 0x31, 0xc5, 0x24, 0xf9, 0xd5,
-	0x82, 0xeb, 0x97, 0x9e, 0x00, 0xf9, 0x68, 0x5a, 0xb2, 0x49, 0x0f, 0xb5, 0x79, 0xef, 0x7f, 0x44,
-	0x1a, 0xcf, 0xfb, 0xe8, 0xb9, 0x4d, 0xbc, 0x12, 0xcf, 0x38, 0xe6, 0x24, 0xf3, 0xf3, 0xaf, 0xfb,
-	0xe0, 0xc1, 0xcb, 0xf3, 0x96, 0xf5, 0xea, 0xbc, 0x65, 0xfd, 0x75, 0xde, 0xb2, 0x7e, 0xb8, 0x68,
-	0xcd, 0xbd, 0xba, 0x68, 0xcd, 0xfd, 0x71, 0xd1, 0x9a, 0xfb, 0x7a, 0xa7, 0x1f, 0xc9, 0x53, 0xd5,
-	0x75, 0x43, 0x36, 0x2c, 0x8a, 0x8e, 0x8a, 0xb2, 0xf2, 0x2c, 0xa1, 0xa2, 0x5b, 0xc5, 0xff, 0x20,
-	0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x07, 0x82, 0xce, 0xbb, 0x9d, 0x09, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0xdc, 0x46,
+	0x14, 0xc6, 0x64, 0x81, 0xe5, 0xed, 0x2e, 0x90, 0x81, 0x56, 0x66, 0xd5, 0x2e, 0x60, 0x9a, 0x40,
+	0x52, 0x62, 0x6b, 0xa1, 0x15, 0xe5, 0xd2, 0x48, 0xac, 0x1a, 0xa9, 0x15, 0x6d, 0xa8, 0x41, 0xa9,
+	0xd4, 0x43, 0x2d, 0xaf, 0x99, 0x5d, 0x5c, 0x79, 0x3d, 0x8e, 0x3d, 0x43, 0x16, 0x45, 0xb9, 0xf4,
+	0x98, 0x53, 0xd5, 0xfe, 0x05, 0xfd, 0x37, 0x7a, 0xeb, 0x2d, 0xc7, 0x48, 0xbd, 0xf4, 0x54, 0x55,
+	0xd0, 0x53, 0x8f, 0xfd, 0x0b, 0xaa, 0xf9, 0xe1, 0xc5, 0x86, 0xac, 0x77, 0xd3, 0x13, 0x3b, 0xdf,
+	0x7c, 0xef, 0x7b, 0xdf, 0x3c, 0xbf, 0x79, 0x03, 0xdc, 0x09, 0xdc, 0x33, 0x37, 0xc4, 0xd4, 0xe2,
+	0x7f, 0xad, 0x84, 0xb5, 0x13, 0x2f, 0xf6, 0x23, 0xea, 0x93, 0xd0, 0x7a, 0xca, 0x70, 0x7c, 0x6e,
+	0x46, 0x31, 0xa1, 0x04, 0x2d, 0x2b, 0x9a, 0xc9, 0xff, 0x9a, 0x59, 0x5a, 0x7d, 0xa9, 0x4b, 0xba,
+	0x44, 0xb0, 0x2c, 0xfe, 0x4b, 0x06, 0xd4, 0xdf, 0xeb, 0x12, 0xd2, 0x0d, 0xb0, 0xe5, 0x46, 0xbe,
+	0xe5, 0x86, 0x21, 0xa1, 0x2e, 0x27, 0x27, 0x6a, 0xf7, 0xbe, 0x47, 0x92, 0x1e, 0x49, 0xac, 0xb6,
+	0x9b, 0x60, 0x99, 0xc7, 0x3a, 0x6b, 0xb6, 0x31, 0x75, 0x9b, 0x56, 0xe4, 0x76, 0xfd, 0x50, 0x90,
+	0x15, 0xf7, 0xee, 0x70, 0x87, 0x91, 0x1b, 0xbb, 0xbd, 0x54, 0xb3, 0x91, 0xd5, 0x4c, 0xd5, 0x3c,
+	0xe2, 0xa7, 0x3a, 0x5b, 0xc3, 0x75, 0xb2, 0x0b, 0xc9, 0x36, 0x96, 0x00, 0x7d, 0xcd, 0x7d, 0x1d,
+	0x8a, 0x14, 0x36, 0x7e, 0xca, 0x70, 0x42, 0x8d, 0x27, 0xb0, 0x98, 0x43, 0x93, 0x88, 0x84, 0x09,
+	0x46, 0x0f, 0x61, 0x5a, 0x5a, 0xd1, 0xb5, 0x55, 0x6d, 0xb3, 0xb2, 0xbd, 0x66, 0x0e, 0x2d, 0x97,
+	0x29, 0x43, 0xf7, 0x4b, 0xaf, 0xfe, 0x5c, 0x99, 0xb0, 0x55, 0x98, 0xd1, 0x54, 0xba, 0x2d, 0x16,
+	0xc7, 0x38, 0xa4, 0x2a, 0x1d, 0xaa, 0x43, 0xd9, 0x23, 0x61, 0xc2, 0x7a, 0x38, 0x16, 0xca, 0xb3,
+	0xf6, 0x60, 0x6d, 0x7c, 0x03, 0x4b, 0xf9, 0x90, 0x81, 0x97, 0x5b, 0x09, 0x6b, 0x2b, 0x23, 0x1b,
+	0x05, 0x46, 0x8e, 0x32, 0x0b, 0x61, 0x47, 0xb3, 0x79, 0xa4, 0xf1, 0x29, 0xe8, 0x42, 0xf8, 0xc0,
+	0x4f, 0xe8, 0x61, 0x4c, 0xbe, 0xc7, 0x1e, 0x4d, 0xcf, 0x8f, 0x0c, 0xa8, 0x66, 0x35, 0x94, 0xa9,
+	0x1c, 0x66, 0xec, 0xc2, 0xf2, 0x1b, 0xe2, 0x95, 0xbb, 0x3a, 0x94, 0x23, 0x85, 0xe9, 0xda, 0xea,
+	0x2d, 0x7e, 0xa2, 0x74, 0x6d, 0x20, 0x58, 0x18, 0x04, 0xa6, 0x05, 0x77, 0xe1, 0x76, 0x06, 0x53,
+	0x22, 0x07, 0x30, 0xcb, 0x33, 0x3a, 0x7e, 0xd8, 0x21, 0x42, 0xa5, 0xb2, 0x7d, 0xaf, 0xe0, 0xa0,
+	0x3c, 0xf6, 0xf3, 0xb0, 0x43, 0x8e, 0x68, 0xcc, 0x3c, 0xaa, 0x2a, 0x5f, 0xe6, 0x14, 0x8e, 0x1a,
+	0x2f, 0x4b, 0x30, 0x97, 0xa7, 0x14, 0xd5, 0x1d, 0x21, 0x28, 0x45, 0x81, 0x1b, 0xea, 0x93, 0x02,
+	0x17, 0xbf, 0xd1, 0x06, 0xcc, 0x9f, 0xb0, 0x58, 0x34, 0xad, 0xd3, 0x26, 0xac, 0x7b, 0x4a, 0xf5,
+	0x5b, 0xab, 0xda, 0x66, 0xc9, 0x9e, 0x4b, 0xe1, 0x7d, 0x81, 0xa2, 0x75, 0xa8, 0x0d, 0x88, 0x01,
+	0xee, 0x50, 0xbd, 0x24, 0x68, 0xd5, 0x14, 0x3c, 0xc0, 0x1d, 0x8a, 0xd6, 0xa0, 0xda, 0x23, 0x21,
+	0x3d, 0x75, 0x70, 0x3f, 0xf2, 0xe3, 0x73, 0x7d, 0x4a, 0x70, 0x2a, 0x02, 0xfb, 0x4c, 0x40, 0xe8,
+	0x03, 0x98, 0x93, 0x14, 0x8f, 0x39, 0x94, 0x50, 0x37, 0xd0, 0xa7, 0xa5, 0x90, 0x40, 0x5b, 0xec,
+	0x98, 0x63, 0xc8, 0x80, 0xda, 0x80, 0x25, 0xb2, 0xcd, 0x64, 0x94, 0x5a, 0x4c, 0x24, 0xd3, 0x61,
+	0xc6, 0x0b, 0x58, 0x42, 0x71, 0xac, 0x97, 0xc5, 0x89, 0xd2, 0x25, 0xba, 0x03, 0x03, 0xf7, 0x2a,
+	0xc7, 0xac, 0x08, 0x1f, 0x9c, 0x40, 0x26, 0xd9, 0x81, 0x77, 0x5d, 0x46, 0x89, 0x13, 0xe3, 0x10,
+	0x3f, 0x73, 0x03, 0x27, 0xc4, 0x7d, 0xea, 0x88, 0x0a, 0x55, 0x84, 0xde, 0x22, 0xdf, 0xb5, 0xe5,
+	0xe6, 0x57, 0xb8, 0x4f, 0x0f, 0x79, 0xc1, 0xbe, 0x83, 0xc5, 0x0e, 0xa3, 0x2c, 0xc6, 0x4e, 0xae,
+	0x9d, 0xaa, 0xa2, 0x69, 0x1f, 0x14, 0x7c, 0xcb, 0x47, 0x22, 0x2a, 0xdb, 0xba, 0x36, 0xea, 0xdc,
+	0xc0, 0x50, 0x13, 0xa6, 0xbd, 0x18, 0x9f, 0xf8, 0x54, 0xaf, 0x09, 0xc9, 0x65, 0x53, 0x0e, 0x07,
+	0x93, 0x0f, 0x07, 0x53, 0x0d, 0x07, 0xb3, 0x45, 0xfc, 0xd0, 0x56, 0xc4, 0x2f, 0x4a, 0x65, 0x58,
+	0xa8, 0x18, 0x2b, 0xf0, 0xbe, 0xe8, 0x37, 0xee, 0xf4, 0x98, 0x7c, 0x79, 0x55, 0xf2, 0xb4, 0x21,
+	0x0f, 0x61, 0xfe, 0xd8, 0xef, 0xe1, 0x58, 0xa2, 0xbc, 0x67, 0x0a, 0xbb, 0xe5, 0xfa, 0xb7, 0x9c,
+	0xbc, 0xf1, 0x2d, 0x8d, 0x3e, 0x34, 0x86, 0xa5, 0x54, 0xfd, 0xfe, 0x04, 0x6a, 0xd9, 0x22, 0x24,
+	0xaa, 0xe7, 0xef, 0x17, 0xd4, 0xe9, 0x9a, 0x47, 0xd5, 0xf4, 0x79, 0x99, 0xc1, 0xd4, 0x79, 0x7c,
+	0x86, 0x63, 0x96, 0xe0, 0x71, 0xa6, 0xce, 0x3f, 0x93, 0xb0, 0x94, 0x8f, 0x51, 0x1e, 0xd7, 0xa1,
+	0xe6, 0x06, 0x01, 0x79, 0xe6, 0x10, 0xb9, 0x21, 0x22, 0xcb, 0x76, 0x55, 0x80, 0x8a, 0xcc, 0xab,
+	0xa1, 0xb6, 0x9d, 0xd8, 0xa5, 0x38, 0xad, 0x86, 0xc2, 0x6c, 0x97, 0x62, 0xb4, 0x09, 0x0b, 0x29,
+	0x85, 0x77, 0xad, 0xdf, 0xf3, 0x07, 0x77, 0x49, 0xe1, 0x2d, 0x76, 0xc0, 0x51, 0xce, 0x94, 0xa5,
+	0xbd, 0xe2, 0xab, 0xeb, 0x24, 0xef, 0xc6, 0xe3, 0x94, 0x8e, 0x8e, 0xe0, 0x9d, 0x6b, 0xcc, 0x53,
+	0x37, 0xee, 0xe2, 0x13, 0x7d, 0x6a, 0x44, 0x73, 0xa8, 0xb2, 0x2d, 0xe6, 0xf4, 0x64, 0x2c, 0xba,
+	0x0b, 0xf3, 0x59, 0xa3, 0xfc, 0x7a, 0xc9, 0x3b, 0x58, 0xbb, 0xf2, 0xc9, 0x2f, 0xd8, 0x1e, 0xcc,
+	0x9c, 0xe0, 0x88, 0x24, 0xbe, 0xbc, 0x7e, 0x63, 0xa4, 0x4b, 0xf9, 0xdb, 0xff, 0xce, 0xc0, 0x94,
+	0x28, 0x36, 0xfa, 0x49, 0x83, 0x69, 0xf9, 0x70, 0xa0, 0xa2, 0xdb, 0x71, 0xf3, 0xc5, 0xaa, 0x9b,
+	0xe3, 0xd2, 0xe5, 0x77, 0x34, 0xee, 0xfd, 0xf0, 0xfb, 0xdf, 0x3f, 0x4f, 0xae, 0xa3, 0x35, 0x6b,
+	0xd4, 0xb3, 0x8b, 0x7e, 0xd1, 0x60, 0x46, 0xbd, 0x3e, 0x68, 0x64, 0x9a, 0xfc, 0xcb, 0x56, 0xb7,
+	0xc6, 0xe6, 0x2b, 0x5f, 0x1f, 0x0b, 0x5f, 0x16, 0x7a, 0x50, 0xe0, 0xcb, 0x93, 0x31, 0xd6, 0xf3,
+	0xb4, 0x5d, 0x5f, 0xa0, 0x5f, 0x35, 0xa8, 0x66, 0x1f, 0x22, 0xb4, 0x33, 0x2a, 0xf1, 0x1b, 0x9e,
+	0xbd, 0xfa, 0x47, 0x6f, 0x17, 0xa4, 0x2c, 0x3f, 0x14, 0x96, 0xf7, 0xd0, 0x6e, 0x81, 0xe5, 0xc0,
+	0x4f, 0xa8, 0x93, 0xbe, 0x80, 0xd6, 0xf3, 0xec, 0xde, 0x0b, 0xf4, 0x52, 0x83, 0x12, 0x57, 0x46,
+	0x1f, 0x8e, 0x93, 0x3f, 0x35, 0xbb, 0x35, 0x1e, 0x59, 0x99, 0xdc, 0x10, 0x26, 0xd7, 0xd0, 0xca,
+	0x08, 0x93, 0xe8, 0x37, 0x0d, 0x6e, 0xdf, 0x18, 0x51, 0xe8, 0x93, 0x51, 0xc9, 0x86, 0x0d, 0xd2,
+	0xfa, 0xde, 0xff, 0x88, 0x54, 0x9e, 0x77, 0x85, 0xe7, 0x26, 0xb2, 0x0a, 0x3c, 0x8b, 0x67, 0x88,
+	0x12, 0x27, 0x3b, 0x7d, 0x45, 0xc7, 0xa6, 0xb3, 0x68, 0x64, 0xc7, 0xe6, 0xa7, 0x62, 0xdd, 0x1a,
+	0x9b, 0xff, 0x16, 0x1d, 0xab, 0x46, 0x45, 0xa6, 0x63, 0xf7, 0x1f, 0xbd, 0xba, 0x68, 0x68, 0xaf,
+	0x2f, 0x1a, 0xda, 0x5f, 0x17, 0x0d, 0xed, 0xc7, 0xcb, 0xc6, 0xc4, 0xeb, 0xcb, 0xc6, 0xc4, 0x1f,
+	0x97, 0x8d, 0x89, 0x6f, 0xb7, 0xba, 0x3e, 0x3d, 0x65, 0x6d, 0xd3, 0x23, 0xbd, 0xbc, 0x64, 0x3f,
+	0x2f, 0x4a, 0xcf, 0x23, 0x9c, 0xb4, 0xa7, 0xc5, 0xff, 0xb1, 0x3b, 0xff, 0x0d, 0x00, 0xe5, 0x1d,
+	0xca, 0x6b, 0xe1, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -736,6 +884,8 @@ type QueryClient interface {
 	List(ctx context.Context, in *QueryListRequest, opts ...grpc.CallOption) (*QueryListResponse, error)
 	// Queries the subscription with the closest month expiry
 	NextToMonthExpiry(ctx context.Context, in *QueryNextToMonthExpiryRequest, opts ...grpc.CallOption) (*QueryNextToMonthExpiryResponse, error)
+	// Queries the CU overuse of a subscription in the current month
+	Overuse(ctx context.Context, in *QueryOveruseRequest, opts ...grpc.CallOption) (*QueryOveruseResponse, error)
 }
 
 type queryClient struct {
@@ -791,6 +941,15 @@ func (c *queryClient) NextToMonthExpiry(ctx context.Context, in *QueryNextToMont
 	return out, nil
 }
 
+func (c *queryClient) Overuse(ctx context.Context, in *QueryOveruseRequest, opts ...grpc.CallOption) (*QueryOveruseResponse, error) {
+	out := new(QueryOveruseResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Query/Overuse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -803,6 +962,8 @@ type QueryServer interface {
 	List(context.Context, *QueryListRequest) (*QueryListResponse, error)
 	// Queries the subscription with the closest month expiry
 	NextToMonthExpiry(context.Context, *QueryNextToMonthExpiryRequest) (*QueryNextToMonthExpiryResponse, error)
+	// Queries the CU overuse of a subscription in the current month
+	Overuse(context.Context, *QueryOveruseRequest) (*QueryOveruseResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -824,6 +985,9 @@ func (*UnimplementedQueryServer) List(ctx context.Context, req *QueryListRequest
 func (*UnimplementedQueryServer) NextToMonthExpiry(ctx context.Context, req *QueryNextToMonthExpiryRequest) (*QueryNextToMonthExpiryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextToMonthExpiry not implemented")
 }
+func (*UnimplementedQueryServer) Overuse(ctx context.Context, req *QueryOveruseRequest) (*QueryOveruseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Overuse not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -919,6 +1083,24 @@ func _Query_NextToMonthExpiry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Overuse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOveruseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Overuse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Query/Overuse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Overuse(ctx, req.(*QueryOveruseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.subscription.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -943,6 +1125,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextToMonthExpiry",
 			Handler:    _Query_NextToMonthExpiry_Handler,
 		},
+		{
+			MethodName: "Overuse",
+			Handler:    _Query_Overuse_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/subscription/query.proto",
@@ -1391,6 +1577,109 @@ func (m *QueryNextToMonthExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOveruseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOveruseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOveruseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOveruseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOveruseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOveruseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OveruseCuLeft != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OveruseCuLeft))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.MonthOveruseCharged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MonthOveruseCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MonthOveruseCu))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OveruseCuLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OveruseCuLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OveruseRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OveruseRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AllowOveruse {
+		i--
+		if m.AllowOveruse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1591,6 +1880,47 @@ func (m *QueryNextToMonthExpiryResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOveruseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOveruseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowOveruse {
+		n += 2
+	}
+	if m.OveruseRate != 0 {
+		n += 1 + sovQuery(uint64(m.OveruseRate))
+	}
+	if m.OveruseCuLimit != 0 {
+		n += 1 + sovQuery(uint64(m.OveruseCuLimit))
+	}
+	if m.MonthOveruseCu != 0 {
+		n += 1 + sovQuery(uint64(m.MonthOveruseCu))
+	}
+	l = m.MonthOveruseCharged.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OveruseCuLeft != 0 {
+		n += 1 + sovQuery(uint64(m.OveruseCuLeft))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2795,6 +3125,300 @@ func (m *QueryNextToMonthExpiryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOveruseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOveruseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOveruseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOveruseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOveruseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOveruseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOveruse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowOveruse = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseRate", wireType)
+			}
+			m.OveruseRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseCuLimit", wireType)
+			}
+			m.OveruseCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthOveruseCu", wireType)
+			}
+			m.MonthOveruseCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthOveruseCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthOveruseCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthOveruseCharged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseCuLeft", wireType)
+			}
+			m.OveruseCuLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseCuLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,4 +12,12 @@ const (
 	AddTrackedCuEventName                   = "add_tracked_cu_event"
 	MonthlyCuTrackerProviderRewardEventName = "monthly_cu_tracker_provider_reward"
 	RemainingCreditEventName                = "subscription_remaining_credit"
+	RefundCreditEventName                   = "subscription_refund_credit"
+	OveruseChargedEventName                 = "subscription_overuse_charged"
+	OveruseDepositEventName                 = "subscription_overuse_deposit"
+	OveruseDepositRefundEventName           = "subscription_overuse_deposit_refund"
+	TransferSubscriptionRequestEventName    = "subscription_transfer_request"
+	TransferSubscriptionEventName           = "subscription_transfer"
+	TopUpSubscriptionEventName              = "subscription_top_up"
+	CancelSubscriptionEventName             = "subscription_cancel"
 )